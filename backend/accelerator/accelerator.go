@@ -0,0 +1,77 @@
+// Package accelerator provides a reference implementation of the
+// [backend.Accelerator] interface.
+//
+// The [CPU] accelerator delegates the multi-scalar multiplications and FFTs
+// back to the gnark-crypto CPU routines for BN254 and reports other curves as
+// unsupported, mirroring the scope of the ICICLE integration. It produces
+// bit-exact results with the default prover path, so it serves both as a test
+// double for the accelerated wiring and as a template for writing CUDA, Metal
+// or ICICLE bindings.
+package accelerator
+
+import (
+	"github.com/consensys/gnark-crypto/ecc"
+	curve "github.com/consensys/gnark-crypto/ecc/bn254"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr/fft"
+	"github.com/consensys/gnark/backend"
+)
+
+// CPU implements [backend.Accelerator] on top of the gnark-crypto CPU
+// routines for BN254. Pass it to the prover with
+// [backend.WithAccelerator].
+type CPU struct{}
+
+// MSMG1 implements [backend.Accelerator].
+func (CPU) MSMG1(res, points, scalars any) error {
+	r, ok := res.(*curve.G1Jac)
+	if !ok {
+		return backend.ErrUnsupportedAcceleration
+	}
+	_, err := r.MultiExp(points.([]curve.G1Affine), scalars.([]fr.Element), ecc.MultiExpConfig{})
+	return err
+}
+
+// MSMG2 implements [backend.Accelerator].
+func (CPU) MSMG2(res, points, scalars any) error {
+	r, ok := res.(*curve.G2Jac)
+	if !ok {
+		return backend.ErrUnsupportedAcceleration
+	}
+	_, err := r.MultiExp(points.([]curve.G2Affine), scalars.([]fr.Element), ecc.MultiExpConfig{})
+	return err
+}
+
+// FFT implements [backend.Accelerator]. The decimation-in-frequency
+// transform leaves the result in bit-reversed order, so it is permuted back
+// to honour the natural order contract of the interface.
+func (CPU) FFT(domain, a any, coset bool) error {
+	d, ok := domain.(*fft.Domain)
+	if !ok {
+		return backend.ErrUnsupportedAcceleration
+	}
+	v := a.([]fr.Element)
+	var opts []fft.Option
+	if coset {
+		opts = append(opts, fft.OnCoset())
+	}
+	d.FFT(v, fft.DIF, opts...)
+	fft.BitReverse(v)
+	return nil
+}
+
+// IFFT implements [backend.Accelerator].
+func (CPU) IFFT(domain, a any, coset bool) error {
+	d, ok := domain.(*fft.Domain)
+	if !ok {
+		return backend.ErrUnsupportedAcceleration
+	}
+	v := a.([]fr.Element)
+	var opts []fft.Option
+	if coset {
+		opts = append(opts, fft.OnCoset())
+	}
+	d.FFTInverse(v, fft.DIF, opts...)
+	fft.BitReverse(v)
+	return nil
+}
@@ -0,0 +1,117 @@
+package accelerator_test
+
+import (
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	curve "github.com/consensys/gnark-crypto/ecc/bn254"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr/fft"
+	"github.com/consensys/gnark/backend"
+	"github.com/consensys/gnark/backend/accelerator"
+	"github.com/consensys/gnark/backend/groth16"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/frontend/cs/r1cs"
+	"github.com/consensys/gnark/test"
+)
+
+const testSize = 64
+
+func randomMSMInputs(t *testing.T) ([]curve.G1Affine, []fr.Element) {
+	t.Helper()
+	scalars := make([]fr.Element, testSize)
+	exps := make([]fr.Element, testSize)
+	for i := range scalars {
+		if _, err := scalars[i].SetRandom(); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := exps[i].SetRandom(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	_, _, g, _ := curve.Generators()
+	points := curve.BatchScalarMultiplicationG1(&g, exps)
+	return points, scalars
+}
+
+// TestCPUMSM checks that the reference accelerator MSM is bit-exact with the
+// default gnark-crypto path.
+func TestCPUMSM(t *testing.T) {
+	assert := test.NewAssert(t)
+	points, scalars := randomMSMInputs(t)
+
+	var want, got curve.G1Jac
+	_, err := want.MultiExp(points, scalars, ecc.MultiExpConfig{})
+	assert.NoError(err)
+	assert.NoError(accelerator.CPU{}.MSMG1(&got, points, scalars))
+	assert.True(got.Equal(&want), "accelerated MSM differs from default path")
+
+	// an unsupported result type reports unsupported acceleration
+	err = accelerator.CPU{}.MSMG1(&struct{}{}, points, scalars)
+	assert.ErrorIs(err, backend.ErrUnsupportedAcceleration)
+}
+
+// TestCPUFFT checks that the reference accelerator transforms are natural
+// order and bit-exact with the default gnark-crypto path.
+func TestCPUFFT(t *testing.T) {
+	assert := test.NewAssert(t)
+	domain := fft.NewDomain(testSize)
+	v := make([]fr.Element, testSize)
+	for i := range v {
+		_, err := v[i].SetRandom()
+		assert.NoError(err)
+	}
+
+	// reference natural order transform: DIT over a bit-reversed input
+	want := make([]fr.Element, testSize)
+	copy(want, v)
+	fft.BitReverse(want)
+	domain.FFT(want, fft.DIT)
+
+	got := make([]fr.Element, testSize)
+	copy(got, v)
+	assert.NoError(accelerator.CPU{}.FFT(domain, got, false))
+	for i := range got {
+		assert.True(got[i].Equal(&want[i]), "accelerated FFT differs from default path")
+	}
+
+	// the inverse transform round-trips
+	assert.NoError(accelerator.CPU{}.IFFT(domain, got, false))
+	for i := range got {
+		assert.True(got[i].Equal(&v[i]), "IFFT(FFT(v)) != v")
+	}
+}
+
+type cubicCircuit struct {
+	X frontend.Variable
+	Y frontend.Variable `gnark:",public"`
+}
+
+func (c *cubicCircuit) Define(api frontend.API) error {
+	x3 := api.Mul(c.X, c.X, c.X)
+	api.AssertIsEqual(c.Y, api.Add(x3, c.X, 5))
+	return nil
+}
+
+// TestProveWithAccelerator proves with the reference accelerator, both on the
+// curve it supports and on one where the prover falls back to the built-in
+// routines.
+func TestProveWithAccelerator(t *testing.T) {
+	assert := test.NewAssert(t)
+	assignment := &cubicCircuit{X: 3, Y: 35}
+	for _, curveID := range []ecc.ID{ecc.BN254, ecc.BLS12_377} {
+		assert.Run(func(assert *test.Assert) {
+			ccs, err := frontend.Compile(curveID.ScalarField(), r1cs.NewBuilder, &cubicCircuit{})
+			assert.NoError(err)
+			pk, vk, err := groth16.Setup(ccs)
+			assert.NoError(err)
+			witness, err := frontend.NewWitness(assignment, curveID.ScalarField())
+			assert.NoError(err)
+			proof, err := groth16.Prove(ccs, pk, witness, backend.WithAccelerator(accelerator.CPU{}))
+			assert.NoError(err)
+			pubWitness, err := witness.Public()
+			assert.NoError(err)
+			assert.NoError(groth16.Verify(proof, vk, pubWitness))
+		}, curveID.String())
+	}
+}
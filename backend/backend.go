@@ -6,6 +6,7 @@ package backend
 
 import (
 	"crypto/sha256"
+	"errors"
 	"hash"
 
 	"github.com/consensys/gnark/constraint/solver"
@@ -56,12 +57,13 @@ type ProverOption func(*ProverConfig) error
 
 // ProverConfig is the configuration for the prover with the options applied.
 type ProverConfig struct {
-	SolverOpts     []solver.Option
-	HashToFieldFn  hash.Hash
-	ChallengeHash  hash.Hash
-	KZGFoldingHash hash.Hash
-	Accelerator    string
-	StatisticalZK  bool
+	SolverOpts      []solver.Option
+	HashToFieldFn   hash.Hash
+	ChallengeHash   hash.Hash
+	KZGFoldingHash  hash.Hash
+	Accelerator     string
+	AcceleratorImpl Accelerator
+	StatisticalZK   bool
 }
 
 // NewProverConfig returns a default ProverConfig with given prover options opts
@@ -122,6 +124,50 @@ func WithProverKZGFoldingHashFunction(hFunc hash.Hash) ProverOption {
 	}
 }
 
+// Accelerator is implemented by hardware-accelerated providers of the prover
+// hot loops (multi-scalar multiplications and FFTs), e.g. CUDA or Metal
+// bindings. The provers call the methods with the curve-specific gnark-crypto
+// types; an implementation type-asserts the arguments for the curves it
+// supports and returns [ErrUnsupportedAcceleration] for the rest, in which
+// case the prover falls back to the built-in CPU routines.
+//
+// See [github.com/consensys/gnark/backend/accelerator] for a reference CPU
+// implementation delegating back to gnark-crypto.
+type Accelerator interface {
+	// MSMG1 computes the multi-scalar multiplication of points by scalars
+	// into res. res is a *G1Jac, points a []G1Affine and scalars a
+	// []fr.Element of the proving curve.
+	MSMG1(res, points, scalars any) error
+	// MSMG2 is [Accelerator.MSMG1] for G2 points.
+	MSMG2(res, points, scalars any) error
+	// FFT computes the in-place discrete Fourier transform of the natural
+	// order vector a (a []fr.Element) over domain (a *fft.Domain of the
+	// proving curve), on the domain coset when coset is set. The result is
+	// in natural order.
+	FFT(domain, a any, coset bool) error
+	// IFFT is the inverse transform of [Accelerator.FFT].
+	IFFT(domain, a any, coset bool) error
+}
+
+// ErrUnsupportedAcceleration is returned by an [Accelerator] when it does not
+// support the requested operation for the proving curve. The prover then
+// falls back to the built-in CPU routine. The accelerator must not have
+// modified its arguments when returning it, as the first FFT of a proof
+// probes curve support for the whole transform pipeline.
+var ErrUnsupportedAcceleration = errors.New("operation not supported by accelerator")
+
+// WithAccelerator instructs the prover to delegate the multi-scalar
+// multiplications and FFTs to impl. Operations (or curves) the implementation
+// reports as unsupported through [ErrUnsupportedAcceleration] run on the
+// built-in CPU routines. Currently wired into the Groth16 provers; for the
+// build-tagged ICICLE integration see [WithIcicleAcceleration].
+func WithAccelerator(impl Accelerator) ProverOption {
+	return func(pc *ProverConfig) error {
+		pc.AcceleratorImpl = impl
+		return nil
+	}
+}
+
 // WithIcicleAcceleration requests to use [ICICLE] GPU proving backend for the
 // prover. This option requires that the program is compiled with `icicle` build
 // tag and the ICICLE dependencies are properly installed. See [ICICLE] for
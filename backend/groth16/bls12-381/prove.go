@@ -6,6 +6,7 @@
 package groth16
 
 import (
+	"errors"
 	"fmt"
 	"github.com/consensys/gnark-crypto/ecc"
 	curve "github.com/consensys/gnark-crypto/ecc/bls12-381"
@@ -103,6 +104,9 @@ func Prove(r1cs *cs.R1CS, pk *ProvingKey, fullWitness witness.Witness, opts ...b
 	}
 
 	solution := _solution.(*cs.R1CSSolution)
+	if solution.A == nil && r1cs.GetNbConstraints() > 0 {
+		return nil, fmt.Errorf("streaming solver is not supported by the Groth16 prover: it requires the a,b,c evaluation vectors")
+	}
 	wireValues := []fr.Element(solution.W)
 
 	start := time.Now()
@@ -129,9 +133,10 @@ func Prove(r1cs *cs.R1CS, pk *ProvingKey, fullWitness witness.Witness, opts ...b
 
 	// H (witness reduction / FFT part)
 	var h []fr.Element
+	var errH error
 	chHDone := make(chan struct{}, 1)
 	go func() {
-		h = computeH(solution.A, solution.B, solution.C, &pk.Domain)
+		h, errH = computeH(solution.A, solution.B, solution.C, &pk.Domain, opt.AcceleratorImpl)
 		solution.A = nil
 		solution.B = nil
 		solution.C = nil
@@ -190,7 +195,7 @@ func Prove(r1cs *cs.R1CS, pk *ProvingKey, fullWitness witness.Witness, opts ...b
 	chBs1Done := make(chan error, 1)
 	computeBS1 := func() {
 		<-chWireValuesB
-		if _, err := bs1.MultiExp(pk.G1.B, wireValuesB, ecc.MultiExpConfig{NbTasks: n / 2}); err != nil {
+		if err := msmG1(opt.AcceleratorImpl, &bs1, pk.G1.B, wireValuesB, ecc.MultiExpConfig{NbTasks: n / 2}); err != nil {
 			chBs1Done <- err
 			close(chBs1Done)
 			return
@@ -203,7 +208,7 @@ func Prove(r1cs *cs.R1CS, pk *ProvingKey, fullWitness witness.Witness, opts ...b
 	chArDone := make(chan error, 1)
 	computeAR1 := func() {
 		<-chWireValuesA
-		if _, err := ar.MultiExp(pk.G1.A, wireValuesA, ecc.MultiExpConfig{NbTasks: n / 2}); err != nil {
+		if err := msmG1(opt.AcceleratorImpl, &ar, pk.G1.A, wireValuesA, ecc.MultiExpConfig{NbTasks: n / 2}); err != nil {
 			chArDone <- err
 			close(chArDone)
 			return
@@ -223,8 +228,7 @@ func Prove(r1cs *cs.R1CS, pk *ProvingKey, fullWitness witness.Witness, opts ...b
 		chKrs2Done := make(chan error, 1)
 		sizeH := int(pk.Domain.Cardinality - 1) // comes from the fact the deg(H)=(n-1)+(n-1)-n=n-2
 		go func() {
-			_, err := krs2.MultiExp(pk.G1.Z, h[:sizeH], ecc.MultiExpConfig{NbTasks: n / 2})
-			chKrs2Done <- err
+			chKrs2Done <- msmG1(opt.AcceleratorImpl, &krs2, pk.G1.Z, h[:sizeH], ecc.MultiExpConfig{NbTasks: n / 2})
 		}()
 
 		// filter the wire values if needed
@@ -233,7 +237,7 @@ func Prove(r1cs *cs.R1CS, pk *ProvingKey, fullWitness witness.Witness, opts ...b
 		toRemove = append(toRemove, commitmentInfo.CommitmentIndexes())
 		_wireValues := filterHeap(wireValues[r1cs.GetNbPublicVariables():], r1cs.GetNbPublicVariables(), internal.ConcatAll(toRemove...))
 
-		if _, err := krs.MultiExp(pk.G1.K, _wireValues, ecc.MultiExpConfig{NbTasks: n / 2}); err != nil {
+		if err := msmG1(opt.AcceleratorImpl, &krs, pk.G1.K, _wireValues, ecc.MultiExpConfig{NbTasks: n / 2}); err != nil {
 			chKrsDone <- err
 			return
 		}
@@ -279,7 +283,7 @@ func Prove(r1cs *cs.R1CS, pk *ProvingKey, fullWitness witness.Witness, opts ...b
 			nbTasks *= 2
 		}
 		<-chWireValuesB
-		if _, err := Bs.MultiExp(pk.G2.B, wireValuesB, ecc.MultiExpConfig{NbTasks: nbTasks}); err != nil {
+		if err := msmG2(opt.AcceleratorImpl, &Bs, pk.G2.B, wireValuesB, ecc.MultiExpConfig{NbTasks: nbTasks}); err != nil {
 			return err
 		}
 
@@ -294,6 +298,9 @@ func Prove(r1cs *cs.R1CS, pk *ProvingKey, fullWitness witness.Witness, opts ...b
 
 	// wait for FFT to end, as it uses all our CPUs
 	<-chHDone
+	if errH != nil {
+		return nil, errH
+	}
 
 	// schedule our proof part computations
 	go computeKRS()
@@ -342,7 +349,7 @@ func filterHeap(slice []fr.Element, sliceFirstIndex int, toRemove []int) (r []fr
 	return
 }
 
-func computeH(a, b, c []fr.Element, domain *fft.Domain) []fr.Element {
+func computeH(a, b, c []fr.Element, domain *fft.Domain, acc backend.Accelerator) ([]fr.Element, error) {
 	// H part of Krs
 	// Compute H (hz=ab-c, where z=-2 on ker X^n+1 (z(x)=x^n-1))
 	// 	1 - _a = ifft(a), _b = ifft(b), _c = ifft(c)
@@ -358,6 +365,15 @@ func computeH(a, b, c []fr.Element, domain *fft.Domain) []fr.Element {
 	c = append(c, padding...)
 	n = len(a)
 
+	if acc != nil {
+		// delegate the transforms to the accelerator; the first call probes
+		// curve support and falls back to the CPU path below
+		h, err := computeHAccelerated(a, b, c, domain, acc)
+		if err == nil || !errors.Is(err, backend.ErrUnsupportedAcceleration) {
+			return h, err
+		}
+	}
+
 	domain.FFTInverse(a, fft.DIF)
 	domain.FFTInverse(b, fft.DIF)
 	domain.FFTInverse(c, fft.DIF)
@@ -384,5 +400,68 @@ func computeH(a, b, c []fr.Element, domain *fft.Domain) []fr.Element {
 	// ifft_coset
 	domain.FFTInverse(a, fft.DIF, fft.OnCoset())
 
-	return a
+	return a, nil
+}
+
+// computeHAccelerated is computeH with the transforms delegated to the
+// accelerator. The transforms operate on natural order vectors, so the result
+// is bit-reversed at the end to match the ordering of pk.G1.Z. The first
+// transform probes curve support; the accelerator must not have modified its
+// input when it returns [backend.ErrUnsupportedAcceleration].
+func computeHAccelerated(a, b, c []fr.Element, domain *fft.Domain, acc backend.Accelerator) ([]fr.Element, error) {
+	for _, v := range [][]fr.Element{a, b, c} {
+		if err := acc.IFFT(domain, v, false); err != nil {
+			return nil, err
+		}
+	}
+	for _, v := range [][]fr.Element{a, b, c} {
+		if err := acc.FFT(domain, v, true); err != nil {
+			return nil, err
+		}
+	}
+
+	var den, one fr.Element
+	one.SetOne()
+	den.Exp(domain.FrMultiplicativeGen, big.NewInt(int64(domain.Cardinality)))
+	den.Sub(&den, &one).Inverse(&den)
+
+	utils.Parallelize(len(a), func(start, end int) {
+		for i := start; i < end; i++ {
+			a[i].Mul(&a[i], &b[i]).
+				Sub(&a[i], &c[i]).
+				Mul(&a[i], &den)
+		}
+	})
+
+	if err := acc.IFFT(domain, a, true); err != nil {
+		return nil, err
+	}
+	fft.BitReverse(a)
+	return a, nil
+}
+
+// msmG1 computes the multi-scalar multiplication through the configured
+// accelerator when one is set, falling back to gnark-crypto when there is
+// none or the accelerator does not support the curve.
+func msmG1(acc backend.Accelerator, res *curve.G1Jac, points []curve.G1Affine, scalars []fr.Element, cfg ecc.MultiExpConfig) error {
+	if acc != nil {
+		err := acc.MSMG1(res, points, scalars)
+		if err == nil || !errors.Is(err, backend.ErrUnsupportedAcceleration) {
+			return err
+		}
+	}
+	_, err := res.MultiExp(points, scalars, cfg)
+	return err
+}
+
+// msmG2 is msmG1 for G2 points.
+func msmG2(acc backend.Accelerator, res *curve.G2Jac, points []curve.G2Affine, scalars []fr.Element, cfg ecc.MultiExpConfig) error {
+	if acc != nil {
+		err := acc.MSMG2(res, points, scalars)
+		if err == nil || !errors.Is(err, backend.ErrUnsupportedAcceleration) {
+			return err
+		}
+	}
+	_, err := res.MultiExp(points, scalars, cfg)
+	return err
 }
@@ -10,6 +10,8 @@ import (
 	"github.com/consensys/gnark/backend"
 	"github.com/consensys/gnark/backend/groth16"
 	"github.com/consensys/gnark/constraint"
+	cs_bn254 "github.com/consensys/gnark/constraint/bn254"
+	"github.com/consensys/gnark/constraint/solver"
 	"github.com/consensys/gnark/frontend"
 	"github.com/consensys/gnark/frontend/cs/r1cs"
 	"github.com/consensys/gnark/test"
@@ -51,6 +53,48 @@ func TestCustomHashToField(t *testing.T) {
 	}
 }
 
+func TestStreamingSolver(t *testing.T) {
+	assert := test.NewAssert(t)
+	const nbConstraints = 10
+	ccs, err := frontend.Compile(ecc.BN254.ScalarField(), r1cs.NewBuilder, &refCircuit{nbConstraints: nbConstraints})
+	assert.NoError(err)
+
+	var assignment refCircuit
+	assignment.X = 2
+	expectedY := new(big.Int).SetUint64(2)
+	expectedY.Exp(expectedY, new(big.Int).Lsh(big.NewInt(1), nbConstraints), ecc.BN254.ScalarField())
+	assignment.Y = expectedY
+	witness, err := frontend.NewWitness(&assignment, ecc.BN254.ScalarField())
+	assert.NoError(err)
+
+	assert.Run(func(assert *test.Assert) {
+		solution, err := ccs.Solve(witness, solver.WithStreamingSolver())
+		assert.NoError(err)
+		// the a,b,c vectors are not materialized in streaming mode
+		res := solution.(*cs_bn254.R1CSSolution)
+		assert.NotEmpty(res.W)
+		assert.Nil(res.A)
+	}, "solve")
+
+	assert.Run(func(assert *test.Assert) {
+		var bad refCircuit
+		bad.X = 2
+		bad.Y = 42
+		badWitness, err := frontend.NewWitness(&bad, ecc.BN254.ScalarField())
+		assert.NoError(err)
+		_, err = ccs.Solve(badWitness, solver.WithStreamingSolver())
+		assert.Error(err)
+	}, "unsatisfied")
+
+	assert.Run(func(assert *test.Assert) {
+		pk, _, err := groth16.Setup(ccs)
+		assert.NoError(err)
+		// the Groth16 prover needs the a,b,c vectors and rejects the option
+		_, err = groth16.Prove(ccs, pk, witness, backend.WithSolverOptions(solver.WithStreamingSolver()))
+		assert.Error(err)
+	}, "prover rejects")
+}
+
 //--------------------//
 //     benches		  //
 //--------------------//
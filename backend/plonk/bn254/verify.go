@@ -383,6 +383,13 @@ func deriveRandomness(fs *fiatshamir.Transcript, challenge string, points ...*cu
 
 // ExportSolidity exports the verifying key to a solidity smart contract.
 //
+// The contract verifies proofs produced with the default prover options,
+// including circuits using the BSB22 commitment extension (api.Commit): the
+// custom gate selector commitments are folded into the linearised polynomial
+// and the commitment proofs of knowledge are checked on-chain, with the same
+// transcript as the Go verifier. Use [solidity.WithHashToFieldFunction] if
+// the prover was configured with a non-default hash to field function.
+//
 // See https://github.com/Consensys/gnark-tests for example usage.
 //
 // Code has not been audited and is provided as-is, we make no guarantees or warranties to its safety and reliability.
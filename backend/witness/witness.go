@@ -57,6 +57,7 @@ import (
 	"github.com/consensys/gnark/debug"
 	"github.com/consensys/gnark/frontend/schema"
 	"github.com/consensys/gnark/internal/tinyfield"
+	"github.com/fxamacker/cbor/v2"
 )
 
 var ErrInvalidWitness = errors.New("invalid witness")
@@ -89,6 +90,15 @@ type Witness interface {
 	// This is a convenience method and should be avoided in most cases.
 	FromJSON(s *schema.Schema, data []byte) error
 
+	// ToCBOR returns the CBOR encoding of the witness following the provided Schema, with
+	// field elements encoded as bignums. This is a convenience method and should be avoided
+	// in most cases.
+	ToCBOR(s *schema.Schema) ([]byte, error)
+
+	// FromCBOR parses a CBOR data input and attempt to reconstruct a witness following the
+	// provided Schema. This is a convenience method and should be avoided in most cases.
+	FromCBOR(s *schema.Schema, data []byte) error
+
 	// Fill range over the provided chan to fill the underlying vector.
 	// Will allocate the underlying vector with nbPublic + nbSecret elements.
 	// This is typically call by internal APIs to fill the vector by walking a structure.
@@ -321,6 +331,82 @@ func (w *witness) FromJSON(s *schema.Schema, data []byte) error {
 	if err := dec.Decode(instance); err != nil {
 		return err
 	}
+
+	return w.fromInstance(s, instance, ptrTyp)
+}
+
+// ToCBOR returns the CBOR encoding of the witness following the provided Schema, with
+// field elements encoded as bignums. This is a convenience method and should be avoided
+// in most cases.
+func (w *witness) ToCBOR(s *schema.Schema) ([]byte, error) {
+	if s.NbPublic != int(w.nbPublic) || (w.nbSecret != 0 && w.nbSecret != uint32(s.NbSecret)) {
+		return nil, errors.New("schema is inconsistent with Witness")
+	}
+
+	// we instantiate the schema with *big.Int leaves; cbor encodes those as bignums,
+	// whereas the field elements would serialize as their raw Montgomery limbs.
+	typ := reflect.PointerTo(reflect.TypeOf(big.Int{}))
+	instance := s.Instantiate(typ)
+
+	setLeaf := func(tValue reflect.Value, v any) {
+		b := v.(interface{ BigInt(*big.Int) *big.Int }).BigInt(new(big.Int))
+		tValue.Set(reflect.ValueOf(b))
+	}
+
+	chValues := w.iterate()
+	if _, err := schema.Walk(instance, typ, func(field schema.LeafInfo, tValue reflect.Value) error {
+		if field.Visibility == schema.Public {
+			setLeaf(tValue, <-chValues)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	if w.nbSecret != 0 {
+		// secret part.
+		if _, err := schema.Walk(instance, typ, func(field schema.LeafInfo, tValue reflect.Value) error {
+			if field.Visibility == schema.Secret {
+				setLeaf(tValue, <-chValues)
+			}
+			return nil
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	enc, err := cbor.CoreDetEncOptions().EncMode()
+	if err != nil {
+		return nil, err
+	}
+	return enc.Marshal(instance)
+}
+
+// FromCBOR parses a CBOR data input and attempt to reconstruct a witness following the
+// provided Schema. This is a convenience method and should be avoided in most cases.
+func (w *witness) FromCBOR(s *schema.Schema, data []byte) error {
+	// we instantiate an object matching the schema, with *big.Int leaves
+	// note that we use a pointer type here to have nil for missing values
+	ptrTyp := reflect.PointerTo(reflect.TypeOf(big.Int{}))
+	instance := s.Instantiate(ptrTyp)
+
+	// cbor honours the json struct tags emitted by Instantiate, so the field names
+	// match the JSON encoding.
+	dm, err := cbor.DecOptions{ExtraReturnErrors: cbor.ExtraDecErrorUnknownField}.DecMode()
+	if err != nil {
+		return err
+	}
+	if err := dm.Unmarshal(data, instance); err != nil {
+		return err
+	}
+
+	return w.fromInstance(s, instance, ptrTyp)
+}
+
+// fromInstance reconstructs the witness from a decoded schema instance whose leaves are
+// pointers of type ptrTyp; a nil leaf is reported as a missing assignment. If only secret
+// assignments are missing, the public part alone is reconstructed.
+func (w *witness) fromInstance(s *schema.Schema, instance any, ptrTyp reflect.Type) error {
 	// walk through the public AND secret values
 	missingAssignment := func(name string) error {
 		return fmt.Errorf("missing assignment for %s", name)
@@ -12,6 +12,8 @@ import (
 	"github.com/consensys/gnark/backend/witness"
 	"github.com/consensys/gnark/frontend"
 	"github.com/consensys/gnark/io"
+	"github.com/consensys/gnark/std/math/emulated"
+	"github.com/consensys/gnark/std/math/uints"
 	"github.com/stretchr/testify/require"
 )
 
@@ -73,6 +75,7 @@ func TestMarshalPublic(t *testing.T) {
 
 	roundTripMarshal(assert, assignment, true)
 	roundTripMarshalJSON(assert, assignment, true)
+	roundTripMarshalCBOR(assert, assignment, true)
 }
 
 func TestMarshal(t *testing.T) {
@@ -85,6 +88,7 @@ func TestMarshal(t *testing.T) {
 
 	roundTripMarshal(assert, assignment, false)
 	roundTripMarshalJSON(assert, assignment, false)
+	roundTripMarshalCBOR(assert, assignment, false)
 }
 
 func TestPublic(t *testing.T) {
@@ -151,6 +155,74 @@ func roundTripMarshalJSON(assert *require.Assertions, assignment circuit, public
 
 }
 
+func roundTripMarshalCBOR(assert *require.Assertions, assignment circuit, publicOnly bool) {
+	// build the vector
+	var opts []frontend.WitnessOption
+	if publicOnly {
+		opts = append(opts, frontend.PublicOnly())
+	}
+	w, err := frontend.NewWitness(&assignment, ecc.BN254.ScalarField(), opts...)
+	assert.NoError(err)
+
+	s, err := frontend.NewSchema(&assignment)
+	assert.NoError(err)
+
+	// serialize the vector to CBOR
+	data, err := w.ToCBOR(s)
+	assert.NoError(err)
+
+	// re-read
+	rw, err := witness.New(ecc.BN254.ScalarField())
+	assert.NoError(err)
+	err = rw.FromCBOR(s, data)
+	assert.NoError(err)
+
+	assert.True(reflect.DeepEqual(rw, w), "witness cbor round trip serialization")
+
+}
+
+type gadgetCircuit struct {
+	Msg [2]uints.U8 `gnark:",public"`
+	Em  emulated.Element[emulated.Secp256k1Fp]
+}
+
+func (c *gadgetCircuit) Define(frontend.API) error {
+	return nil
+}
+
+// TestMarshalGadgets checks that the schema driven JSON and CBOR conversions
+// handle assignments built from gadget structs such as byte arrays and
+// emulated field elements.
+func TestMarshalGadgets(t *testing.T) {
+	assert := require.New(t)
+
+	assignment := gadgetCircuit{
+		Msg: [2]uints.U8{uints.NewU8(0xca), uints.NewU8(0xfe)},
+		Em:  emulated.ValueOf[emulated.Secp256k1Fp]("1234567891011121314151617181920"),
+	}
+
+	w, err := frontend.NewWitness(&assignment, ecc.BN254.ScalarField())
+	assert.NoError(err)
+	s, err := frontend.NewSchema(&assignment)
+	assert.NoError(err)
+
+	// JSON round trip
+	data, err := w.ToJSON(s)
+	assert.NoError(err)
+	rw, err := witness.New(ecc.BN254.ScalarField())
+	assert.NoError(err)
+	assert.NoError(rw.FromJSON(s, data))
+	assert.True(reflect.DeepEqual(rw, w), "witness json round trip serialization")
+
+	// CBOR round trip
+	data, err = w.ToCBOR(s)
+	assert.NoError(err)
+	rw, err = witness.New(ecc.BN254.ScalarField())
+	assert.NoError(err)
+	assert.NoError(rw.FromCBOR(s, data))
+	assert.True(reflect.DeepEqual(rw, w), "witness cbor round trip serialization")
+}
+
 type initableVariable struct {
 	Val []frontend.Variable
 }
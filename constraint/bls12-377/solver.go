@@ -110,7 +110,9 @@ func newSolver(cs *system, witness fr.Vector, opts ...csolver.Option) (*solver,
 	// to ensure we instantiated all wires
 	s.nbSolved += uint64(len(witness) + witnessOffset)
 
-	if s.Type == constraint.SystemR1CS {
+	if s.Type == constraint.SystemR1CS && !opt.StreamingSolver {
+		// in streaming mode the solver checks the constraints on the fly and
+		// does not materialize the a,b,c vectors
 		n := ecc.NextPowerOfTwo(uint64(cs.GetNbConstraints()))
 		s.a = make(fr.Vector, cs.GetNbConstraints(), n)
 		s.b = make(fr.Vector, cs.GetNbConstraints(), n)
@@ -527,7 +529,13 @@ func (solver *solver) run() error {
 // returns true, nil if exactly one wire was solved. In that case, it is redundant to check that
 // the constraint is satisfied later.
 func (solver *solver) solveR1C(cID uint32, r *constraint.R1C) error {
-	a, b, c := &solver.a[cID], &solver.b[cID], &solver.c[cID]
+	// in streaming mode the evaluations live on the stack and are dropped
+	// once the constraint is checked
+	var sa, sb, sc fr.Element
+	a, b, c := &sa, &sb, &sc
+	if solver.a != nil {
+		a, b, c = &solver.a[cID], &solver.b[cID], &solver.c[cID]
+	}
 
 	// the index of the non-zero entry shows if L, R or O has an uninstantiated wire
 	// the content is the ID of the wire non instantiated
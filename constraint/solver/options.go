@@ -15,9 +15,10 @@ type Option func(*Config) error
 
 // Config is the configuration for the solver with the options applied.
 type Config struct {
-	HintFunctions map[HintID]Hint // defaults to all built-in hint functions
-	Logger        zerolog.Logger  // defaults to gnark.Logger
-	NbTasks       int             // defaults to runtime.NumCPU()
+	HintFunctions   map[HintID]Hint // defaults to all built-in hint functions
+	Logger          zerolog.Logger  // defaults to gnark.Logger
+	NbTasks         int             // defaults to runtime.NumCPU()
+	StreamingSolver bool            // defaults to false
 }
 
 // WithHints is a solver option that specifies additional hint functions to be used
@@ -79,6 +80,24 @@ func WithNbTasks(nbTasks int) Option {
 	}
 }
 
+// WithStreamingSolver instructs the solver to check the constraints
+// level-by-level without materializing the per-constraint evaluation vectors.
+// For R1CS this skips the three constraint-sized vectors the solver otherwise
+// keeps alongside the wire assignment, cutting the solver memory overhead to
+// roughly a quarter on large circuits. The returned solution then carries the
+// wire assignment only, so the option applies to witness solving and
+// satisfiability checking; the Groth16 prover consumes the evaluation vectors
+// and returns an error when the option is set.
+//
+// The option is experimental and its scope may grow to spilling cold solver
+// state to disk.
+func WithStreamingSolver() Option {
+	return func(opt *Config) error {
+		opt.StreamingSolver = true
+		return nil
+	}
+}
+
 // NewConfig returns a default SolverConfig with given prover options opts applied.
 func NewConfig(opts ...Option) (Config, error) {
 	log := logger.Logger()
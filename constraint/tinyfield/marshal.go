@@ -13,7 +13,14 @@ import (
 	"github.com/blang/semver/v4"
 )
 
-// WriteTo encodes R1CS into provided io.Writer using cbor
+// WriteTo encodes R1CS into provided io.Writer using cbor.
+//
+// The format is deterministic and versioned: a fixed header carries the total
+// length and the gnark semantic version, followed by the length-prefixed
+// levels, instructions and calldata blocks, the cbor-encoded system body and
+// the raw coefficient table. The blocks are decoded in parallel on read. The
+// stream is not compressed; wrap w in a compressing writer (and the reader
+// symmetrically) when shipping multi-GB systems between machines.
 func (cs *system) WriteTo(w io.Writer) (int64, error) {
 	b, err := cs.System.ToBytes()
 	if err != nil {
@@ -50,7 +57,8 @@ func (cs *system) WriteTo(w io.Writer) (int64, error) {
 	return int64(n+m) + 4*8, err
 }
 
-// ReadFrom attempts to decode R1CS from io.Reader using cbor
+// ReadFrom attempts to decode R1CS from io.Reader using cbor. It rejects
+// streams written by an incompatible gnark version.
 func (cs *system) ReadFrom(r io.Reader) (int64, error) {
 	var totalLen uint64
 	if err := binary.Read(r, binary.LittleEndian, &totalLen); err != nil {
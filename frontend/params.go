@@ -0,0 +1,74 @@
+// Copyright 2020-2024 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package frontend
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+
+	"github.com/consensys/gnark/constraint"
+	"github.com/consensys/gnark/logger"
+	"github.com/fxamacker/cbor/v2"
+)
+
+// Parametrizer must be implemented by circuits whose shape depends on
+// compile-time parameters: slice sizes, loop bounds, optional sub-circuits.
+// It removes the need to maintain one circuit struct per parameterization.
+//
+// ApplyParams is called once, before the circuit structure is parsed, so it
+// may allocate witness slices and store the parameters for use in Define. It
+// must be deterministic: two circuits sized with equal parameters must
+// generate the same constraints.
+type Parametrizer[P any] interface {
+	Circuit
+
+	// ApplyParams sizes the circuit from the given parameters before
+	// constraint generation.
+	ApplyParams(params P) error
+}
+
+// CompileWithParams sizes the circuit with the given parameters and compiles
+// it, like [Compile] does. The parameters are resolved before constraint
+// generation; they are constants of the compiled system, not witness values.
+//
+// The parameters fingerprint (see [ParamsFingerprint]) is logged at compile
+// time; since the proving and verifying keys commit to the compiled
+// constraints, storing the fingerprint alongside the verifying key records
+// which parameterization the key was generated for.
+//
+// The assignment structure must match the compiled circuit; call ApplyParams
+// with the same parameters on the assignment before [NewWitness].
+func CompileWithParams[P any](field *big.Int, newBuilder NewBuilder, circuit Parametrizer[P], params P, opts ...CompileOption) (constraint.ConstraintSystem, error) {
+	if err := circuit.ApplyParams(params); err != nil {
+		return nil, fmt.Errorf("apply params: %w", err)
+	}
+
+	log := logger.Logger()
+	if fp, err := ParamsFingerprint(params); err != nil {
+		log.Warn().Err(err).Msg("could not fingerprint circuit parameters")
+	} else {
+		log.Info().Str("fingerprint", hex.EncodeToString(fp)).Msg("applied circuit parameters")
+	}
+
+	return Compile(field, newBuilder, circuit, opts...)
+}
+
+// ParamsFingerprint returns a deterministic digest of the given compile-time
+// parameters: the sha256 hash of their canonical cbor encoding. Equal
+// parameters always produce the same fingerprint, so it identifies a circuit
+// parameterization across machines and versions of the calling code.
+func ParamsFingerprint(params any) ([]byte, error) {
+	enc, err := cbor.CoreDetEncOptions().EncMode()
+	if err != nil {
+		return nil, err
+	}
+	b, err := enc.Marshal(params)
+	if err != nil {
+		return nil, err
+	}
+	h := sha256.Sum256(b)
+	return h[:], nil
+}
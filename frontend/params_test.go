@@ -0,0 +1,93 @@
+package frontend_test
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/frontend/cs/r1cs"
+	"github.com/consensys/gnark/test"
+)
+
+// sumParams parameterizes sumCircuit: the message length drives the witness
+// size and loop bound, and the domain separator is an optional sub-circuit.
+type sumParams struct {
+	MsgLen           int
+	WithDomainSep    bool
+	DomainSeparation int
+}
+
+type sumCircuit struct {
+	Msg []frontend.Variable
+	Sum frontend.Variable `gnark:",public"`
+
+	params sumParams
+}
+
+func (c *sumCircuit) ApplyParams(p sumParams) error {
+	if p.MsgLen <= 0 {
+		return errors.New("message length must be positive")
+	}
+	c.Msg = make([]frontend.Variable, p.MsgLen)
+	c.params = p
+	return nil
+}
+
+func (c *sumCircuit) Define(api frontend.API) error {
+	sum := frontend.Variable(0)
+	for i := 0; i < c.params.MsgLen; i++ {
+		sum = api.Add(sum, api.Mul(c.Msg[i], c.Msg[i]))
+	}
+	if c.params.WithDomainSep {
+		sum = api.Add(sum, c.params.DomainSeparation)
+	}
+	api.AssertIsEqual(c.Sum, sum)
+	return nil
+}
+
+func TestCompileWithParams(t *testing.T) {
+	assert := test.NewAssert(t)
+
+	params := sumParams{MsgLen: 3, WithDomainSep: true, DomainSeparation: 42}
+	ccs, err := frontend.CompileWithParams(ecc.BN254.ScalarField(), r1cs.NewBuilder, &sumCircuit{}, params)
+	assert.NoError(err)
+	assert.Equal(3, ccs.GetNbSecretVariables())
+
+	// the assignment is sized with the same parameters
+	assignment := &sumCircuit{Sum: 1*1 + 2*2 + 3*3 + 42}
+	assert.NoError(assignment.ApplyParams(params))
+	assignment.Msg[0] = 1
+	assignment.Msg[1] = 2
+	assignment.Msg[2] = 3
+
+	w, err := frontend.NewWitness(assignment, ecc.BN254.ScalarField())
+	assert.NoError(err)
+	_, err = ccs.Solve(w)
+	assert.NoError(err)
+
+	// the optional sub-circuit changes the compiled system
+	withoutDomSep, err := frontend.CompileWithParams(ecc.BN254.ScalarField(), r1cs.NewBuilder, &sumCircuit{}, sumParams{MsgLen: 3})
+	assert.NoError(err)
+	_, err = withoutDomSep.Solve(w)
+	assert.Error(err)
+
+	// invalid parameters are rejected before constraint generation
+	_, err = frontend.CompileWithParams(ecc.BN254.ScalarField(), r1cs.NewBuilder, &sumCircuit{}, sumParams{MsgLen: 0})
+	assert.Error(err)
+}
+
+func TestParamsFingerprint(t *testing.T) {
+	assert := test.NewAssert(t)
+
+	fp1, err := frontend.ParamsFingerprint(sumParams{MsgLen: 3, WithDomainSep: true, DomainSeparation: 42})
+	assert.NoError(err)
+	fp2, err := frontend.ParamsFingerprint(sumParams{MsgLen: 3, WithDomainSep: true, DomainSeparation: 42})
+	assert.NoError(err)
+	assert.True(bytes.Equal(fp1, fp2), "equal parameters must have equal fingerprints")
+
+	fp3, err := frontend.ParamsFingerprint(sumParams{MsgLen: 4, WithDomainSep: true, DomainSeparation: 42})
+	assert.NoError(err)
+	assert.False(bytes.Equal(fp1, fp3), "different parameters must have different fingerprints")
+}
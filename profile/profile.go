@@ -6,9 +6,12 @@ package profile
 
 import (
 	"bytes"
+	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 	"runtime"
+	"sort"
 	"strings"
 	"sync"
 	"sync/atomic"
@@ -156,6 +159,60 @@ func (p *Profile) Top() string {
 	return buf.String()
 }
 
+// TopN returns the n heaviest call frames in a flat text format, similar to
+// the pprof top command. It is a bounded variant of [Profile.Top] meant for
+// assertions in tests and for quickly spotting the dominating sub-gadget of a
+// large circuit.
+func (p *Profile) TopN(n int) string {
+	r := report.NewDefault(&p.pprof, report.Options{
+		OutputFormat:  report.Text,
+		CompactLabels: true,
+		NodeCount:     n,
+		NodeFraction:  0.005,
+		EdgeFraction:  0.001,
+		SampleValue:   func(v []int64) int64 { return v[0] },
+		SampleUnit:    "count",
+	})
+	var buf bytes.Buffer
+	report.Generate(&buf, r)
+	return buf.String()
+}
+
+// WriteFolded writes the profile in the folded stacks format consumed by
+// flamegraph tooling (flamegraph.pl, speedscope, inferno): one line per
+// unique call stack with the frames joined root to leaf by ';', followed by
+// the constraint count. The pprof file written by [Profile.Stop] can
+// alternatively be rendered as a flamegraph with go tool pprof -http.
+func (p *Profile) WriteFolded(w io.Writer) error {
+	counts := make(map[string]int64)
+	var sb strings.Builder
+	for _, s := range p.pprof.Sample {
+		sb.Reset()
+		// sample locations and their inlined lines are leaf first
+		for i := len(s.Location) - 1; i >= 0; i-- {
+			lines := s.Location[i].Line
+			for j := len(lines) - 1; j >= 0; j-- {
+				if sb.Len() > 0 {
+					sb.WriteByte(';')
+				}
+				sb.WriteString(lines[j].Function.Name)
+			}
+		}
+		counts[sb.String()] += s.Value[0]
+	}
+	stacks := make([]string, 0, len(counts))
+	for s := range counts {
+		stacks = append(stacks, s)
+	}
+	sort.Strings(stacks)
+	for _, s := range stacks {
+		if _, err := fmt.Fprintf(w, "%s %d\n", s, counts[s]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // RecordConstraint add a sample (with count == 1) to all the active profiling sessions.
 func RecordConstraint() {
 	if n := atomic.LoadUint32(&activeSessions); n == 0 {
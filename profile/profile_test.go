@@ -3,7 +3,10 @@
 package profile_test
 
 import (
+	"bytes"
 	"fmt"
+	"strings"
+	"testing"
 
 	"github.com/consensys/gnark-crypto/ecc"
 	"github.com/consensys/gnark/frontend"
@@ -59,3 +62,35 @@ func Example() {
 	// Output:
 	// 2
 }
+
+func TestTopNAndFolded(t *testing.T) {
+	p := profile.Start(profile.WithNoOutput())
+	_, _ = frontend.Compile(ecc.BN254.ScalarField(), r1cs.NewBuilder, &Circuit{})
+	p.Stop()
+
+	if p.NbConstraints() != 2 {
+		t.Fatalf("expected 2 constraints, got %d", p.NbConstraints())
+	}
+
+	top := p.TopN(1)
+	if !strings.Contains(top, "AssertIsEqual") && !strings.Contains(top, "Mul") {
+		t.Errorf("TopN misses the heaviest frame:\n%s", top)
+	}
+
+	var buf bytes.Buffer
+	if err := p.WriteFolded(&buf); err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("expected 2 folded stacks, got %d:\n%s", len(lines), buf.String())
+	}
+	for _, line := range lines {
+		if !strings.HasSuffix(line, " 1") {
+			t.Errorf("unexpected folded count in %q", line)
+		}
+		if !strings.Contains(line, "Define;") {
+			t.Errorf("folded stack %q misses the gadget frames", line)
+		}
+	}
+}
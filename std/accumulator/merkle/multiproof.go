@@ -0,0 +1,207 @@
+// Copyright 2020-2024 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package merkle
+
+import (
+	"fmt"
+	"math/bits"
+
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/hash"
+)
+
+// MultiProof stores the root hash and the sibling digests of a Merkle proof
+// in a tree of configurable arity. It generalizes [MerkleProof] to arity-4
+// and arity-8 trees and to variable-depth (padded) proofs; any
+// [hash.FieldHasher] (MiMC, Poseidon2, ...) can be used to recompute the
+// nodes.
+type MultiProof struct {
+
+	// RootHash root of the Merkle tree
+	RootHash frontend.Variable
+
+	// Siblings holds, for each level from the leaves to the root, the
+	// digests of the arity children of the path node's parent, ordered
+	// left to right. The slot at the path node's own position is ignored
+	// by the verifier and may hold any value.
+	Siblings [][]frontend.Variable
+}
+
+// arity returns the tree arity and its base-2 logarithm, and panics if the
+// proof levels are inconsistent or the arity is not a power of two.
+func (mp *MultiProof) arity() (int, int) {
+	if len(mp.Siblings) == 0 {
+		panic("merkle: empty proof")
+	}
+	arity := len(mp.Siblings[0])
+	logArity := bits.Len(uint(arity)) - 1
+	if arity < 2 || arity != 1<<logArity {
+		panic(fmt.Sprintf("merkle: arity %d is not a power of two", arity))
+	}
+	for _, level := range mp.Siblings {
+		if len(level) != arity {
+			panic("merkle: inconsistent arity across proof levels")
+		}
+	}
+	return arity, logArity
+}
+
+// nodeSumN returns the hash of the ordered children of an internal node.
+// Without domain separation.
+func nodeSumN(api frontend.API, h hash.FieldHasher, children ...frontend.Variable) frontend.Variable {
+
+	h.Reset()
+	h.Write(children...)
+	res := h.Sum()
+
+	return res
+}
+
+// oneHot returns the indicator variables of the value decomposed in b
+// (little endian bits): res[j] == 1 iff the value equals j.
+func oneHot(api frontend.API, b []frontend.Variable) []frontend.Variable {
+	res := []frontend.Variable{1}
+	for _, bit := range b {
+		n := len(res)
+		next := make([]frontend.Variable, 2*n)
+		for k, e := range res {
+			next[k+n] = api.Mul(e, bit)
+			next[k] = api.Sub(e, next[k+n])
+		}
+		res = next
+	}
+	return res
+}
+
+// VerifyProof asserts that leaf is stored at the given index of the Merkle
+// tree committed to by mp.RootHash. The tree depth is fixed to
+// len(mp.Siblings); one circuit is generated per depth and arity.
+func (mp *MultiProof) VerifyProof(api frontend.API, h hash.FieldHasher, index, leaf frontend.Variable) {
+	sum := mp.computeRoot(api, h, index, leaf, len(mp.Siblings))
+
+	// Compare our calculated Merkle root to the desired Merkle root.
+	api.AssertIsEqual(sum, mp.RootHash)
+}
+
+// VerifyProofAtDepth asserts that leaf is stored at the given index of a
+// Merkle tree of the given depth, committed to by mp.RootHash. The depth is a
+// circuit variable in [1, len(mp.Siblings)]; the proof path is padded to
+// len(mp.Siblings) levels and the padding levels are ignored, so a single
+// circuit verifies proofs for trees of different heights.
+func (mp *MultiProof) VerifyProofAtDepth(api frontend.API, h hash.FieldHasher, index, depth, leaf frontend.Variable) {
+	arity, logArity := mp.arity()
+	maxDepth := len(mp.Siblings)
+	indexBits := api.ToBinary(index, maxDepth*logArity)
+
+	sum := leafSum(api, h, leaf)
+	res := frontend.Variable(0)
+	found := frontend.Variable(0)
+	for i := 0; i < maxDepth; i++ {
+		levelBits := indexBits[i*logArity : (i+1)*logArity]
+
+		// the levels at or above the claimed depth only feed the discarded
+		// padding hashes, so their index bits would otherwise be free; pin
+		// them to zero to constrain the index to [0, arity^depth)
+		for _, bit := range levelBits {
+			api.AssertIsEqual(api.Mul(found, bit), 0)
+		}
+
+		e := oneHot(api, levelBits)
+		children := make([]frontend.Variable, arity)
+		for j := 0; j < arity; j++ {
+			// children[j] = sum at the path position, the sibling elsewhere
+			children[j] = api.Add(mp.Siblings[i][j], api.Mul(e[j], api.Sub(sum, mp.Siblings[i][j])))
+		}
+		sum = nodeSumN(api, h, children...)
+
+		// capture the candidate root when we reach the claimed depth
+		isDepth := api.IsZero(api.Sub(depth, i+1))
+		res = api.Select(isDepth, sum, res)
+		found = api.Add(found, isDepth)
+	}
+
+	// ensures depth is in [1, maxDepth]
+	api.AssertIsEqual(found, 1)
+	api.AssertIsEqual(res, mp.RootHash)
+}
+
+// computeRoot recomputes the root digest from the leaf and the first depth
+// levels of the proof path.
+func (mp *MultiProof) computeRoot(api frontend.API, h hash.FieldHasher, index, leaf frontend.Variable, depth int) frontend.Variable {
+	arity, logArity := mp.arity()
+	indexBits := api.ToBinary(index, depth*logArity)
+
+	sum := leafSum(api, h, leaf)
+	for i := 0; i < depth; i++ {
+		e := oneHot(api, indexBits[i*logArity:(i+1)*logArity])
+		children := make([]frontend.Variable, arity)
+		for j := 0; j < arity; j++ {
+			children[j] = api.Add(mp.Siblings[i][j], api.Mul(e[j], api.Sub(sum, mp.Siblings[i][j])))
+		}
+		sum = nodeSumN(api, h, children...)
+	}
+	return sum
+}
+
+// AssertMembershipBatch asserts that, for each i, leaves[i] is stored at
+// index indices[i] of the Merkle tree committed to by root. The siblings
+// parameter holds one proof path per leaf, with the per-level layout of
+// [MultiProof.Siblings]; all paths must have the same depth and arity.
+//
+// The indices are compile-time constants. This lets the gadget place each
+// recomputed node at a static position — no selectors are needed — and share
+// the recomputation of the internal nodes above the point where two proof
+// paths meet, so the constraint count decreases when the leaves cluster in
+// the same subtree.
+func AssertMembershipBatch(api frontend.API, h hash.FieldHasher, root frontend.Variable, indices []uint64, leaves []frontend.Variable, siblings [][][]frontend.Variable) {
+	if len(indices) != len(leaves) || len(indices) != len(siblings) {
+		panic("merkle: indices, leaves and siblings must have the same length")
+	}
+	if len(indices) == 0 {
+		return
+	}
+	depth := len(siblings[0])
+
+	type node struct {
+		level int
+		index uint64
+	}
+	computed := make(map[node]frontend.Variable)
+
+	refArity := len(siblings[0][0])
+
+	for k := range leaves {
+		mp := MultiProof{RootHash: root, Siblings: siblings[k]}
+		arity, logArity := mp.arity()
+		if len(mp.Siblings) != depth || arity != refArity {
+			panic("merkle: inconsistent depth or arity across batch proofs")
+		}
+		if indices[k]>>(depth*logArity) != 0 {
+			panic(fmt.Sprintf("merkle: index %d out of range for depth %d", indices[k], depth))
+		}
+
+		sum := leafSum(api, h, leaves[k])
+		idx := indices[k]
+		merged := false
+		for i := 0; i < depth; i++ {
+			if prev, ok := computed[node{i, idx}]; ok {
+				// an earlier proof already recomputed this node and the
+				// levels above it; tie the two paths together and stop.
+				api.AssertIsEqual(sum, prev)
+				merged = true
+				break
+			}
+			computed[node{i, idx}] = sum
+
+			children := make([]frontend.Variable, arity)
+			copy(children, mp.Siblings[i])
+			children[idx%uint64(arity)] = sum
+			sum = nodeSumN(api, h, children...)
+			idx /= uint64(arity)
+		}
+		if !merged {
+			api.AssertIsEqual(sum, root)
+		}
+	}
+}
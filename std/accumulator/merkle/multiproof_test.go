@@ -0,0 +1,263 @@
+// Copyright 2020-2024 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package merkle
+
+import (
+	"crypto/rand"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/hash"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/frontend/cs/r1cs"
+	"github.com/consensys/gnark/std/hash/mimc"
+	"github.com/consensys/gnark/test"
+)
+
+// buildTestTree builds an arity-ary Merkle tree over the given leaf data and
+// returns the node digests per level, leaves first, root last.
+func buildTestTree(h hash.Hash, arity int, leaves [][]byte) [][][]byte {
+	hGo := h.New()
+	digest := func(data ...[]byte) []byte {
+		hGo.Reset()
+		for _, d := range data {
+			hGo.Write(d)
+		}
+		return hGo.Sum(nil)
+	}
+
+	cur := make([][]byte, len(leaves))
+	for i, l := range leaves {
+		cur[i] = digest(l)
+	}
+	levels := [][][]byte{cur}
+	for len(cur) > 1 {
+		next := make([][]byte, len(cur)/arity)
+		for i := range next {
+			next[i] = digest(cur[i*arity : (i+1)*arity]...)
+		}
+		levels = append(levels, next)
+		cur = next
+	}
+	return levels
+}
+
+// proofSiblings extracts the proof path for the given leaf index: per level,
+// the digests of all children of the path node's parent.
+func proofSiblings(levels [][][]byte, arity int, index uint64) [][]frontend.Variable {
+	depth := len(levels) - 1
+	siblings := make([][]frontend.Variable, depth)
+	idx := index
+	for i := 0; i < depth; i++ {
+		siblings[i] = make([]frontend.Variable, arity)
+		base := (idx / uint64(arity)) * uint64(arity)
+		for j := 0; j < arity; j++ {
+			siblings[i][j] = levels[i][base+uint64(j)]
+		}
+		idx /= uint64(arity)
+	}
+	return siblings
+}
+
+func randomLeaves(t *testing.T, n int) [][]byte {
+	t.Helper()
+	mod := ecc.BN254.ScalarField()
+	modNbBytes := len(mod.Bytes())
+	leaves := make([][]byte, n)
+	for i := range leaves {
+		l, err := rand.Int(rand.Reader, mod)
+		if err != nil {
+			t.Fatal(err)
+		}
+		b := l.Bytes()
+		leaves[i] = append(make([]byte, modNbBytes-len(b)), b...)
+	}
+	return leaves
+}
+
+type multiProofTest struct {
+	M     MultiProof
+	Index frontend.Variable
+	Leaf  frontend.Variable
+}
+
+func (mp *multiProofTest) Define(api frontend.API) error {
+	h, err := mimc.NewMiMC(api)
+	if err != nil {
+		return err
+	}
+	mp.M.VerifyProof(api, &h, mp.Index, mp.Leaf)
+	return nil
+}
+
+func newMultiProofTest(arity, depth int) *multiProofTest {
+	var c multiProofTest
+	c.M.Siblings = make([][]frontend.Variable, depth)
+	for i := range c.M.Siblings {
+		c.M.Siblings[i] = make([]frontend.Variable, arity)
+	}
+	return &c
+}
+
+func TestVerifyMultiProof(t *testing.T) {
+	assert := test.NewAssert(t)
+
+	for _, arity := range []int{2, 4, 8} {
+		depth := 2
+		numLeaves := arity * arity
+
+		leaves := randomLeaves(t, numLeaves)
+		levels := buildTestTree(hash.MIMC_BN254, arity, leaves)
+
+		circuit := newMultiProofTest(arity, depth)
+
+		for proofIndex := uint64(0); proofIndex < uint64(numLeaves); proofIndex++ {
+			witness := newMultiProofTest(arity, depth)
+			witness.Index = proofIndex
+			witness.Leaf = leaves[proofIndex]
+			witness.M.RootHash = levels[depth][0]
+			witness.M.Siblings = proofSiblings(levels, arity, proofIndex)
+
+			if proofIndex > 1 {
+				assert.CheckCircuit(circuit, test.WithValidAssignment(witness), test.WithCurves(ecc.BN254), test.NoProverChecks())
+			} else {
+				assert.CheckCircuit(circuit, test.WithValidAssignment(witness), test.WithCurves(ecc.BN254))
+			}
+		}
+	}
+}
+
+type multiProofAtDepthTest struct {
+	M     MultiProof
+	Index frontend.Variable
+	Depth frontend.Variable
+	Leaf  frontend.Variable
+}
+
+func (mp *multiProofAtDepthTest) Define(api frontend.API) error {
+	h, err := mimc.NewMiMC(api)
+	if err != nil {
+		return err
+	}
+	mp.M.VerifyProofAtDepth(api, &h, mp.Index, mp.Depth, mp.Leaf)
+	return nil
+}
+
+func TestVerifyMultiProofAtDepth(t *testing.T) {
+	assert := test.NewAssert(t)
+
+	const (
+		arity    = 4
+		depth    = 2
+		maxDepth = 3
+	)
+	numLeaves := arity * arity
+	proofIndex := uint64(6)
+
+	leaves := randomLeaves(t, numLeaves)
+	levels := buildTestTree(hash.MIMC_BN254, arity, leaves)
+
+	newCircuit := func() *multiProofAtDepthTest {
+		var c multiProofAtDepthTest
+		c.M.Siblings = make([][]frontend.Variable, maxDepth)
+		for i := range c.M.Siblings {
+			c.M.Siblings[i] = make([]frontend.Variable, arity)
+		}
+		return &c
+	}
+
+	// the proof path is padded with zeroes beyond the actual tree depth
+	witness := newCircuit()
+	witness.Index = proofIndex
+	witness.Depth = depth
+	witness.Leaf = leaves[proofIndex]
+	witness.M.RootHash = levels[depth][0]
+	copy(witness.M.Siblings, proofSiblings(levels, arity, proofIndex))
+	for j := 0; j < arity; j++ {
+		witness.M.Siblings[maxDepth-1][j] = 0
+	}
+
+	// a wrong depth must not verify
+	badWitness := newCircuit()
+	*badWitness = *witness
+	badWitness.Depth = depth + 1
+
+	// an index shifted by arity^depth must not verify either: the shifted
+	// bits only select among the discarded padding levels
+	badIndexWitness := newCircuit()
+	*badIndexWitness = *witness
+	badIndexWitness.Index = proofIndex + uint64(numLeaves)
+
+	assert.CheckCircuit(newCircuit(), test.WithValidAssignment(witness), test.WithInvalidAssignment(badWitness), test.WithInvalidAssignment(badIndexWitness), test.WithCurves(ecc.BN254))
+}
+
+type batchTest struct {
+	Root     frontend.Variable
+	Leaves   []frontend.Variable
+	Siblings [][][]frontend.Variable
+
+	indices []uint64
+}
+
+func (c *batchTest) Define(api frontend.API) error {
+	h, err := mimc.NewMiMC(api)
+	if err != nil {
+		return err
+	}
+	AssertMembershipBatch(api, &h, c.Root, c.indices, c.Leaves, c.Siblings)
+	return nil
+}
+
+func newBatchTest(arity, depth int, indices []uint64) *batchTest {
+	c := batchTest{
+		Leaves:   make([]frontend.Variable, len(indices)),
+		Siblings: make([][][]frontend.Variable, len(indices)),
+		indices:  indices,
+	}
+	for k := range c.Siblings {
+		c.Siblings[k] = make([][]frontend.Variable, depth)
+		for i := range c.Siblings[k] {
+			c.Siblings[k][i] = make([]frontend.Variable, arity)
+		}
+	}
+	return &c
+}
+
+func TestAssertMembershipBatch(t *testing.T) {
+	assert := test.NewAssert(t)
+
+	const (
+		arity = 4
+		depth = 2
+	)
+	numLeaves := arity * arity
+	indices := []uint64{0, 1, 9}
+
+	leaves := randomLeaves(t, numLeaves)
+	levels := buildTestTree(hash.MIMC_BN254, arity, leaves)
+
+	circuit := newBatchTest(arity, depth, indices)
+	witness := newBatchTest(arity, depth, indices)
+	witness.Root = levels[depth][0]
+	for k, idx := range indices {
+		witness.Leaves[k] = leaves[idx]
+		witness.Siblings[k] = proofSiblings(levels, arity, idx)
+	}
+
+	// a leaf that is not a member must not verify
+	badWitness := newBatchTest(arity, depth, indices)
+	*badWitness = *witness
+	badWitness.Leaves = append([]frontend.Variable{}, witness.Leaves...)
+	badWitness.Leaves[2] = leaves[10]
+
+	assert.CheckCircuit(circuit, test.WithValidAssignment(witness), test.WithInvalidAssignment(badWitness), test.WithCurves(ecc.BN254))
+
+	// proofs clustered in the same subtree share the upper levels of the
+	// recomputation, so they cost fewer constraints than scattered ones
+	clustered, err := frontend.Compile(ecc.BN254.ScalarField(), r1cs.NewBuilder, newBatchTest(arity, depth, []uint64{0, 1}))
+	assert.NoError(err)
+	scattered, err := frontend.Compile(ecc.BN254.ScalarField(), r1cs.NewBuilder, newBatchTest(arity, depth, []uint64{0, 9}))
+	assert.NoError(err)
+	assert.Less(clustered.GetNbConstraints(), scattered.GetNbConstraints())
+}
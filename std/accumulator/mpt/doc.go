@@ -0,0 +1,12 @@
+// Package mpt implements verification of Merkle-Patricia trie proofs as used
+// for the Ethereum state and storage tries. The nodes are RLP-encoded and
+// chained by their Keccak-256 digests; the gadget walks the branch, extension
+// and leaf nodes from the root to the proven key/value pair.
+//
+// The RLP structure of every node is recovered by a hint and fully verified
+// in-circuit: the item prefixes, lengths and boundaries are constrained to be
+// the canonical encoding of the node bytes. Only inclusion proofs with hashed
+// child references are supported — nodes shorter than 32 bytes embedded
+// directly in their parent (which do not occur in the account trie and are
+// rare in storage tries) are rejected.
+package mpt
@@ -0,0 +1,80 @@
+package mpt
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/consensys/gnark/constraint/solver"
+)
+
+func init() {
+	solver.RegisterHint(GetHints()...)
+}
+
+// GetHints returns all hint functions used in the package.
+func GetHints() []solver.Hint {
+	return []solver.Hint{parseNodeHint}
+}
+
+// parseNodeHint parses the RLP structure of a trie node given as bytes. It
+// outputs the number of items followed by (offset, content length, prefix
+// length) for each of the up to 17 items; unused slots are zero. The outputs
+// are untrusted and verified in-circuit against the node bytes.
+func parseNodeHint(_ *big.Int, inputs, outputs []*big.Int) error {
+	if len(outputs) != 1+3*maxItems {
+		return fmt.Errorf("expected %d outputs, got %d", 1+3*maxItems, len(outputs))
+	}
+	node := make([]byte, len(inputs))
+	for i, in := range inputs {
+		if !in.IsUint64() || in.Uint64() > 255 {
+			return fmt.Errorf("input %d is not a byte", i)
+		}
+		node[i] = byte(in.Uint64())
+	}
+	if len(node) == 0 || node[0] < 0xc0 {
+		return fmt.Errorf("node is not an RLP list")
+	}
+	pos := 1
+	if node[0] > 0xf7 {
+		pos += int(node[0] - 0xf7)
+	}
+	for i := range outputs {
+		outputs[i].SetInt64(0)
+	}
+	count := 0
+	for pos < len(node) {
+		if count == maxItems {
+			return fmt.Errorf("node has more than %d items", maxItems)
+		}
+		b := node[pos]
+		var plen, clen int
+		switch {
+		case b < 0x80:
+			// single byte, the prefix is the content itself
+			plen, clen = 0, 1
+		case b <= 0xb7:
+			plen, clen = 1, int(b-0x80)
+		case b <= 0xbf:
+			n := int(b - 0xb7)
+			if pos+1+n > len(node) {
+				return fmt.Errorf("length prefix out of bounds")
+			}
+			for k := 0; k < n; k++ {
+				clen = clen<<8 | int(node[pos+1+k])
+			}
+			plen = 1 + n
+		default:
+			return fmt.Errorf("embedded (unhashed) child nodes are not supported")
+		}
+		outputs[1+3*count].SetInt64(int64(pos))
+		outputs[2+3*count].SetInt64(int64(clen))
+		outputs[3+3*count].SetInt64(int64(plen))
+		pos += plen + clen
+		count++
+	}
+	if pos != len(node) {
+		return fmt.Errorf("malformed node: items overflow the list payload")
+	}
+	outputs[0].SetInt64(int64(count))
+	return nil
+}
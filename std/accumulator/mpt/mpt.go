@@ -0,0 +1,221 @@
+package mpt
+
+import (
+	"fmt"
+
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/hash/sha3"
+	"github.com/consensys/gnark/std/math/bits"
+	"github.com/consensys/gnark/std/math/uints"
+	"github.com/consensys/gnark/std/selector"
+)
+
+// maxItems is the number of items of a branch node: 16 children and a value.
+const maxItems = 17
+
+// VerifyInclusion asserts that the key/value pair is included in the
+// Merkle-Patricia trie with the given root. proof contains the RLP-encoded
+// nodes on the path from the root node to the leaf holding the value, as
+// returned for example by eth_getProof; value is the content of the leaf's
+// value item (for storage tries the RLP encoding of the stored value). The
+// byte lengths of the key, the value and the proof nodes are compile-time
+// constants.
+func VerifyInclusion(api frontend.API, root [32]uints.U8, key []uints.U8, value []uints.U8, proof [][]uints.U8) error {
+	if len(proof) == 0 {
+		return fmt.Errorf("empty proof")
+	}
+	// the key is traversed nibble by nibble, most significant first
+	keyNibbles := make([]frontend.Variable, 2*len(key))
+	for i := range key {
+		hi, lo := byteNibbles(api, key[i].Val)
+		keyNibbles[2*i] = hi
+		keyNibbles[2*i+1] = lo
+	}
+	expected := make([]frontend.Variable, 32)
+	for i := range expected {
+		expected[i] = root[i].Val
+	}
+	var nibbleIdx frontend.Variable = 0
+	for n := range proof {
+		last := n == len(proof)-1
+		var err error
+		expected, nibbleIdx, err = verifyNode(api, proof[n], expected, keyNibbles, nibbleIdx, value, last)
+		if err != nil {
+			return fmt.Errorf("node %d: %w", n, err)
+		}
+	}
+	// the whole key must be consumed by the path
+	api.AssertIsEqual(nibbleIdx, len(keyNibbles))
+	return nil
+}
+
+// verifyNode checks a single node: its Keccak digest matches the reference in
+// the parent, its RLP structure is canonical and the key path continues
+// through it. It returns the child reference and the updated number of
+// consumed key nibbles.
+func verifyNode(api frontend.API, node []uints.U8, expected, keyNibbles []frontend.Variable, nibbleIdx frontend.Variable, value []uints.U8, last bool) ([]frontend.Variable, frontend.Variable, error) {
+	length := len(node)
+
+	// the node hashes to the reference held by the parent (or the root)
+	h, err := sha3.NewLegacyKeccak256(api)
+	if err != nil {
+		return nil, nil, fmt.Errorf("new keccak: %w", err)
+	}
+	h.Write(node)
+	digest := h.Sum()
+	for i := range expected {
+		api.AssertIsEqual(digest[i].Val, expected[i])
+	}
+
+	// the list header is determined by the compile-time node length
+	header, err := listHeader(length)
+	if err != nil {
+		return nil, nil, err
+	}
+	for i, b := range header {
+		api.AssertIsEqual(node[i].Val, b)
+	}
+
+	vals := make([]frontend.Variable, length)
+	nibbleVals := make([]frontend.Variable, 2*length)
+	for i := range node {
+		vals[i] = node[i].Val
+		nibbleVals[2*i], nibbleVals[2*i+1] = byteNibbles(api, node[i].Val)
+	}
+
+	// recover the item boundaries with a hint and verify them against the
+	// canonical RLP encoding
+	outs, err := api.Compiler().NewHint(parseNodeHint, 1+3*maxItems, vals...)
+	if err != nil {
+		return nil, nil, fmt.Errorf("parse node hint: %w", err)
+	}
+	count := outs[0]
+	// a node is either a branch (17 items) or an extension/leaf (2 items)
+	api.AssertIsEqual(api.Mul(api.Sub(count, 2), api.Sub(count, maxItems)), 0)
+	isBranch := api.IsZero(api.Sub(count, maxItems))
+	twoItem := api.Sub(1, isBranch)
+
+	offs := make([]frontend.Variable, maxItems)
+	clens := make([]frontend.Variable, maxItems)
+	plens := make([]frontend.Variable, maxItems)
+	cur := frontend.Variable(len(header))
+	for i := 0; i < maxItems; i++ {
+		offs[i], clens[i], plens[i] = outs[1+3*i], outs[2+3*i], outs[3+3*i]
+		var active frontend.Variable = 1
+		if i >= 2 {
+			active = isBranch
+		}
+		// the item starts where the previous one ended
+		api.AssertIsEqual(api.Mul(active, api.Sub(offs[i], cur)), 0)
+		b0 := selector.Mux(api, offs[i], vals...)
+		p0 := api.IsZero(plens[i])
+		p1 := api.IsZero(api.Sub(plens[i], 1))
+		p2 := api.IsZero(api.Sub(plens[i], 2))
+		api.AssertIsEqual(api.Add(p0, p1, p2), 1)
+		// single byte below 0x80: the byte is the content itself
+		s0 := api.Mul(active, p0)
+		api.AssertIsEqual(api.Mul(s0, api.Sub(clens[i], 1)), 0)
+		api.AssertIsLessOrEqual(api.Select(s0, b0, 0), 0x7f)
+		// short string: the prefix encodes the content length
+		s1 := api.Mul(active, p1)
+		api.AssertIsEqual(api.Mul(s1, api.Sub(b0, api.Add(clens[i], 0x80))), 0)
+		api.AssertIsLessOrEqual(api.Select(s1, clens[i], 0), 55)
+		// long string: 0xb8 followed by the one-byte content length
+		s2 := api.Mul(active, p2)
+		api.AssertIsEqual(api.Mul(s2, api.Sub(b0, 0xb8)), 0)
+		b1 := selector.Mux(api, api.Select(s2, api.Add(offs[i], 1), 0), vals...)
+		api.AssertIsEqual(api.Mul(s2, api.Sub(b1, clens[i])), 0)
+		api.AssertIsLessOrEqual(api.Sub(api.Select(s2, clens[i], 56), 56), 199)
+		cur = api.Select(active, api.Add(cur, plens[i], clens[i]), cur)
+	}
+	// the items fill the list payload exactly
+	api.AssertIsEqual(cur, length)
+
+	// branch node: descend into the child selected by the current key nibble
+	curNibble := selector.Mux(api, api.Select(isBranch, nibbleIdx, 0), keyNibbles...)
+	branchSel := api.Select(isBranch, curNibble, 0)
+	branchOff := selector.Mux(api, branchSel, offs[:16]...)
+	branchClen := selector.Mux(api, branchSel, clens[:16]...)
+	branchPlen := selector.Mux(api, branchSel, plens[:16]...)
+
+	// extension/leaf node: the first item is the hex-prefix encoded path
+	flagIdx := api.Select(twoItem, api.Add(offs[0], plens[0]), 0)
+	flags := selector.Mux(api, flagIdx, vals...)
+	fb := bits.ToBinary(api, flags, bits.WithNbDigits(8))
+	isOdd := fb[4]
+	isLeaf := fb[5]
+	api.AssertIsEqual(api.Mul(twoItem, fb[6]), 0)
+	api.AssertIsEqual(api.Mul(twoItem, fb[7]), 0)
+	// for an even path length the low nibble of the flags byte is zero
+	loFlags := bits.FromBinary(api, fb[:4])
+	api.AssertIsEqual(api.Mul(twoItem, api.Sub(1, isOdd), loFlags), 0)
+	if last {
+		// the proof ends in a leaf
+		api.AssertIsEqual(isBranch, 0)
+		api.AssertIsEqual(isLeaf, 1)
+	} else {
+		// inner two-item nodes are extensions
+		api.AssertIsEqual(api.Mul(twoItem, isLeaf), 0)
+	}
+
+	// the path nibbles continue the key at the current position. The path is
+	// the nibble stream of the first item's content without the flag nibbles:
+	// it starts at item nibble 2 for an even length and 1 for an odd one.
+	pathCount := api.Add(api.Mul(2, api.Sub(clens[0], 1)), isOdd)
+	pathBase := api.Add(api.Mul(2, api.Add(offs[0], plens[0])), api.Sub(2, isOdd))
+	active := twoItem
+	for j := 0; j < len(keyNibbles); j++ {
+		active = api.Mul(active, api.Sub(1, api.IsZero(api.Sub(pathCount, j))))
+		pathNib := selector.Mux(api, api.Select(active, api.Add(pathBase, j), 0), nibbleVals...)
+		keyNib := selector.Mux(api, api.Select(active, api.Add(nibbleIdx, j), 0), keyNibbles...)
+		api.AssertIsEqual(api.Mul(active, api.Sub(pathNib, keyNib)), 0)
+	}
+	nibbleIdx = api.Add(nibbleIdx, api.Select(isBranch, 1, pathCount))
+
+	if last {
+		// the second item of the leaf is the proven value
+		api.AssertIsEqual(clens[1], len(value))
+		for j := range value {
+			b := selector.Mux(api, api.Add(offs[1], plens[1], j), vals...)
+			api.AssertIsEqual(b, value[j].Val)
+		}
+		return expected, nibbleIdx, nil
+	}
+
+	// the child reference is a 32-byte hash, either the selected branch child
+	// or the second item of the extension
+	childOff := api.Select(isBranch, branchOff, offs[1])
+	childClen := api.Select(isBranch, branchClen, clens[1])
+	childPlen := api.Select(isBranch, branchPlen, plens[1])
+	api.AssertIsEqual(childClen, 32)
+	api.AssertIsEqual(childPlen, 1)
+	next := make([]frontend.Variable, 32)
+	for j := range next {
+		next[j] = selector.Mux(api, api.Add(childOff, 1+j), vals...)
+	}
+	return next, nibbleIdx, nil
+}
+
+// byteNibbles splits a byte into its high and low nibbles, range checking it
+// on the way.
+func byteNibbles(api frontend.API, b frontend.Variable) (hi, lo frontend.Variable) {
+	bts := bits.ToBinary(api, b, bits.WithNbDigits(8))
+	lo = bits.FromBinary(api, bts[:4])
+	hi = bits.FromBinary(api, bts[4:])
+	return hi, lo
+}
+
+// listHeader returns the canonical RLP list header for a node of the given
+// total length.
+func listHeader(length int) ([]byte, error) {
+	if p := length - 1; p >= 0 && p <= 55 {
+		return []byte{0xc0 + byte(p)}, nil
+	}
+	if p := length - 2; p >= 56 && p <= 255 {
+		return []byte{0xf8, byte(p)}, nil
+	}
+	if p := length - 3; p >= 256 && p <= 65535 {
+		return []byte{0xf9, byte(p >> 8), byte(p)}, nil
+	}
+	return nil, fmt.Errorf("node length %d has no canonical list header", length)
+}
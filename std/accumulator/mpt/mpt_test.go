@@ -0,0 +1,130 @@
+package mpt
+
+import (
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/math/uints"
+	"github.com/consensys/gnark/test"
+	"golang.org/x/crypto/sha3"
+)
+
+type mptCircuit struct {
+	Root  [32]uints.U8
+	Key   []uints.U8
+	Value []uints.U8
+	Proof [][]uints.U8
+}
+
+func (c *mptCircuit) Define(api frontend.API) error {
+	return VerifyInclusion(api, c.Root, c.Key, c.Value, c.Proof)
+}
+
+func rlpString(b []byte) []byte {
+	if len(b) == 1 && b[0] < 0x80 {
+		return b
+	}
+	if len(b) <= 55 {
+		return append([]byte{0x80 + byte(len(b))}, b...)
+	}
+	if len(b) <= 255 {
+		return append([]byte{0xb8, byte(len(b))}, b...)
+	}
+	panic("string too long")
+}
+
+func rlpList(items ...[]byte) []byte {
+	var payload []byte
+	for _, item := range items {
+		payload = append(payload, item...)
+	}
+	var header []byte
+	switch {
+	case len(payload) <= 55:
+		header = []byte{0xc0 + byte(len(payload))}
+	case len(payload) <= 255:
+		header = []byte{0xf8, byte(len(payload))}
+	default:
+		header = []byte{0xf9, byte(len(payload) >> 8), byte(len(payload))}
+	}
+	return append(header, payload...)
+}
+
+func keccak(b []byte) []byte {
+	h := sha3.NewLegacyKeccak256()
+	h.Write(b)
+	return h.Sum(nil)
+}
+
+// TestVerifyInclusion builds a storage trie with the layout produced by
+// eth_getProof: an extension over the shared key prefix, a branch and the
+// leaves holding the RLP-encoded values.
+func TestVerifyInclusion(t *testing.T) {
+	assert := test.NewAssert(t)
+
+	key := make([]byte, 32)
+	key[0], key[1] = 0xab, 0x1c
+	for i := 2; i < len(key); i++ {
+		key[i] = byte(7 * i)
+	}
+	// a sibling key sharing the first two nibbles, diverging at the third
+	key2 := append([]byte{}, key...)
+	key2[1] = 0x5d
+
+	// leaf values are the RLP encoding of the stored words
+	value := rlpString([]byte{0xde, 0xad, 0xbe, 0xef})
+	value2 := rlpString([]byte{0x2a})
+
+	// leaves consume the 61 nibbles remaining after the extension (2) and the
+	// branch (1); odd length, so the first path nibble shares the flags byte
+	leafPath := append([]byte{0x30 | key[1]&0x0f}, key[2:]...)
+	leaf := rlpList(rlpString(leafPath), rlpString(value))
+	leafPath2 := append([]byte{0x30 | key2[1]&0x0f}, key2[2:]...)
+	leaf2 := rlpList(rlpString(leafPath2), rlpString(value2))
+
+	branchItems := make([][]byte, 17)
+	for i := range branchItems {
+		branchItems[i] = rlpString(nil)
+	}
+	branchItems[1] = rlpString(keccak(leaf))
+	branchItems[5] = rlpString(keccak(leaf2))
+	branch := rlpList(branchItems...)
+
+	// extension over the two shared nibbles [a b]
+	ext := rlpList(rlpString([]byte{0x00, 0xab}), rlpString(keccak(branch)))
+	root := keccak(ext)
+
+	proof := [][]byte{ext, branch, leaf}
+
+	circuit := mptCircuit{
+		Key:   make([]uints.U8, len(key)),
+		Value: make([]uints.U8, len(value)),
+		Proof: make([][]uints.U8, len(proof)),
+	}
+	assignment := mptCircuit{
+		Key:   uints.NewU8Array(key),
+		Value: uints.NewU8Array(value),
+		Proof: make([][]uints.U8, len(proof)),
+	}
+	copy(assignment.Root[:], uints.NewU8Array(root))
+	for i := range proof {
+		circuit.Proof[i] = make([]uints.U8, len(proof[i]))
+		assignment.Proof[i] = uints.NewU8Array(proof[i])
+	}
+	assert.NoError(test.IsSolved(&circuit, &assignment, ecc.BN254.ScalarField()))
+
+	// a proof for a different value must fail
+	badAssignment := assignment
+	badValue := append([]byte{}, value...)
+	badValue[1] ^= 1
+	badAssignment.Value = uints.NewU8Array(badValue)
+	assert.Error(test.IsSolved(&circuit, &badAssignment, ecc.BN254.ScalarField()))
+
+	// tampering with a node breaks the hash chain
+	badAssignment = assignment
+	badNode := append([]byte{}, branch...)
+	badNode[len(badNode)-1] ^= 1
+	badAssignment.Proof = [][]uints.U8{assignment.Proof[0], uints.NewU8Array(badNode), assignment.Proof[2]}
+	assert.Error(test.IsSolved(&circuit, &badAssignment, ecc.BN254.ScalarField()))
+}
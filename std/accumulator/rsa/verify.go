@@ -0,0 +1,40 @@
+// Package rsa provides a ZKP-circuit function to verify RSA accumulator
+// membership witnesses.
+//
+// An RSA accumulator over a group of unknown order (an RSA group Z_N^* or a
+// class group) compresses a set {e_1, ..., e_n} into a single group element
+//
+//	A = g^(e_1 · e_2 · ... · e_n) mod N.
+//
+// The membership witness for e_i is the accumulator of the remaining set,
+// w_i = g^(∏_{j≠i} e_j) mod N, and membership is checked by the relation
+// w_i^(e_i) ≡ A (mod N). Soundness of the accumulator additionally requires
+// the accumulated representatives to be primes; this mapping is performed
+// off-circuit and is not checked by this gadget.
+package rsa
+
+import (
+	"fmt"
+
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/math/emulated"
+)
+
+// VerifyMembership checks the RSA accumulator membership relation
+//
+//	witness^element ≡ accumulator (mod modulus).
+//
+// All operands are given as emulated elements over the type parameter T, which
+// acts only as an upper bound on the operand sizes: the modulus is a circuit
+// witness, not the field modulus. Use [emparams.Mod1e512] or
+// [emparams.Mod1e4096] depending on the RSA modulus size. Circuit complexity
+// depends on the size of T rather than on the actual length of the modulus.
+func VerifyMembership[T emulated.FieldParams](api frontend.API, accumulator, element, witness, modulus emulated.Element[T]) error {
+	f, err := emulated.NewField[T](api)
+	if err != nil {
+		return fmt.Errorf("new field: %w", err)
+	}
+	res := f.ModExp(&witness, &element, &modulus)
+	f.ModAssertIsEqual(res, &accumulator, &modulus)
+	return nil
+}
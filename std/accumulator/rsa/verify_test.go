@@ -0,0 +1,72 @@
+package rsa
+
+import (
+	"crypto/rand"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/math/emulated"
+	"github.com/consensys/gnark/std/math/emulated/emparams"
+	"github.com/consensys/gnark/test"
+)
+
+type membershipCircuit[T emulated.FieldParams] struct {
+	Accumulator emulated.Element[T]
+	Element     emulated.Element[T]
+	Witness     emulated.Element[T]
+	Modulus     emulated.Element[T]
+}
+
+func (c *membershipCircuit[T]) Define(api frontend.API) error {
+	return VerifyMembership(api, c.Accumulator, c.Element, c.Witness, c.Modulus)
+}
+
+func TestVerifyMembership(t *testing.T) {
+	assert := test.NewAssert(t)
+
+	// native RSA accumulator over N = p·q with three accumulated primes
+	p, err := rand.Prime(rand.Reader, 256)
+	assert.NoError(err)
+	q, err := rand.Prime(rand.Reader, 256)
+	assert.NoError(err)
+	n := new(big.Int).Mul(p, q)
+	g, err := rand.Int(rand.Reader, n)
+	assert.NoError(err)
+
+	members := make([]*big.Int, 3)
+	for i := range members {
+		members[i], err = rand.Prime(rand.Reader, 128)
+		assert.NoError(err)
+	}
+	prod := big.NewInt(1)
+	for _, m := range members {
+		prod.Mul(prod, m)
+	}
+	accumulator := new(big.Int).Exp(g, prod, n)
+	// witness for members[0] accumulates the remaining members
+	cofactor := new(big.Int).Mul(members[1], members[2])
+	witness := new(big.Int).Exp(g, cofactor, n)
+
+	circuit := membershipCircuit[emparams.Mod1e512]{}
+	assignment := membershipCircuit[emparams.Mod1e512]{
+		Accumulator: emulated.ValueOf[emparams.Mod1e512](accumulator),
+		Element:     emulated.ValueOf[emparams.Mod1e512](members[0]),
+		Witness:     emulated.ValueOf[emparams.Mod1e512](witness),
+		Modulus:     emulated.ValueOf[emparams.Mod1e512](n),
+	}
+
+	assert.Run(func(assert *test.Assert) {
+		assert.NoError(test.IsSolved(&circuit, &assignment, ecc.BN254.ScalarField()))
+	}, "valid")
+
+	assert.Run(func(assert *test.Assert) {
+		// a witness for a non-accumulated element is rejected
+		nonMember, err := rand.Prime(rand.Reader, 128)
+		assert.NoError(err)
+		badAssignment := assignment
+		badAssignment.Element = emulated.ValueOf[emparams.Mod1e512](nonMember)
+		assert.Error(test.IsSolved(&circuit, &badAssignment, ecc.BN254.ScalarField()))
+	}, "non-member")
+}
@@ -45,6 +45,12 @@ func GetCurve[FR emulated.FieldParams, G1El G1ElementT](api frontend.API) (Curve
 			return ret, fmt.Errorf("new curve: %w", err)
 		}
 		*s = c
+	case *Curve[emparams.BLS12377Fr, sw_emulated.AffinePoint[emparams.BLS12377Fp]]:
+		c, err := sw_emulated.New[emparams.BLS12377Fp, emparams.BLS12377Fr](api, sw_emulated.GetBLS12377Params())
+		if err != nil {
+			return ret, fmt.Errorf("new curve: %w", err)
+		}
+		*s = c
 	case *Curve[sw_bls24315.ScalarField, sw_bls24315.G1Affine]:
 		c, err := sw_bls24315.NewCurve(api)
 		if err != nil {
@@ -6,6 +6,8 @@ import (
 	"math/big"
 
 	"github.com/consensys/gnark-crypto/ecc"
+	bls12377 "github.com/consensys/gnark-crypto/ecc/bls12-377"
+	bls12377_fp "github.com/consensys/gnark-crypto/ecc/bls12-377/fp"
 	bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381"
 	bls12381_fp "github.com/consensys/gnark-crypto/ecc/bls12-381/fp"
 	"github.com/consensys/gnark-crypto/ecc/bn254"
@@ -93,7 +95,7 @@ func decomposeScalarG1Signs(mod *big.Int, inputs []*big.Int, outputs []*big.Int)
 }
 
 // TODO @yelhousni: generalize for any supported curve as it currently supports only:
-// BN254, BLS12-381, BW6-761 and Secp256k1, P256, P384 and STARK curve.
+// BN254, BLS12-381, BLS12-377, BW6-761 and Secp256k1, P256, P384 and STARK curve.
 func scalarMulHint(_ *big.Int, inputs []*big.Int, outputs []*big.Int) error {
 	return emulated.UnwrapHintWithNativeInput(inputs, outputs, func(field *big.Int, inputs, outputs []*big.Int) error {
 		if len(outputs) != 2 {
@@ -228,6 +230,32 @@ func scalarMulHint(_ *big.Int, inputs []*big.Int, outputs []*big.Int) error {
 			P.ScalarMultiplication(&P, S)
 			P.X.BigInt(outputs[0])
 			P.Y.BigInt(outputs[1])
+		} else if field.Cmp(bls12377_fp.Modulus()) == 0 {
+			var fp emparams.BLS12377Fp
+			var fr emparams.BLS12377Fr
+			PXLimbs := inputs[:fp.NbLimbs()]
+			PYLimbs := inputs[fp.NbLimbs() : 2*fp.NbLimbs()]
+			SLimbs := inputs[2*fp.NbLimbs():]
+			Px, Py, S := new(big.Int), new(big.Int), new(big.Int)
+			if err := limbs.Recompose(PXLimbs, fp.BitsPerLimb(), Px); err != nil {
+				return err
+
+			}
+			if err := limbs.Recompose(PYLimbs, fp.BitsPerLimb(), Py); err != nil {
+				return err
+
+			}
+			if err := limbs.Recompose(SLimbs, fr.BitsPerLimb(), S); err != nil {
+				return err
+
+			}
+			// compute the resulting point [s]Q
+			var P bls12377.G1Affine
+			P.X.SetBigInt(Px)
+			P.Y.SetBigInt(Py)
+			P.ScalarMultiplication(&P, S)
+			P.X.BigInt(outputs[0])
+			P.Y.BigInt(outputs[1])
 		} else if field.Cmp(secp_fp.Modulus()) == 0 {
 			var fp emparams.Secp256k1Fp
 			var fr emparams.Secp256k1Fr
@@ -4,6 +4,7 @@ import (
 	"crypto/elliptic"
 	"math/big"
 
+	bls12377 "github.com/consensys/gnark-crypto/ecc/bls12-377"
 	bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381"
 	"github.com/consensys/gnark-crypto/ecc/bn254"
 	bw6761 "github.com/consensys/gnark-crypto/ecc/bw6-761"
@@ -82,6 +83,24 @@ func GetBLS12381Params() CurveParams {
 	}
 }
 
+// GetBLS12377Params returns the curve parameters for the curve BLS12-377.
+// When initialising new curve, use the base field [emulated.BLS12377Fp] and scalar
+// field [emulated.BLS12377Fr].
+func GetBLS12377Params() CurveParams {
+	_, _, g1aff, _ := bls12377.Generators()
+	lambda, _ := new(big.Int).SetString("91893752504881257701523279626832445440", 10)
+	omega, _ := new(big.Int).SetString("80949648264912719408558363140637477264845294720710499478137287262712535938301461879813459410945", 10)
+	return CurveParams{
+		A:            big.NewInt(0),
+		B:            big.NewInt(1),
+		Gx:           g1aff.X.BigInt(new(big.Int)),
+		Gy:           g1aff.Y.BigInt(new(big.Int)),
+		Gm:           computeBLS12377Table(),
+		Eigenvalue:   lambda,
+		ThirdRootOne: omega,
+	}
+}
+
 // GetP256Params returns the curve parameters for the curve P-256 (also
 // SECP256r1). When initialising new curve, use the base field
 // [emulated.P256Fp] and scalar field [emulated.P256Fr].
@@ -163,6 +182,8 @@ func GetCurveParams[Base emulated.FieldParams]() CurveParams {
 		return bn254Params
 	case emulated.BLS12381Fp{}.Modulus().String():
 		return bls12381Params
+	case emulated.BLS12377Fp{}.Modulus().String():
+		return bls12377Params
 	case emulated.P256Fp{}.Modulus().String():
 		return p256Params
 	case emulated.P384Fp{}.Modulus().String():
@@ -180,6 +201,7 @@ var (
 	secp256k1Params  CurveParams
 	bn254Params      CurveParams
 	bls12381Params   CurveParams
+	bls12377Params   CurveParams
 	p256Params       CurveParams
 	p384Params       CurveParams
 	bw6761Params     CurveParams
@@ -190,6 +212,7 @@ func init() {
 	secp256k1Params = GetSecp256k1Params()
 	bn254Params = GetBN254Params()
 	bls12381Params = GetBLS12381Params()
+	bls12377Params = GetBLS12377Params()
 	p256Params = GetP256Params()
 	p384Params = GetP384Params()
 	bw6761Params = GetBW6761Params()
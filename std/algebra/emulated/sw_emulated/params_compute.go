@@ -4,6 +4,7 @@ import (
 	"crypto/elliptic"
 	"math/big"
 
+	bls12377 "github.com/consensys/gnark-crypto/ecc/bls12-377"
 	bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381"
 	"github.com/consensys/gnark-crypto/ecc/bn254"
 	bw6761 "github.com/consensys/gnark-crypto/ecc/bw6-761"
@@ -89,6 +90,32 @@ func computeBLS12381Table() [][2]*big.Int {
 	return table
 }
 
+func computeBLS12377Table() [][2]*big.Int {
+	Gjac, _, _, _ := bls12377.Generators()
+	table := make([][2]*big.Int, 256)
+	tmp := new(bls12377.G1Jac).Set(&Gjac)
+	aff := new(bls12377.G1Affine)
+	jac := new(bls12377.G1Jac)
+	for i := 1; i < 256; i++ {
+		tmp = tmp.Double(tmp)
+		switch i {
+		case 1, 2:
+			jac.Set(tmp).AddAssign(&Gjac)
+			aff.FromJacobian(jac)
+			table[i-1] = [2]*big.Int{aff.X.BigInt(new(big.Int)), aff.Y.BigInt(new(big.Int))}
+		case 3:
+			jac.Set(tmp).SubAssign(&Gjac)
+			aff.FromJacobian(jac)
+			table[i-1] = [2]*big.Int{aff.X.BigInt(new(big.Int)), aff.Y.BigInt(new(big.Int))}
+			fallthrough
+		default:
+			aff.FromJacobian(tmp)
+			table[i] = [2]*big.Int{aff.X.BigInt(new(big.Int)), aff.Y.BigInt(new(big.Int))}
+		}
+	}
+	return table
+}
+
 func computeP256Table() [][2]*big.Int {
 	table := make([][2]*big.Int, 256)
 	p256 := elliptic.P256()
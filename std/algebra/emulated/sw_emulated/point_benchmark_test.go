@@ -0,0 +1,55 @@
+package sw_emulated
+
+import (
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/frontend/cs/scs"
+	"github.com/consensys/gnark/std/algebra/algopts"
+	"github.com/consensys/gnark/std/math/emulated"
+)
+
+type scalarMulBench[T, S emulated.FieldParams] struct {
+	P, Q     AffinePoint[T]
+	S        emulated.Element[S]
+	complete bool
+}
+
+func (c *scalarMulBench[T, S]) Define(api frontend.API) error {
+	cr, err := New[T, S](api, GetCurveParams[T]())
+	if err != nil {
+		return err
+	}
+	var opts []algopts.AlgebraOption
+	if c.complete {
+		opts = append(opts, algopts.WithCompleteArithmetic())
+	}
+	res := cr.ScalarMul(&c.P, &c.S, opts...)
+	cr.AssertIsEqual(res, &c.Q)
+	return nil
+}
+
+// BenchmarkScalarMul compares the default scalar multiplication, which uses
+// incomplete additions made sound by the shift-based completeness argument,
+// against the variant using complete formulas everywhere.
+func BenchmarkScalarMul(b *testing.B) {
+	for _, bb := range []struct {
+		name     string
+		complete bool
+	}{
+		{"incomplete", false},
+		{"complete", true},
+	} {
+		b.Run(bb.name, func(b *testing.B) {
+			var ccs interface{ GetNbConstraints() int }
+			var err error
+			for i := 0; i < b.N; i++ {
+				if ccs, err = frontend.Compile(ecc.BN254.ScalarField(), scs.NewBuilder, &scalarMulBench[emulated.Secp256k1Fp, emulated.Secp256k1Fr]{complete: bb.complete}); err != nil {
+					b.Fatal(err)
+				}
+			}
+			b.Logf("nb constraints %d", ccs.GetNbConstraints())
+		})
+	}
+}
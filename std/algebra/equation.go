@@ -0,0 +1,71 @@
+package algebra
+
+import (
+	"fmt"
+
+	"github.com/consensys/gnark/std/math/emulated"
+)
+
+// Equation accumulates pairing terms and asserts that their product equals
+// one in the target group with a single multi-pairing check. It factors out
+// the bookkeeping of the two parallel point slices that pairing-based
+// verification equations are usually written with, making custom schemes less
+// error-prone:
+//
+//	eq := algebra.NewEquation(curve, pairing)
+//	eq.AddPairing(a, b)            // multiplies by e(a, b)
+//	eq.AddScaledPairing(c, d, s)   // multiplies by e(c, d)^s
+//	err := eq.AssertEqualsOne()
+//
+// The accumulated terms are only constrained when [Equation.AssertEqualsOne]
+// is called; an equation that is built but never asserted adds no pairing
+// constraints.
+type Equation[FR emulated.FieldParams, G1El G1ElementT, G2El G2ElementT, GtEl GtElementT] struct {
+	curve   Curve[FR, G1El]
+	pairing Pairing[G1El, G2El, GtEl]
+	g1      []*G1El
+	g2      []*G2El
+}
+
+// NewEquation returns an empty equation accumulating terms for the given
+// pairing. The curve is used to scale G1 inputs in
+// [Equation.AddScaledPairing].
+func NewEquation[FR emulated.FieldParams, G1El G1ElementT, G2El G2ElementT, GtEl GtElementT](curve Curve[FR, G1El], pairing Pairing[G1El, G2El, GtEl]) *Equation[FR, G1El, G2El, GtEl] {
+	return &Equation[FR, G1El, G2El, GtEl]{
+		curve:   curve,
+		pairing: pairing,
+	}
+}
+
+// AddPairing multiplies the equation by e(g1, g2).
+func (eq *Equation[FR, G1El, G2El, GtEl]) AddPairing(g1 *G1El, g2 *G2El) {
+	eq.g1 = append(eq.g1, g1)
+	eq.g2 = append(eq.g2, g2)
+}
+
+// AddScaledPairing multiplies the equation by e(g1, g2)^coeff. The exponent is
+// moved into G1 by bilinearity, costing a scalar multiplication instead of a
+// target group exponentiation.
+func (eq *Equation[FR, G1El, G2El, GtEl]) AddScaledPairing(g1 *G1El, g2 *G2El, coeff *emulated.Element[FR]) {
+	eq.AddPairing(eq.curve.ScalarMul(g1, coeff), g2)
+}
+
+// AddScaledPairingNeg multiplies the equation by e(g1, g2)^(-coeff). It is a
+// convenience for moving a term to the other side of an equation without
+// negating points by hand.
+func (eq *Equation[FR, G1El, G2El, GtEl]) AddScaledPairingNeg(g1 *G1El, g2 *G2El, coeff *emulated.Element[FR]) {
+	eq.AddPairing(eq.curve.Neg(eq.curve.ScalarMul(g1, coeff)), g2)
+}
+
+// AssertEqualsOne constrains the product of the accumulated terms to equal one
+// in the target group, emitting a single multi-pairing check. It errors on an
+// empty equation, which would be vacuously satisfied.
+func (eq *Equation[FR, G1El, G2El, GtEl]) AssertEqualsOne() error {
+	if len(eq.g1) == 0 {
+		return fmt.Errorf("empty pairing equation")
+	}
+	if err := eq.pairing.PairingCheck(eq.g1, eq.g2); err != nil {
+		return fmt.Errorf("pairing check: %w", err)
+	}
+	return nil
+}
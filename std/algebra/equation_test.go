@@ -0,0 +1,116 @@
+package algebra_test
+
+import (
+	"crypto/rand"
+	"fmt"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	fr_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	kzg_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/kzg"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/algebra"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_bn254"
+	"github.com/consensys/gnark/std/math/emulated"
+	"github.com/consensys/gnark/test"
+)
+
+// equationKZGCircuit checks a KZG opening through the equation builder, once
+// with the usual pre-folded G1 term and once with the point and value moved
+// into pairing exponents via AddScaledPairing.
+type equationKZGCircuit struct {
+	G1         sw_bn254.G1Affine
+	G2         [2]sw_bn254.G2Affine
+	Commitment sw_bn254.G1Affine
+	Quotient   sw_bn254.G1Affine
+	Point      emulated.Element[sw_bn254.ScalarField]
+	Value      emulated.Element[sw_bn254.ScalarField]
+}
+
+func (c *equationKZGCircuit) Define(api frontend.API) error {
+	curve, err := algebra.GetCurve[sw_bn254.ScalarField, sw_bn254.G1Affine](api)
+	if err != nil {
+		return fmt.Errorf("get curve: %w", err)
+	}
+	pairing, err := algebra.GetPairing[sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl](api)
+	if err != nil {
+		return fmt.Errorf("get pairing: %w", err)
+	}
+	fr, err := emulated.NewField[sw_bn254.ScalarField](api)
+	if err != nil {
+		return fmt.Errorf("new scalar field: %w", err)
+	}
+
+	// variant 1: e([v]G₁ - [z]Q - C, G₂)·e(Q, [α]G₂) == 1 with the G1 term
+	// folded before entering the equation
+	folded, err := curve.MultiScalarMul(
+		[]*sw_bn254.G1Affine{&c.G1, &c.Quotient},
+		[]*emulated.Element[sw_bn254.ScalarField]{&c.Value, fr.Neg(&c.Point)},
+	)
+	if err != nil {
+		return fmt.Errorf("fold G1 term: %w", err)
+	}
+	folded = curve.Add(folded, curve.Neg(&c.Commitment))
+	eq := algebra.NewEquation(curve, pairing)
+	eq.AddPairing(folded, &c.G2[0])
+	eq.AddPairing(&c.Quotient, &c.G2[1])
+	if err := eq.AssertEqualsOne(); err != nil {
+		return fmt.Errorf("folded equation: %w", err)
+	}
+
+	// variant 2: the same equation with scaled terms,
+	// e(G₁, G₂)^v·e(Q, G₂)^(-z)·e(-C, G₂)·e(Q, [α]G₂) == 1
+	eq2 := algebra.NewEquation(curve, pairing)
+	eq2.AddScaledPairing(&c.G1, &c.G2[0], &c.Value)
+	eq2.AddScaledPairingNeg(&c.Quotient, &c.G2[0], &c.Point)
+	eq2.AddPairing(curve.Neg(&c.Commitment), &c.G2[0])
+	eq2.AddPairing(&c.Quotient, &c.G2[1])
+	if err := eq2.AssertEqualsOne(); err != nil {
+		return fmt.Errorf("scaled equation: %w", err)
+	}
+
+	// an equation with no terms must be rejected
+	if err := algebra.NewEquation(curve, pairing).AssertEqualsOne(); err == nil {
+		return fmt.Errorf("expected error for empty equation")
+	}
+	return nil
+}
+
+func TestEquationKZG(t *testing.T) {
+	assert := test.NewAssert(t)
+
+	alpha, err := rand.Int(rand.Reader, ecc.BN254.ScalarField())
+	assert.NoError(err)
+	srs, err := kzg_bn254.NewSRS(16, alpha)
+	assert.NoError(err)
+	f := make([]fr_bn254.Element, 10)
+	for i := range f {
+		f[i].SetRandom()
+	}
+	com, err := kzg_bn254.Commit(f, srs.Pk)
+	assert.NoError(err)
+	var point fr_bn254.Element
+	point.SetRandom()
+	proof, err := kzg_bn254.Open(f, point, srs.Pk)
+	assert.NoError(err)
+
+	circuit := equationKZGCircuit{}
+	assignment := equationKZGCircuit{
+		G1: sw_bn254.NewG1Affine(srs.Vk.G1),
+		G2: [2]sw_bn254.G2Affine{
+			sw_bn254.NewG2Affine(srs.Vk.G2[0]),
+			sw_bn254.NewG2Affine(srs.Vk.G2[1]),
+		},
+		Commitment: sw_bn254.NewG1Affine(com),
+		Quotient:   sw_bn254.NewG1Affine(proof.H),
+		Point:      emulated.ValueOf[sw_bn254.ScalarField](point),
+		Value:      emulated.ValueOf[sw_bn254.ScalarField](proof.ClaimedValue),
+	}
+	assert.NoError(test.IsSolved(&circuit, &assignment, ecc.BN254.ScalarField()))
+
+	badAssignment := assignment
+	var badValue fr_bn254.Element
+	badValue.SetOne().Add(&badValue, &proof.ClaimedValue)
+	badAssignment.Value = emulated.ValueOf[sw_bn254.ScalarField](badValue)
+	assert.Error(test.IsSolved(&circuit, &badAssignment, ecc.BN254.ScalarField()))
+}
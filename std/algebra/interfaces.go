@@ -37,7 +37,11 @@ type Curve[FR emulated.FieldParams, G1El G1ElementT] interface {
 	ScalarMul(*G1El, *emulated.Element[FR], ...algopts.AlgebraOption) *G1El
 
 	// ScalarMulBase returns the scalar multiplication of the curve base point
-	// by a scalar. It does not modify the scalar.
+	// by a scalar. It does not modify the scalar. The implementations use
+	// precomputed multiples of the base point, making this considerably
+	// cheaper than variable-base ScalarMul; verifier gadgets multiplying
+	// fixed verification-key points can get the same saving through their
+	// own hooks (see e.g. the precomputed-base option of the KZG verifier).
 	//
 	// Depending on the implementation the scalar multiplication may be
 	// incomplete for zero scalar. To allow the exceptional case use the
@@ -46,7 +50,9 @@ type Curve[FR emulated.FieldParams, G1El G1ElementT] interface {
 
 	// MultiScalarMul computes the sum ∑ s_i P_i for the input
 	// scalars s_i and points P_i. It returns an error if the input lengths
-	// mismatch.
+	// mismatch. The implementations pair up the inputs into fused joint
+	// scalar multiplications (GLV-based where the curve allows), which costs
+	// roughly half the constraints of chaining individual ScalarMul calls.
 	//
 	// Depending on the implementation the scalar multiplication may be
 	// incomplete for zero scalar or point at infinity. To allow the exceptional
@@ -80,6 +86,12 @@ type Curve[FR emulated.FieldParams, G1El G1ElementT] interface {
 // Pairing allows to compute the bi-linear pairing of G1 and G2 elements.
 // Additionally, the interface provides steps used in pairing computation and a
 // dedicated optimised pairing check.
+//
+// The GtEl type parameter carries the embedding-degree-dependent structure of
+// the target group, so the interface is not tied to embedding degree 12:
+// implementations exist for k=12 curves (e.g. [sw_bn254.GTEl]) as well as for
+// k=24 ([sw_bls24315.GT]) and k=6 ([sw_bw6761.GTEl]) curves, and generic
+// gadgets such as the KZG verifier work over any of them unchanged.
 type Pairing[G1El G1ElementT, G2El G2ElementT, GtEl GtElementT] interface {
 	// MillerLoop computes the Miller loop of the input pairs. It returns error
 	// when the inputs are of mismatching length. It does not modify the inputs.
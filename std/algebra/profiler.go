@@ -0,0 +1,111 @@
+package algebra
+
+import (
+	"maps"
+
+	"github.com/consensys/gnark/profile"
+	"github.com/consensys/gnark/std/algebra/algopts"
+	"github.com/consensys/gnark/std/math/emulated"
+)
+
+// Profiler accumulates the number of constraints emitted per high-level
+// algebra operation. Wrap a curve or pairing with [NewProfiledCurve] or
+// [NewProfiledPairing] and compile the circuit; afterwards [Profiler.Counts]
+// returns the labeled breakdown. Constraint attribution uses the [profile]
+// package, so counts are only collected during an actual frontend compilation
+// (not in the test engine) and profiling must run in the compile goroutine.
+//
+// Profiling changes no in-circuit behavior; the wrappers delegate every
+// operation unmodified.
+type Profiler struct {
+	counts map[string]int
+}
+
+// NewProfiler returns an empty profiler.
+func NewProfiler() *Profiler {
+	return &Profiler{counts: make(map[string]int)}
+}
+
+// Counts returns a copy of the constraint counts accumulated so far, keyed by
+// operation label.
+func (p *Profiler) Counts() map[string]int {
+	return maps.Clone(p.counts)
+}
+
+// measure starts a profiling session attributed to label and returns the
+// function finalizing it.
+func (p *Profiler) measure(label string) func() {
+	session := profile.Start(profile.WithNoOutput())
+	return func() {
+		session.Stop()
+		p.counts[label] += session.NbConstraints()
+	}
+}
+
+// NewProfiledCurve wraps the curve so that the constraints emitted by its
+// group operations are recorded in the profiler. Operations not overridden
+// below (selection, marshalling) are delegated without attribution.
+func NewProfiledCurve[FR emulated.FieldParams, G1El G1ElementT](c Curve[FR, G1El], p *Profiler) Curve[FR, G1El] {
+	return &profiledCurve[FR, G1El]{Curve: c, p: p}
+}
+
+type profiledCurve[FR emulated.FieldParams, G1El G1ElementT] struct {
+	Curve[FR, G1El]
+	p *Profiler
+}
+
+func (c *profiledCurve[FR, G1El]) Add(a, b *G1El) *G1El {
+	defer c.p.measure("Add")()
+	return c.Curve.Add(a, b)
+}
+
+func (c *profiledCurve[FR, G1El]) AddUnified(a, b *G1El) *G1El {
+	defer c.p.measure("AddUnified")()
+	return c.Curve.AddUnified(a, b)
+}
+
+func (c *profiledCurve[FR, G1El]) ScalarMul(q *G1El, s *emulated.Element[FR], opts ...algopts.AlgebraOption) *G1El {
+	defer c.p.measure("ScalarMul")()
+	return c.Curve.ScalarMul(q, s, opts...)
+}
+
+func (c *profiledCurve[FR, G1El]) ScalarMulBase(s *emulated.Element[FR], opts ...algopts.AlgebraOption) *G1El {
+	defer c.p.measure("ScalarMulBase")()
+	return c.Curve.ScalarMulBase(s, opts...)
+}
+
+func (c *profiledCurve[FR, G1El]) MultiScalarMul(q []*G1El, s []*emulated.Element[FR], opts ...algopts.AlgebraOption) (*G1El, error) {
+	defer c.p.measure("MultiScalarMul")()
+	return c.Curve.MultiScalarMul(q, s, opts...)
+}
+
+// NewProfiledPairing wraps the pairing so that the constraints emitted by the
+// Miller loops and final exponentiations are recorded in the profiler.
+func NewProfiledPairing[G1El G1ElementT, G2El G2ElementT, GtEl GtElementT](pr Pairing[G1El, G2El, GtEl], p *Profiler) Pairing[G1El, G2El, GtEl] {
+	return &profiledPairing[G1El, G2El, GtEl]{Pairing: pr, p: p}
+}
+
+type profiledPairing[G1El G1ElementT, G2El G2ElementT, GtEl GtElementT] struct {
+	Pairing[G1El, G2El, GtEl]
+	p *Profiler
+}
+
+func (pr *profiledPairing[G1El, G2El, GtEl]) MillerLoop(g1 []*G1El, g2 []*G2El) (*GtEl, error) {
+	defer pr.p.measure("MillerLoop")()
+	return pr.Pairing.MillerLoop(g1, g2)
+}
+
+func (pr *profiledPairing[G1El, G2El, GtEl]) FinalExponentiation(gt *GtEl) *GtEl {
+	defer pr.p.measure("FinalExp")()
+	return pr.Pairing.FinalExponentiation(gt)
+}
+
+func (pr *profiledPairing[G1El, G2El, GtEl]) Pair(g1 []*G1El, g2 []*G2El) (*GtEl, error) {
+	defer pr.p.measure("Pair")()
+	return pr.Pairing.Pair(g1, g2)
+}
+
+func (pr *profiledPairing[G1El, G2El, GtEl]) PairingCheck(g1 []*G1El, g2 []*G2El) error {
+	defer pr.p.measure("PairingCheck")()
+	return pr.Pairing.PairingCheck(g1, g2)
+}
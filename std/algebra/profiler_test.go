@@ -0,0 +1,58 @@
+package algebra_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/frontend/cs/scs"
+	"github.com/consensys/gnark/std/algebra"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_bn254"
+	"github.com/consensys/gnark/std/math/emulated"
+	"github.com/consensys/gnark/test"
+)
+
+type profiledOpsCircuit struct {
+	P, Q sw_bn254.G1Affine
+	S    emulated.Element[sw_bn254.ScalarField]
+	R    sw_bn254.G1Affine
+
+	profiler *algebra.Profiler
+}
+
+func (c *profiledOpsCircuit) Define(api frontend.API) error {
+	curve, err := algebra.GetCurve[sw_bn254.ScalarField, sw_bn254.G1Affine](api)
+	if err != nil {
+		return fmt.Errorf("get curve: %w", err)
+	}
+	curve = algebra.NewProfiledCurve(curve, c.profiler)
+	res := curve.ScalarMul(&c.P, &c.S)
+	res = curve.Add(res, &c.Q)
+	curve.AssertIsEqual(res, &c.R)
+	return nil
+}
+
+func TestProfiler(t *testing.T) {
+	assert := test.NewAssert(t)
+	profiler := algebra.NewProfiler()
+	circuit := profiledOpsCircuit{profiler: profiler}
+	ccs, err := frontend.Compile(ecc.BN254.ScalarField(), scs.NewBuilder, &circuit)
+	assert.NoError(err)
+
+	counts := profiler.Counts()
+	// the profiled operations are attributed
+	assert.Greater(counts["ScalarMul"], 0)
+	assert.Greater(counts["Add"], 0)
+	// a scalar multiplication dominates a single addition
+	assert.Greater(counts["ScalarMul"], counts["Add"])
+	// operations not performed are not attributed
+	_, ok := counts["MultiScalarMul"]
+	assert.False(ok)
+	// attributed constraints are a subset of the compiled system
+	total := 0
+	for _, n := range counts {
+		total += n
+	}
+	assert.LessOrEqual(total, ccs.GetNbConstraints())
+}
@@ -0,0 +1,119 @@
+package algebra_test
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381"
+	fp_bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381/fp"
+	fr_bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/frontend/cs/scs"
+	"github.com/consensys/gnark/std/algebra"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_bls12381"
+	"github.com/consensys/gnark/test"
+)
+
+const testBatchSize = 3
+
+type batchSubgroupCircuit struct {
+	Points [testBatchSize]sw_bls12381.G1Affine
+}
+
+func (c *batchSubgroupCircuit) Define(api frontend.API) error {
+	pairing, err := algebra.GetPairing[sw_bls12381.G1Affine, sw_bls12381.G2Affine, sw_bls12381.GTEl](api)
+	if err != nil {
+		return fmt.Errorf("get pairing: %w", err)
+	}
+	points := make([]*sw_bls12381.G1Affine, len(c.Points))
+	for i := range c.Points {
+		points[i] = &c.Points[i]
+	}
+	return algebra.AssertBatchSubgroup(pairing, points)
+}
+
+// offSubgroupPoint returns a point on the curve but outside the prime-order
+// subgroup.
+func offSubgroupPoint(t *testing.T) bls12381.G1Affine {
+	t.Helper()
+	var u fp_bls12381.Element
+	for i := int64(1); i < 100; i++ {
+		u.SetInt64(i)
+		p := bls12381.MapToCurve1(&u)
+		if !p.IsInSubGroup() {
+			return p
+		}
+	}
+	t.Fatal("no off-subgroup point found")
+	return bls12381.G1Affine{}
+}
+
+// smallOrderPoint returns a nonzero curve point of order 3, i.e. a pure
+// cofactor component. A subgroup check folded over a random linear
+// combination misses such a component for a third of the challenges, which is
+// why [algebra.AssertBatchSubgroup] checks each point individually.
+func smallOrderPoint(t *testing.T) bls12381.G1Affine {
+	t.Helper()
+	// #E(Fp) = r·h with the G1 cofactor h divisible by 3
+	h, ok := new(big.Int).SetString("396c8c005555e1568c00aaab0000aaab", 16)
+	if !ok {
+		t.Fatal("parse cofactor")
+	}
+	s := new(big.Int).Div(h, big.NewInt(3))
+	s.Mul(s, fr_bls12381.Modulus())
+	var u fp_bls12381.Element
+	for i := int64(1); i < 100; i++ {
+		u.SetInt64(i)
+		p := bls12381.MapToCurve1(&u)
+		p.ScalarMultiplication(&p, s)
+		if !p.IsInfinity() {
+			return p
+		}
+	}
+	t.Fatal("no order-3 point found")
+	return bls12381.G1Affine{}
+}
+
+func TestAssertBatchSubgroup(t *testing.T) {
+	assert := test.NewAssert(t)
+
+	_, _, g1, _ := bls12381.Generators()
+
+	circuit := batchSubgroupCircuit{}
+	assignment := batchSubgroupCircuit{}
+	points := make([]bls12381.G1Affine, testBatchSize)
+	for i := range points {
+		points[i].ScalarMultiplication(&g1, big.NewInt(int64(i+2)))
+		assignment.Points[i] = sw_bls12381.NewG1Affine(points[i])
+	}
+	assert.NoError(test.IsSolved(&circuit, &assignment, ecc.BN254.ScalarField()))
+
+	// a batch containing one off-subgroup point is rejected
+	badAssignment := assignment
+	badAssignment.Points[1] = sw_bls12381.NewG1Affine(offSubgroupPoint(t))
+	assert.Error(test.IsSolved(&circuit, &badAssignment, ecc.BN254.ScalarField()))
+
+	// an in-subgroup point with an added order-3 cofactor component is
+	// rejected too
+	var tampered bls12381.G1Affine
+	small := smallOrderPoint(t)
+	tampered.Add(&points[1], &small)
+	badAssignment = assignment
+	badAssignment.Points[1] = sw_bls12381.NewG1Affine(tampered)
+	assert.Error(test.IsSolved(&circuit, &badAssignment, ecc.BN254.ScalarField()))
+}
+
+// BenchmarkAssertBatchSubgroup measures the constraint cost of the batch
+// subgroup check.
+func BenchmarkAssertBatchSubgroup(b *testing.B) {
+	var ccs interface{ GetNbConstraints() int }
+	var err error
+	for i := 0; i < b.N; i++ {
+		if ccs, err = frontend.Compile(ecc.BN254.ScalarField(), scs.NewBuilder, &batchSubgroupCircuit{}); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.Logf("nb constraints %d", ccs.GetNbConstraints())
+}
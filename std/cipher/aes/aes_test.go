@@ -0,0 +1,219 @@
+package aes
+
+import (
+	cryptoaes "crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/math/uints"
+	"github.com/consensys/gnark/test"
+)
+
+type blockCircuit struct {
+	Key        []uints.U8
+	Plaintext  [16]uints.U8
+	Ciphertext [16]uints.U8
+}
+
+func (c *blockCircuit) Define(api frontend.API) error {
+	cph, err := New(api, c.Key)
+	if err != nil {
+		return err
+	}
+	ct, err := cph.EncryptBlock(c.Plaintext[:])
+	if err != nil {
+		return err
+	}
+	for i := range ct {
+		api.AssertIsEqual(ct[i].Val, c.Ciphertext[i].Val)
+	}
+	return nil
+}
+
+// TestEncryptBlock checks the block cipher against the FIPS-197 appendix C
+// known-answer vectors.
+func TestEncryptBlock(t *testing.T) {
+	assert := test.NewAssert(t)
+	for _, tc := range []struct {
+		key, pt, ct string
+	}{
+		{
+			key: "000102030405060708090a0b0c0d0e0f",
+			pt:  "00112233445566778899aabbccddeeff",
+			ct:  "69c4e0d86a7b0430d8cdb78070b4c55a",
+		},
+		{
+			key: "000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f",
+			pt:  "00112233445566778899aabbccddeeff",
+			ct:  "8ea2b7ca516745bfeafc49904b496089",
+		},
+	} {
+		key, err := hex.DecodeString(tc.key)
+		assert.NoError(err)
+		pt, err := hex.DecodeString(tc.pt)
+		assert.NoError(err)
+		ct, err := hex.DecodeString(tc.ct)
+		assert.NoError(err)
+
+		circuit := blockCircuit{Key: make([]uints.U8, len(key))}
+		assignment := blockCircuit{Key: uints.NewU8Array(key)}
+		copy(assignment.Plaintext[:], uints.NewU8Array(pt))
+		copy(assignment.Ciphertext[:], uints.NewU8Array(ct))
+		assert.Run(func(assert *test.Assert) {
+			assert.NoError(test.IsSolved(&circuit, &assignment, ecc.BN254.ScalarField()))
+		}, fmt.Sprintf("aes-%d", 8*len(key)))
+	}
+}
+
+type ctrCircuit struct {
+	Key        []uints.U8
+	Nonce      [12]uints.U8
+	Plaintext  []uints.U8
+	Ciphertext []uints.U8
+}
+
+func (c *ctrCircuit) Define(api frontend.API) error {
+	cph, err := New(api, c.Key)
+	if err != nil {
+		return err
+	}
+	ct, err := cph.EncryptCTR(c.Nonce[:], 1, c.Plaintext)
+	if err != nil {
+		return err
+	}
+	for i := range ct {
+		api.AssertIsEqual(ct[i].Val, c.Ciphertext[i].Val)
+	}
+	return nil
+}
+
+func TestEncryptCTR(t *testing.T) {
+	assert := test.NewAssert(t)
+	const ptLen = 37
+	for _, keyLen := range []int{16, 32} {
+		key := make([]byte, keyLen)
+		nonce := make([]byte, gcmNonceSize)
+		pt := make([]byte, ptLen)
+		_, err := rand.Read(key)
+		assert.NoError(err)
+		_, err = rand.Read(nonce)
+		assert.NoError(err)
+		_, err = rand.Read(pt)
+		assert.NoError(err)
+
+		block, err := cryptoaes.NewCipher(key)
+		assert.NoError(err)
+		iv := make([]byte, cryptoaes.BlockSize)
+		copy(iv, nonce)
+		binary.BigEndian.PutUint32(iv[gcmNonceSize:], 1)
+		ct := make([]byte, ptLen)
+		cipher.NewCTR(block, iv).XORKeyStream(ct, pt)
+
+		circuit := ctrCircuit{
+			Key:        make([]uints.U8, keyLen),
+			Plaintext:  make([]uints.U8, ptLen),
+			Ciphertext: make([]uints.U8, ptLen),
+		}
+		assignment := ctrCircuit{
+			Key:        uints.NewU8Array(key),
+			Plaintext:  uints.NewU8Array(pt),
+			Ciphertext: uints.NewU8Array(ct),
+		}
+		copy(assignment.Nonce[:], uints.NewU8Array(nonce))
+
+		assert.Run(func(assert *test.Assert) {
+			assert.NoError(test.IsSolved(&circuit, &assignment, ecc.BN254.ScalarField()))
+		}, fmt.Sprintf("aes-%d/valid", 8*keyLen))
+		assert.Run(func(assert *test.Assert) {
+			badAssignment := assignment
+			badAssignment.Ciphertext = uints.NewU8Array(ct)
+			badAssignment.Ciphertext[3] = uints.NewU8(ct[3] ^ 1)
+			assert.Error(test.IsSolved(&circuit, &badAssignment, ecc.BN254.ScalarField()))
+		}, fmt.Sprintf("aes-%d/wrong ciphertext", 8*keyLen))
+	}
+}
+
+type gcmCircuit struct {
+	Key        []uints.U8
+	Nonce      [12]uints.U8
+	AAD        []uints.U8
+	Plaintext  []uints.U8
+	Ciphertext []uints.U8
+	Tag        [16]uints.U8
+}
+
+func (c *gcmCircuit) Define(api frontend.API) error {
+	cph, err := New(api, c.Key)
+	if err != nil {
+		return err
+	}
+	// the GCM payload keystream starts at counter 2; counter 1 is the tag mask
+	ct, err := cph.EncryptCTR(c.Nonce[:], 2, c.Plaintext)
+	if err != nil {
+		return err
+	}
+	for i := range ct {
+		api.AssertIsEqual(ct[i].Val, c.Ciphertext[i].Val)
+	}
+	return cph.AssertGCMTag(c.Nonce[:], c.AAD, c.Ciphertext, c.Tag[:])
+}
+
+func TestAssertGCMTag(t *testing.T) {
+	assert := test.NewAssert(t)
+	const (
+		ptLen  = 20
+		aadLen = 7
+	)
+	for _, keyLen := range []int{16, 32} {
+		key := make([]byte, keyLen)
+		nonce := make([]byte, gcmNonceSize)
+		pt := make([]byte, ptLen)
+		aad := make([]byte, aadLen)
+		_, err := rand.Read(key)
+		assert.NoError(err)
+		_, err = rand.Read(nonce)
+		assert.NoError(err)
+		_, err = rand.Read(pt)
+		assert.NoError(err)
+		_, err = rand.Read(aad)
+		assert.NoError(err)
+
+		block, err := cryptoaes.NewCipher(key)
+		assert.NoError(err)
+		gcm, err := cipher.NewGCM(block)
+		assert.NoError(err)
+		sealed := gcm.Seal(nil, nonce, pt, aad)
+		ct, tag := sealed[:ptLen], sealed[ptLen:]
+
+		circuit := gcmCircuit{
+			Key:        make([]uints.U8, keyLen),
+			AAD:        make([]uints.U8, aadLen),
+			Plaintext:  make([]uints.U8, ptLen),
+			Ciphertext: make([]uints.U8, ptLen),
+		}
+		assignment := gcmCircuit{
+			Key:        uints.NewU8Array(key),
+			AAD:        uints.NewU8Array(aad),
+			Plaintext:  uints.NewU8Array(pt),
+			Ciphertext: uints.NewU8Array(ct),
+		}
+		copy(assignment.Nonce[:], uints.NewU8Array(nonce))
+		copy(assignment.Tag[:], uints.NewU8Array(tag))
+
+		assert.Run(func(assert *test.Assert) {
+			assert.NoError(test.IsSolved(&circuit, &assignment, ecc.BN254.ScalarField()))
+		}, fmt.Sprintf("aes-%d/valid", 8*keyLen))
+		assert.Run(func(assert *test.Assert) {
+			badAssignment := assignment
+			copy(badAssignment.Tag[:], uints.NewU8Array(tag))
+			badAssignment.Tag[0] = uints.NewU8(tag[0] ^ 1)
+			assert.Error(test.IsSolved(&circuit, &badAssignment, ecc.BN254.ScalarField()))
+		}, fmt.Sprintf("aes-%d/wrong tag", 8*keyLen))
+	}
+}
@@ -0,0 +1,38 @@
+package aes
+
+import (
+	"fmt"
+
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/math/uints"
+)
+
+// EncryptCTR encrypts data in counter mode and returns the ciphertext. By
+// the symmetry of CTR the same call decrypts a ciphertext. The counter
+// blocks are nonce || BE32(counter) with the 32-bit counter starting at
+// initCounter and incremented per block, matching the layout GCM uses for
+// 96-bit nonces: the GCM payload starts at counter 2. nonce must have 12
+// bytes; data may have any length.
+func (c *Cipher) EncryptCTR(nonce []uints.U8, initCounter uint32, data []uints.U8) ([]uints.U8, error) {
+	if len(nonce) != gcmNonceSize {
+		return nil, fmt.Errorf("nonce size %d, expected %d bytes", len(nonce), gcmNonceSize)
+	}
+	res := make([]uints.U8, len(data))
+	ctr := make([]frontend.Variable, blockSize)
+	for i := range nonce {
+		ctr[i] = nonce[i].Val
+	}
+	for b := 0; b*blockSize < len(data); b++ {
+		// the counter suffix is a compile-time constant of the block index
+		cnt := initCounter + uint32(b)
+		ctr[12] = (cnt >> 24) & 0xff
+		ctr[13] = (cnt >> 16) & 0xff
+		ctr[14] = (cnt >> 8) & 0xff
+		ctr[15] = cnt & 0xff
+		ks := c.encryptBlock(ctr)
+		for i := 0; i < blockSize && b*blockSize+i < len(data); i++ {
+			res[b*blockSize+i] = uints.U8{Val: c.xor(data[b*blockSize+i].Val, ks[i])}
+		}
+	}
+	return res, nil
+}
@@ -0,0 +1,141 @@
+package aes
+
+import (
+	"fmt"
+
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/math/bits"
+	"github.com/consensys/gnark/std/math/uints"
+)
+
+// gcmNonceSize is the standard GCM nonce size in bytes.
+const gcmNonceSize = 12
+
+// AssertGCMTag asserts that tag is the GCM authentication tag over the
+// additional data aad and the ciphertext under the cipher key and nonce. The
+// ciphertext itself is produced (or checked) separately with
+// [Cipher.EncryptCTR] using the initial counter 2. nonce must have 12 bytes
+// and tag 16 bytes; aad and ciphertext may have any length.
+//
+// The GHASH multiplications are performed bit-serially over GF(2^128), so
+// the cost is linear in the number of 16-byte blocks of aad and ciphertext.
+func (c *Cipher) AssertGCMTag(nonce, aad, ciphertext, tag []uints.U8) error {
+	if len(nonce) != gcmNonceSize {
+		return fmt.Errorf("nonce size %d, expected %d bytes", len(nonce), gcmNonceSize)
+	}
+	if len(tag) != blockSize {
+		return fmt.Errorf("tag size %d, expected %d bytes", len(tag), blockSize)
+	}
+	// H = E_K(0^128) is the GHASH key
+	zero := make([]frontend.Variable, blockSize)
+	for i := range zero {
+		zero[i] = 0
+	}
+	h := blockToBits(c.api, c.encryptBlock(zero))
+
+	s := make([]frontend.Variable, 128)
+	for i := range s {
+		s[i] = 0
+	}
+	s = c.ghashUpdate(s, h, aad)
+	s = c.ghashUpdate(s, h, ciphertext)
+	// the final block holds the 64-bit big-endian bit lengths of aad and
+	// ciphertext, known at compile time
+	lenBlock := make([]frontend.Variable, blockSize)
+	aadBits := uint64(len(aad)) * 8
+	ctBits := uint64(len(ciphertext)) * 8
+	for i := 0; i < 8; i++ {
+		lenBlock[i] = (aadBits >> (56 - 8*i)) & 0xff
+		lenBlock[8+i] = (ctBits >> (56 - 8*i)) & 0xff
+	}
+	s = c.gfMul(xorBits(c.api, s, blockToBits(c.api, lenBlock)), h)
+
+	// T = E_K(nonce || 0x00000001) ^ GHASH
+	j0 := make([]frontend.Variable, blockSize)
+	for i := range nonce {
+		j0[i] = nonce[i].Val
+	}
+	j0[12], j0[13], j0[14], j0[15] = 0, 0, 0, 1
+	t := xorBits(c.api, blockToBits(c.api, c.encryptBlock(j0)), s)
+	for i := 0; i < blockSize; i++ {
+		// byte i spans the bits 8i..8i+8, most significant first
+		b := make([]frontend.Variable, 8)
+		for j := 0; j < 8; j++ {
+			b[j] = t[8*i+7-j]
+		}
+		c.api.AssertIsEqual(bits.FromBinary(c.api, b), tag[i].Val)
+	}
+	return nil
+}
+
+// ghashUpdate folds the zero-padded 16-byte blocks of data into the GHASH
+// state s under the hash key h.
+func (c *Cipher) ghashUpdate(s, h []frontend.Variable, data []uints.U8) []frontend.Variable {
+	for b := 0; b*blockSize < len(data); b++ {
+		blk := make([]frontend.Variable, blockSize)
+		for i := range blk {
+			if b*blockSize+i < len(data) {
+				blk[i] = data[b*blockSize+i].Val
+			} else {
+				blk[i] = 0
+			}
+		}
+		s = c.gfMul(xorBits(c.api, s, blockToBits(c.api, blk)), h)
+	}
+	return s
+}
+
+// gfMul multiplies x and y in GF(2^128) with the GCM reduction polynomial,
+// bit-serially over the bits of x. Both operands and the result use the GCM
+// bit order of [blockToBits].
+func (c *Cipher) gfMul(x, y []frontend.Variable) []frontend.Variable {
+	z := make([]frontend.Variable, 128)
+	for i := range z {
+		z[i] = 0
+	}
+	v := y
+	for i := 0; i < 128; i++ {
+		for j := 0; j < 128; j++ {
+			z[j] = xorBit(c.api, z[j], c.api.Mul(x[i], v[j]))
+		}
+		if i == 127 {
+			break
+		}
+		// v = v >> 1, reduced by R = 11100001 || 0^120 when the bit shifted
+		// out is set
+		msb := v[127]
+		nv := make([]frontend.Variable, 128)
+		copy(nv[1:], v[:127])
+		nv[0] = msb
+		nv[1] = xorBit(c.api, nv[1], msb)
+		nv[2] = xorBit(c.api, nv[2], msb)
+		nv[7] = xorBit(c.api, nv[7], msb)
+		v = nv
+	}
+	return z
+}
+
+// blockToBits decomposes a block of bytes into its bits in the GCM bit
+// order, i.e. the most significant bit of the first byte first.
+func blockToBits(api frontend.API, block []frontend.Variable) []frontend.Variable {
+	res := make([]frontend.Variable, 8*len(block))
+	for i, b := range block {
+		bts := bits.ToBinary(api, b, bits.WithNbDigits(8))
+		for j := 0; j < 8; j++ {
+			res[8*i+j] = bts[7-j]
+		}
+	}
+	return res
+}
+
+func xorBits(api frontend.API, a, b []frontend.Variable) []frontend.Variable {
+	res := make([]frontend.Variable, len(a))
+	for i := range res {
+		res[i] = xorBit(api, a[i], b[i])
+	}
+	return res
+}
+
+func xorBit(api frontend.API, a, b frontend.Variable) frontend.Variable {
+	return api.Sub(api.Add(a, b), api.Mul(2, api.Mul(a, b)))
+}
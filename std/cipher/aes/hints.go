@@ -0,0 +1,21 @@
+package aes
+
+import (
+	"math/big"
+
+	"github.com/consensys/gnark/constraint/solver"
+)
+
+func init() {
+	solver.RegisterHint(GetHints()...)
+}
+
+// GetHints returns all hint functions used in the package.
+func GetHints() []solver.Hint {
+	return []solver.Hint{xorHint}
+}
+
+func xorHint(_ *big.Int, inputs, outputs []*big.Int) error {
+	outputs[0].Xor(inputs[0], inputs[1])
+	return nil
+}
@@ -0,0 +1,158 @@
+// Package dory implements in-circuit verification of evaluation proofs for a
+// transparent, pairing-based polynomial commitment in the style of Dory
+// [Lee21]. No trusted setup is required: the commitment key consists of
+// random group elements with unknown discrete logarithm relations.
+//
+// The coefficients of the committed polynomial are arranged in an m×w matrix
+// (a_{j,i}) with f = Σ_{j,i} a_{j,i}·X^{j·w+i}. The rows are committed as
+// Pedersen commitments T_j = Σᵢ a_{j,i}·Γ₁ᵢ over the G₁ key and compressed
+// into a single target-group element with the AFGHO inner-pairing-product
+// commitment
+//
+//	C = Π_j e(T_j, Γ₂_j).
+//
+// An evaluation proof for f(x) = y reveals the row commitments and the folded
+// row u = Σ_j x^{j·w}·(a_{j,1}, ..., a_{j,w}); the verifier checks the rows
+// against C with a multi-pairing, folds them with the powers x^{j·w} and
+// checks the result is the Pedersen commitment of u, and finally that
+// y = Σᵢ uᵢ·xⁱ. Proof size and verifier work are O(√n).
+//
+// This is the square-root baseline that Dory's recursive reduction
+// accelerates to logarithmic; the log-round reduction is left for a follow-up
+// and the gadget currently instantiates BN254 only.
+//
+// [Lee21]: https://eprint.iacr.org/2020/1274
+package dory
+
+import (
+	"fmt"
+
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/algebra"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_bn254"
+	"github.com/consensys/gnark/std/math/emulated"
+)
+
+// Params is the transparent commitment key. The elements must be sampled
+// without known discrete logarithm relations, e.g. by hashing to the curve.
+type Params struct {
+	// G1 is the row key Γ₁ of length w, the matrix width.
+	G1 []sw_bn254.G1Affine
+	// G2 is the column key Γ₂ of length m, the number of rows.
+	G2 []sw_bn254.G2Affine
+}
+
+// Commitment is an inner-pairing-product commitment to a polynomial.
+type Commitment struct {
+	T sw_bn254.GTEl
+}
+
+// Proof is an evaluation proof for a committed polynomial.
+type Proof struct {
+	// Rows are the Pedersen row commitments T_j.
+	Rows []sw_bn254.G1Affine
+	// U is the folded row Σ_j x^{j·w}·a_j.
+	U []emulated.Element[sw_bn254.ScalarField]
+}
+
+// Verifier verifies Dory-style evaluation proofs.
+type Verifier struct {
+	api       frontend.API
+	curve     algebra.Curve[sw_bn254.ScalarField, sw_bn254.G1Affine]
+	pairing   *sw_bn254.Pairing
+	scalarApi *emulated.Field[sw_bn254.ScalarField]
+}
+
+// NewVerifier returns a new verifier using the native arithmetic of api.
+func NewVerifier(api frontend.API) (*Verifier, error) {
+	curve, err := algebra.GetCurve[sw_bn254.ScalarField, sw_bn254.G1Affine](api)
+	if err != nil {
+		return nil, fmt.Errorf("get curve: %w", err)
+	}
+	pairing, err := sw_bn254.NewPairing(api)
+	if err != nil {
+		return nil, fmt.Errorf("new pairing: %w", err)
+	}
+	scalarApi, err := emulated.NewField[sw_bn254.ScalarField](api)
+	if err != nil {
+		return nil, fmt.Errorf("new scalar field: %w", err)
+	}
+	return &Verifier{
+		api:       api,
+		curve:     curve,
+		pairing:   pairing,
+		scalarApi: scalarApi,
+	}, nil
+}
+
+// AssertProof asserts the validity of the evaluation proof for f(point) =
+// value against the commitment.
+func (v *Verifier) AssertProof(params Params, commitment Commitment, proof Proof, point, value emulated.Element[sw_bn254.ScalarField]) error {
+	m := len(params.G2)
+	w := len(params.G1)
+	if len(proof.Rows) != m {
+		return fmt.Errorf("number of rows doesn't match G2 key length")
+	}
+	if len(proof.U) != w {
+		return fmt.Errorf("folded row length doesn't match G1 key length")
+	}
+	if m == 0 || w == 0 {
+		return fmt.Errorf("empty commitment key")
+	}
+
+	// the rows bind to the commitment: Π_j e(T_j, Γ₂_j) == C
+	rows := make([]*sw_bn254.G1Affine, m)
+	cols := make([]*sw_bn254.G2Affine, m)
+	for j := range rows {
+		rows[j] = &proof.Rows[j]
+		cols[j] = &params.G2[j]
+	}
+	res, err := v.pairing.Pair(rows, cols)
+	if err != nil {
+		return fmt.Errorf("pair rows: %w", err)
+	}
+	v.pairing.AssertIsEqual(res, &commitment.T)
+
+	// powers 1, x, ..., x^{w-1} and x^w, x^{2w}, ...
+	xi := make([]*emulated.Element[sw_bn254.ScalarField], w)
+	xi[0] = v.scalarApi.One()
+	for i := 1; i < w; i++ {
+		xi[i] = v.scalarApi.Mul(xi[i-1], &point)
+	}
+	xw := v.scalarApi.Mul(xi[w-1], &point)
+
+	// fold the rows with x^{j·w} and compare against the Pedersen
+	// commitment of the folded row u
+	folded := rows[0]
+	if m > 1 {
+		xwj := make([]*emulated.Element[sw_bn254.ScalarField], m-1)
+		xwj[0] = xw
+		for j := 1; j < m-1; j++ {
+			xwj[j] = v.scalarApi.Mul(xwj[j-1], xw)
+		}
+		foldedTail, err := v.curve.MultiScalarMul(rows[1:], xwj)
+		if err != nil {
+			return fmt.Errorf("fold rows: %w", err)
+		}
+		folded = v.curve.Add(rows[0], foldedTail)
+	}
+	key := make([]*sw_bn254.G1Affine, w)
+	us := make([]*emulated.Element[sw_bn254.ScalarField], w)
+	for i := range key {
+		key[i] = &params.G1[i]
+		us[i] = &proof.U[i]
+	}
+	pedersen, err := v.curve.MultiScalarMul(key, us)
+	if err != nil {
+		return fmt.Errorf("commit folded row: %w", err)
+	}
+	v.curve.AssertIsEqual(folded, pedersen)
+
+	// the folded row evaluates to the claimed value: y == Σᵢ uᵢ·xⁱ
+	eval := v.scalarApi.Zero()
+	for i := range us {
+		eval = v.scalarApi.Add(eval, v.scalarApi.Mul(us[i], xi[i]))
+	}
+	v.scalarApi.AssertIsEqual(eval, &value)
+	return nil
+}
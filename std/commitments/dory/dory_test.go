@@ -0,0 +1,173 @@
+package dory
+
+import (
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bn254"
+	fr_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_bn254"
+	"github.com/consensys/gnark/std/math/emulated"
+	"github.com/consensys/gnark/test"
+)
+
+const (
+	testRows  = 2
+	testWidth = 4
+)
+
+type DoryCircuit struct {
+	Params     Params
+	Commitment Commitment
+	Proof      Proof
+	Point      emulated.Element[sw_bn254.ScalarField]
+	Value      emulated.Element[sw_bn254.ScalarField]
+}
+
+func (c *DoryCircuit) Define(api frontend.API) error {
+	verifier, err := NewVerifier(api)
+	if err != nil {
+		return fmt.Errorf("new verifier: %w", err)
+	}
+	if err := verifier.AssertProof(c.Params, c.Commitment, c.Proof, c.Point, c.Value); err != nil {
+		return fmt.Errorf("assert proof: %w", err)
+	}
+	return nil
+}
+
+// nativeProver commits to random coefficients and opens at a random point
+// with a native implementation of the prover.
+func nativeProver(t *testing.T) (Params, Commitment, Proof, fr_bn254.Element, fr_bn254.Element) {
+	t.Helper()
+	_, _, g1Gen, g2Gen := bn254.Generators()
+
+	// transparent key: random multiples of the generators stand in for
+	// hash-to-curve sampling
+	g1Key := make([]bn254.G1Affine, testWidth)
+	for i := range g1Key {
+		s, err := rand.Int(rand.Reader, ecc.BN254.ScalarField())
+		if err != nil {
+			t.Fatal(err)
+		}
+		g1Key[i].ScalarMultiplication(&g1Gen, s)
+	}
+	g2Key := make([]bn254.G2Affine, testRows)
+	for j := range g2Key {
+		s, err := rand.Int(rand.Reader, ecc.BN254.ScalarField())
+		if err != nil {
+			t.Fatal(err)
+		}
+		g2Key[j].ScalarMultiplication(&g2Gen, s)
+	}
+
+	// random coefficient matrix
+	var coeffs [testRows][testWidth]fr_bn254.Element
+	for j := range coeffs {
+		for i := range coeffs[j] {
+			coeffs[j][i].SetRandom()
+		}
+	}
+
+	// row commitments and their inner pairing product
+	rows := make([]bn254.G1Affine, testRows)
+	for j := range rows {
+		if _, err := rows[j].MultiExp(g1Key, coeffs[j][:], ecc.MultiExpConfig{}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	com, err := bn254.Pair(rows, g2Key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// open at a random point
+	var point fr_bn254.Element
+	point.SetRandom()
+	var xw fr_bn254.Element
+	xw.Exp(point, new(big.Int).SetInt64(testWidth))
+	var u [testWidth]fr_bn254.Element
+	xwj := fr_bn254.One()
+	for j := 0; j < testRows; j++ {
+		for i := 0; i < testWidth; i++ {
+			var tmp fr_bn254.Element
+			tmp.Mul(&coeffs[j][i], &xwj)
+			u[i].Add(&u[i], &tmp)
+		}
+		xwj.Mul(&xwj, &xw)
+	}
+	var value, xi fr_bn254.Element
+	xi.SetOne()
+	for i := 0; i < testWidth; i++ {
+		var tmp fr_bn254.Element
+		tmp.Mul(&u[i], &xi)
+		value.Add(&value, &tmp)
+		xi.Mul(&xi, &point)
+	}
+
+	params := Params{
+		G1: make([]sw_bn254.G1Affine, testWidth),
+		G2: make([]sw_bn254.G2Affine, testRows),
+	}
+	for i := range g1Key {
+		params.G1[i] = sw_bn254.NewG1Affine(g1Key[i])
+	}
+	for j := range g2Key {
+		params.G2[j] = sw_bn254.NewG2Affine(g2Key[j])
+	}
+	proof := Proof{
+		Rows: make([]sw_bn254.G1Affine, testRows),
+		U:    make([]emulated.Element[sw_bn254.ScalarField], testWidth),
+	}
+	for j := range rows {
+		proof.Rows[j] = sw_bn254.NewG1Affine(rows[j])
+	}
+	for i := range u {
+		proof.U[i] = emulated.ValueOf[sw_bn254.ScalarField](u[i])
+	}
+	return params, Commitment{T: sw_bn254.NewGTEl(com)}, proof, point, value
+}
+
+func placeholderCircuit() DoryCircuit {
+	var c DoryCircuit
+	c.Params.G1 = make([]sw_bn254.G1Affine, testWidth)
+	c.Params.G2 = make([]sw_bn254.G2Affine, testRows)
+	c.Proof.Rows = make([]sw_bn254.G1Affine, testRows)
+	c.Proof.U = make([]emulated.Element[sw_bn254.ScalarField], testWidth)
+	return c
+}
+
+func TestDoryVerification(t *testing.T) {
+	assert := test.NewAssert(t)
+	params, com, proof, point, value := nativeProver(t)
+
+	circuit := placeholderCircuit()
+	assignment := DoryCircuit{
+		Params:     params,
+		Commitment: com,
+		Proof:      proof,
+		Point:      emulated.ValueOf[sw_bn254.ScalarField](point),
+		Value:      emulated.ValueOf[sw_bn254.ScalarField](value),
+	}
+	assert.NoError(test.IsSolved(&circuit, &assignment, ecc.BN254.ScalarField()))
+}
+
+func TestDoryVerificationWrongValue(t *testing.T) {
+	assert := test.NewAssert(t)
+	params, com, proof, point, value := nativeProver(t)
+	var wrong fr_bn254.Element
+	wrong.SetOne().Add(&wrong, &value)
+
+	circuit := placeholderCircuit()
+	assignment := DoryCircuit{
+		Params:     params,
+		Commitment: com,
+		Proof:      proof,
+		Point:      emulated.ValueOf[sw_bn254.ScalarField](point),
+		Value:      emulated.ValueOf[sw_bn254.ScalarField](wrong),
+	}
+	assert.Error(test.IsSolved(&circuit, &assignment, ecc.BN254.ScalarField()))
+}
@@ -0,0 +1,27 @@
+package kzg
+
+// AccumulationPlan describes the pairing work a batch verification performs
+// under the current folding strategy. It is used for recursion budgeting: the
+// number of final exponentiations determines how much GT accumulation an
+// outer circuit can share.
+type AccumulationPlan struct {
+	// MillerLoops is the number of Miller loop pairs evaluated.
+	MillerLoops int
+	// FinalExponentiations is the number of final exponentiations performed.
+	FinalExponentiations int
+}
+
+// AccumulationPlan returns the pairing operations that verifying a batch of
+// nProofs opening proofs incurs under the current strategy. The verifier
+// folds any nonempty batch into a single pairing check of two pairs (see
+// [Verifier.BatchVerifyMultiPoints]), so the plan is independent of the batch
+// size. The method is pure and emits no constraints.
+func (v *Verifier[FR, G1El, G2El, GTEl]) AccumulationPlan(nProofs int) AccumulationPlan {
+	if nProofs <= 0 {
+		return AccumulationPlan{}
+	}
+	return AccumulationPlan{
+		MillerLoops:          2,
+		FinalExponentiations: 1,
+	}
+}
@@ -0,0 +1,96 @@
+package kzg
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/algebra"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_bn254"
+	"github.com/consensys/gnark/std/math/emulated"
+	"github.com/consensys/gnark/test"
+)
+
+// countingPairing wraps a pairing and records the operations performed.
+type countingPairing[G1El algebra.G1ElementT, G2El algebra.G2ElementT, GTEl algebra.GtElementT] struct {
+	algebra.Pairing[G1El, G2El, GTEl]
+	millerLoops int
+	finalExps   int
+}
+
+func (p *countingPairing[G1El, G2El, GTEl]) MillerLoop(g1 []*G1El, g2 []*G2El) (*GTEl, error) {
+	p.millerLoops += len(g1)
+	return p.Pairing.MillerLoop(g1, g2)
+}
+
+func (p *countingPairing[G1El, G2El, GTEl]) FinalExponentiation(gt *GTEl) *GTEl {
+	p.finalExps++
+	return p.Pairing.FinalExponentiation(gt)
+}
+
+func (p *countingPairing[G1El, G2El, GTEl]) Pair(g1 []*G1El, g2 []*G2El) (*GTEl, error) {
+	p.millerLoops += len(g1)
+	p.finalExps++
+	return p.Pairing.Pair(g1, g2)
+}
+
+func (p *countingPairing[G1El, G2El, GTEl]) PairingCheck(g1 []*G1El, g2 []*G2El) error {
+	p.millerLoops += len(g1)
+	p.finalExps++
+	return p.Pairing.PairingCheck(g1, g2)
+}
+
+type AccumulationPlanCircuit[FR emulated.FieldParams, G1El algebra.G1ElementT, G2El algebra.G2ElementT, GTEl algebra.GtElementT] struct {
+	Batch MultiSRSBatch[FR, G1El, G2El]
+}
+
+func (c *AccumulationPlanCircuit[FR, G1El, G2El, GTEl]) Define(api frontend.API) error {
+	scalarApi, err := emulated.NewField[FR](api)
+	if err != nil {
+		return fmt.Errorf("new scalar field: %w", err)
+	}
+	curve, err := algebra.GetCurve[FR, G1El](api)
+	if err != nil {
+		return fmt.Errorf("get curve: %w", err)
+	}
+	pairing, err := algebra.GetPairing[G1El, G2El, GTEl](api)
+	if err != nil {
+		return fmt.Errorf("get pairing: %w", err)
+	}
+	counting := &countingPairing[G1El, G2El, GTEl]{Pairing: pairing}
+	verifier, err := NewVerifierWithContext[FR](api, scalarApi, curve, counting)
+	if err != nil {
+		return fmt.Errorf("new verifier: %w", err)
+	}
+	if err := verifier.BatchVerifyMultiPoints(c.Batch.Digests, c.Batch.Proofs, c.Batch.Points, c.Batch.VK); err != nil {
+		return fmt.Errorf("batch verify: %w", err)
+	}
+	// the plan must match the operations actually emitted
+	plan := verifier.AccumulationPlan(len(c.Batch.Proofs))
+	if counting.millerLoops != plan.MillerLoops {
+		return fmt.Errorf("plan predicts %d Miller loops, emitted %d", plan.MillerLoops, counting.millerLoops)
+	}
+	if counting.finalExps != plan.FinalExponentiations {
+		return fmt.Errorf("plan predicts %d final exponentiations, emitted %d", plan.FinalExponentiations, counting.finalExps)
+	}
+	return nil
+}
+
+func TestAccumulationPlan(t *testing.T) {
+	assert := test.NewAssert(t)
+	for _, nbProofs := range []int{1, 2, 3} {
+		assert.Run(func(assert *test.Assert) {
+			batch := openingsUnderSRS(t, nbProofs)
+			var circuit, assignment AccumulationPlanCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]
+			assignment.Batch = batch
+			circuit.Batch.Digests = make([]Commitment[sw_bn254.G1Affine], nbProofs)
+			circuit.Batch.Proofs = make([]OpeningProof[sw_bn254.ScalarField, sw_bn254.G1Affine], nbProofs)
+			circuit.Batch.Points = make([]emulated.Element[sw_bn254.ScalarField], nbProofs)
+			assert.NoError(test.IsSolved(&circuit, &assignment, ecc.BN254.ScalarField()))
+		}, fmt.Sprintf("proofs=%d", nbProofs))
+	}
+	// the empty batch incurs no pairing work
+	var v Verifier[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]
+	assert.Equal(AccumulationPlan{}, v.AccumulationPlan(0))
+}
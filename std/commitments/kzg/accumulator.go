@@ -0,0 +1,50 @@
+package kzg
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/accumulator/rsa"
+	"github.com/consensys/gnark/std/algebra"
+	"github.com/consensys/gnark/std/algebra/algopts"
+	"github.com/consensys/gnark/std/math/emulated"
+)
+
+// AssertProofWithAccumulator verifies the opening proof and that the
+// commitment is a member of an RSA (or class-group) accumulator. The
+// accumulated element is bound to the commitment by construction: it is the
+// marshalled commitment (matching gnark-crypto point marshalling),
+// interpreted as an integer over the accumulator parametrization M. The
+// membership relation witness^element ≡ accumulator (mod modulus) is then
+// checked with [rsa.VerifyMembership].
+//
+// Deployments whose accumulators require prime representatives should
+// accumulate a prime derived from the commitment off-circuit and verify that
+// mapping separately; this method checks membership of the raw serialization.
+//
+// It is a free function instead of a method on [Verifier] as it additionally
+// parametrizes the accumulator integer size M. Use [emparams.Mod1e4096] (or
+// [emparams.Mod1e512] for small moduli over BN254) for M; the marshalled
+// commitment and the RSA modulus must both fit in M.
+func AssertProofWithAccumulator[FR emulated.FieldParams, G1El algebra.G1ElementT, G2El algebra.G2ElementT, GTEl algebra.GtElementT, M emulated.FieldParams](api frontend.API, commitment Commitment[G1El], proof OpeningProof[FR, G1El], point emulated.Element[FR], vk VerifyingKey[G1El, G2El], accumulator, witness, modulus emulated.Element[M]) error {
+	v, err := NewVerifier[FR, G1El, G2El, GTEl](api)
+	if err != nil {
+		return fmt.Errorf("new verifier: %w", err)
+	}
+	if err := v.CheckOpeningProof(commitment, proof, point, vk); err != nil {
+		return fmt.Errorf("check opening proof: %w", err)
+	}
+	accApi, err := emulated.NewField[M](api)
+	if err != nil {
+		return fmt.Errorf("new accumulator field: %w", err)
+	}
+	// marshalled bits are big-endian, FromBits expects little-endian
+	cmtBits := v.curve.MarshalG1(commitment.G1El, algopts.WithCanonicalBitRepresentation())
+	slices.Reverse(cmtBits)
+	element := accApi.FromBits(cmtBits...)
+	if err := rsa.VerifyMembership(api, accumulator, *element, witness, modulus); err != nil {
+		return fmt.Errorf("verify membership: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,91 @@
+package kzg
+
+import (
+	"crypto/rand"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	fr_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	kzg_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/kzg"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/algebra"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_bn254"
+	"github.com/consensys/gnark/std/math/emulated"
+	"github.com/consensys/gnark/std/math/emulated/emparams"
+	"github.com/consensys/gnark/test"
+)
+
+type AccumulatorCircuit[FR emulated.FieldParams, G1El algebra.G1ElementT, G2El algebra.G2ElementT, GTEl algebra.GtElementT, M emulated.FieldParams] struct {
+	VerifyingKey[G1El, G2El]
+	Commitment[G1El]
+	OpeningProof[FR, G1El]
+	Point       emulated.Element[FR]
+	Accumulator emulated.Element[M]
+	AccWitness  emulated.Element[M]
+	AccModulus  emulated.Element[M]
+}
+
+func (c *AccumulatorCircuit[FR, G1El, G2El, GTEl, M]) Define(api frontend.API) error {
+	return AssertProofWithAccumulator[FR, G1El, G2El, GTEl](api, c.Commitment, c.OpeningProof, c.Point, c.VerifyingKey, c.Accumulator, c.AccWitness, c.AccModulus)
+}
+
+func TestAssertProofWithAccumulator(t *testing.T) {
+	assert := test.NewAssert(t)
+
+	alpha, err := rand.Int(rand.Reader, ecc.BN254.ScalarField())
+	assert.NoError(err)
+	srs, err := kzg_bn254.NewSRS(kzgSize, alpha)
+	assert.NoError(err)
+	f := make([]fr_bn254.Element, polynomialSize)
+	for i := range f {
+		f[i].SetRandom()
+	}
+	com, err := kzg_bn254.Commit(f, srs.Pk)
+	assert.NoError(err)
+	var point fr_bn254.Element
+	point.SetRandom()
+	proof, err := kzg_bn254.Open(f, point, srs.Pk)
+	assert.NoError(err)
+
+	// native RSA accumulator holding the marshalled commitment and one more
+	// prime member
+	p, err := rand.Prime(rand.Reader, 256)
+	assert.NoError(err)
+	q, err := rand.Prime(rand.Reader, 256)
+	assert.NoError(err)
+	n := new(big.Int).Mul(p, q)
+	g, err := rand.Int(rand.Reader, n)
+	assert.NoError(err)
+	element := new(big.Int).SetBytes(com.Marshal())
+	other, err := rand.Prime(rand.Reader, 128)
+	assert.NoError(err)
+	accumulator := new(big.Int).Exp(g, new(big.Int).Mul(element, other), n)
+	accWitness := new(big.Int).Exp(g, other, n)
+
+	circuit := AccumulatorCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl, emparams.Mod1e512]{}
+	assignment := AccumulatorCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl, emparams.Mod1e512]{
+		Point:       emulated.ValueOf[sw_bn254.ScalarField](point),
+		Accumulator: emulated.ValueOf[emparams.Mod1e512](accumulator),
+		AccWitness:  emulated.ValueOf[emparams.Mod1e512](accWitness),
+		AccModulus:  emulated.ValueOf[emparams.Mod1e512](n),
+	}
+	assignment.VerifyingKey, err = ValueOfVerifyingKey[sw_bn254.G1Affine, sw_bn254.G2Affine](srs.Vk)
+	assert.NoError(err)
+	assignment.Commitment, err = ValueOfCommitment[sw_bn254.G1Affine](com)
+	assert.NoError(err)
+	assignment.OpeningProof, err = ValueOfOpeningProof[sw_bn254.ScalarField, sw_bn254.G1Affine](proof)
+	assert.NoError(err)
+
+	assert.Run(func(assert *test.Assert) {
+		assert.NoError(test.IsSolved(&circuit, &assignment, ecc.BN254.ScalarField()))
+	}, "valid")
+
+	assert.Run(func(assert *test.Assert) {
+		// an accumulator not holding the commitment is rejected
+		badAccumulator := new(big.Int).Exp(g, new(big.Int).Mul(element, big.NewInt(3)), n)
+		badAssignment := assignment
+		badAssignment.Accumulator = emulated.ValueOf[emparams.Mod1e512](badAccumulator)
+		assert.Error(test.IsSolved(&circuit, &badAssignment, ecc.BN254.ScalarField()))
+	}, "wrong accumulator")
+}
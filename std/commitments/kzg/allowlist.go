@@ -0,0 +1,24 @@
+package kzg
+
+import (
+	"fmt"
+
+	"github.com/consensys/gnark/std/math/emulated"
+)
+
+// AssertProofPointInAllowlist verifies the opening proof and that the opening
+// point belongs to a committed allowlist of points, without revealing which
+// entry it is. The allowlist {p₁, ..., pₙ} is committed as its vanishing
+// polynomial, as in [Verifier.AssertTableMembership], and membershipProof is
+// a KZG opening proof of the allowlist commitment at the opening point. As
+// the point stays a circuit witness, the verifier learns only that it is one
+// of the allowed points.
+func (v *Verifier[FR, G1El, G2El, GTEl]) AssertProofPointInAllowlist(commitment Commitment[G1El], proof OpeningProof[FR, G1El], point emulated.Element[FR], allowlistCommitment Commitment[G1El], membershipProof OpeningProof[FR, G1El], vk VerifyingKey[G1El, G2El]) error {
+	if err := v.CheckOpeningProof(commitment, proof, point, vk); err != nil {
+		return fmt.Errorf("check opening proof: %w", err)
+	}
+	if err := v.AssertTableMembership(allowlistCommitment, point, membershipProof, vk); err != nil {
+		return fmt.Errorf("allowlist membership: %w", err)
+	}
+	return nil
+}
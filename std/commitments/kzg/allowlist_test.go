@@ -0,0 +1,93 @@
+package kzg
+
+import (
+	"crypto/rand"
+	"fmt"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	fr_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	kzg_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/kzg"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/algebra"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_bn254"
+	"github.com/consensys/gnark/std/math/emulated"
+	"github.com/consensys/gnark/test"
+)
+
+type AllowlistCircuit[FR emulated.FieldParams, G1El algebra.G1ElementT, G2El algebra.G2ElementT, GTEl algebra.GtElementT] struct {
+	VerifyingKey[G1El, G2El]
+	Commitment[G1El]
+	OpeningProof[FR, G1El]
+	Point               emulated.Element[FR]
+	AllowlistCommitment Commitment[G1El]
+	MembershipProof     OpeningProof[FR, G1El]
+}
+
+func (c *AllowlistCircuit[FR, G1El, G2El, GTEl]) Define(api frontend.API) error {
+	verifier, err := NewVerifier[FR, G1El, G2El, GTEl](api)
+	if err != nil {
+		return fmt.Errorf("new verifier: %w", err)
+	}
+	return verifier.AssertProofPointInAllowlist(c.Commitment, c.OpeningProof, c.Point, c.AllowlistCommitment, c.MembershipProof, c.VerifyingKey)
+}
+
+func TestAssertProofPointInAllowlist(t *testing.T) {
+	assert := test.NewAssert(t)
+
+	alpha, err := rand.Int(rand.Reader, ecc.BN254.ScalarField())
+	assert.NoError(err)
+	srs, err := kzg_bn254.NewSRS(kzgSize, alpha)
+	assert.NoError(err)
+
+	f := make([]fr_bn254.Element, polynomialSize)
+	for i := range f {
+		f[i].SetRandom()
+	}
+	com, err := kzg_bn254.Commit(f, srs.Pk)
+	assert.NoError(err)
+
+	// the allowlist is committed as its vanishing polynomial
+	allowlist := make([]fr_bn254.Element, 8)
+	for i := range allowlist {
+		allowlist[i].SetRandom()
+	}
+	z := vanishingPolynomial(allowlist)
+	allowCom, err := kzg_bn254.Commit(z, srs.Pk)
+	assert.NoError(err)
+
+	buildAssignment := func(point fr_bn254.Element) AllowlistCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl] {
+		proof, err := kzg_bn254.Open(f, point, srs.Pk)
+		assert.NoError(err)
+		membershipProof, err := kzg_bn254.Open(z, point, srs.Pk)
+		assert.NoError(err)
+		var assignment AllowlistCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]
+		assignment.VerifyingKey, err = ValueOfVerifyingKey[sw_bn254.G1Affine, sw_bn254.G2Affine](srs.Vk)
+		assert.NoError(err)
+		assignment.Commitment, err = ValueOfCommitment[sw_bn254.G1Affine](com)
+		assert.NoError(err)
+		assignment.OpeningProof, err = ValueOfOpeningProof[sw_bn254.ScalarField, sw_bn254.G1Affine](proof)
+		assert.NoError(err)
+		assignment.Point, err = ValueOfScalar[sw_bn254.ScalarField](point)
+		assert.NoError(err)
+		assignment.AllowlistCommitment, err = ValueOfCommitment[sw_bn254.G1Affine](allowCom)
+		assert.NoError(err)
+		assignment.MembershipProof, err = ValueOfOpeningProof[sw_bn254.ScalarField, sw_bn254.G1Affine](membershipProof)
+		assert.NoError(err)
+		return assignment
+	}
+
+	var circuit AllowlistCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]
+
+	assert.Run(func(assert *test.Assert) {
+		assignment := buildAssignment(allowlist[5])
+		assert.NoError(test.IsSolved(&circuit, &assignment, ecc.BN254.ScalarField()))
+	}, "allowed point")
+
+	assert.Run(func(assert *test.Assert) {
+		var outside fr_bn254.Element
+		outside.SetRandom()
+		assignment := buildAssignment(outside)
+		assert.Error(test.IsSolved(&circuit, &assignment, ecc.BN254.ScalarField()))
+	}, "disallowed point")
+}
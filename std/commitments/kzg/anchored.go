@@ -0,0 +1,77 @@
+package kzg
+
+import (
+	"fmt"
+
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/accumulator/merkle"
+	"github.com/consensys/gnark/std/hash"
+	"github.com/consensys/gnark/std/math/emulated"
+	"github.com/consensys/gnark/std/recursion"
+)
+
+// HeaderChainProof carries the witness anchoring a commitment in a block of a
+// committed header chain. Headers are modelled as the running hash
+//
+//	dᵢ = H(dᵢ₋₁, rootᵢ)
+//
+// where rootᵢ is the Merkle root of the block contents. BlockRoots lists the
+// roots from the anchoring block up to the chain head, so the circuit fixes
+// the distance between the anchored block and the head; the block height is
+// the head height minus len(BlockRoots)-1.
+type HeaderChainProof struct {
+	// HeadDigest is the header digest of the chain head, the public anchor.
+	HeadDigest frontend.Variable
+	// ParentDigest is the header digest of the block preceding the anchoring
+	// block.
+	ParentDigest frontend.Variable
+	// BlockRoots are the content Merkle roots from the anchoring block
+	// (index 0) to the chain head.
+	BlockRoots []frontend.Variable
+	// Merkle proves membership of the commitment digest under BlockRoots[0].
+	Merkle merkle.MerkleProof
+	// LeafIndex is the index of the commitment digest leaf in the block
+	// content tree.
+	LeafIndex frontend.Variable
+}
+
+// AssertAnchoredOpening verifies the KZG opening proof and that the
+// commitment is anchored in a block of the header chain committed to by
+// headerChainProof.HeadDigest. The commitment is encoded as the short hash
+// (see [recursion.NewHash]) of its serialization and proven a leaf of the
+// anchoring block's content tree; the block's root is then chained with the
+// subsequent roots up to the head digest. The Merkle tree and the header
+// chain use the caller-provided hasher, the commitment encoding uses the
+// recursion hash so the native light client computes it with
+// [recursion.NewShort].
+func (v *Verifier[FR, G1El, G2El, GTEl]) AssertAnchoredOpening(commitment Commitment[G1El], proof OpeningProof[FR, G1El], point emulated.Element[FR], vk VerifyingKey[G1El, G2El], headerChainProof HeaderChainProof, hasher hash.FieldHasher) error {
+	if len(headerChainProof.BlockRoots) == 0 {
+		return fmt.Errorf("header chain should contain the anchoring block")
+	}
+	var fr FR
+	h, err := recursion.NewHash(v.api, fr.Modulus(), true)
+	if err != nil {
+		return fmt.Errorf("new hash: %w", err)
+	}
+	h.Write(v.curve.MarshalG1(commitment.G1El)...)
+	digest := h.Sum()
+
+	// the commitment digest is a leaf of the anchoring block's content tree
+	v.api.AssertIsEqual(headerChainProof.Merkle.Path[0], digest)
+	v.api.AssertIsEqual(headerChainProof.Merkle.RootHash, headerChainProof.BlockRoots[0])
+	headerChainProof.Merkle.VerifyProof(v.api, hasher, headerChainProof.LeafIndex)
+
+	// chain the headers from the anchoring block to the head
+	headerDigest := headerChainProof.ParentDigest
+	for _, root := range headerChainProof.BlockRoots {
+		hasher.Reset()
+		hasher.Write(headerDigest, root)
+		headerDigest = hasher.Sum()
+	}
+	v.api.AssertIsEqual(headerDigest, headerChainProof.HeadDigest)
+
+	if err := v.CheckOpeningProof(commitment, proof, point, vk); err != nil {
+		return fmt.Errorf("check opening proof: %w", err)
+	}
+	return nil
+}
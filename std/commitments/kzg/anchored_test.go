@@ -0,0 +1,152 @@
+package kzg
+
+import (
+	"crypto/rand"
+	"fmt"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	fr_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	kzg_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/kzg"
+	crypto_hash "github.com/consensys/gnark-crypto/hash"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/accumulator/merkle"
+	"github.com/consensys/gnark/std/algebra"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_bn254"
+	"github.com/consensys/gnark/std/hash/mimc"
+	"github.com/consensys/gnark/std/math/emulated"
+	"github.com/consensys/gnark/std/recursion"
+	"github.com/consensys/gnark/test"
+)
+
+const (
+	testNbHeaders     = 3
+	testMerkleDepth   = 2
+	testAnchoredIndex = 2
+)
+
+type AnchoredOpeningCircuit[FR emulated.FieldParams, G1El algebra.G1ElementT, G2El algebra.G2ElementT, GTEl algebra.GtElementT] struct {
+	VerifyingKey[G1El, G2El]
+	Commitment[G1El]
+	OpeningProof[FR, G1El]
+	Point       emulated.Element[FR]
+	HeaderChain HeaderChainProof
+}
+
+func (c *AnchoredOpeningCircuit[FR, G1El, G2El, GTEl]) Define(api frontend.API) error {
+	verifier, err := NewVerifier[FR, G1El, G2El, GTEl](api)
+	if err != nil {
+		return fmt.Errorf("new verifier: %w", err)
+	}
+	hasher, err := mimc.NewMiMC(api)
+	if err != nil {
+		return fmt.Errorf("new mimc: %w", err)
+	}
+	return verifier.AssertAnchoredOpening(c.Commitment, c.OpeningProof, c.Point, c.VerifyingKey, c.HeaderChain, &hasher)
+}
+
+// mimcSum returns the native MiMC hash of the given field elements.
+func mimcSum(elems ...fr_bn254.Element) fr_bn254.Element {
+	h := crypto_hash.MIMC_BN254.New()
+	for i := range elems {
+		h.Write(elems[i].Marshal())
+	}
+	var res fr_bn254.Element
+	res.SetBytes(h.Sum(nil))
+	return res
+}
+
+func TestAssertAnchoredOpening(t *testing.T) {
+	assert := test.NewAssert(t)
+
+	alpha, err := rand.Int(rand.Reader, ecc.BN254.ScalarField())
+	assert.NoError(err)
+	srs, err := kzg_bn254.NewSRS(kzgSize, alpha)
+	assert.NoError(err)
+
+	f := make([]fr_bn254.Element, polynomialSize)
+	for i := range f {
+		f[i].SetRandom()
+	}
+	com, err := kzg_bn254.Commit(f, srs.Pk)
+	assert.NoError(err)
+	var point fr_bn254.Element
+	point.SetRandom()
+	proof, err := kzg_bn254.Open(f, point, srs.Pk)
+	assert.NoError(err)
+
+	// the commitment digest is a leaf of the anchoring block's content tree
+	hs, err := recursion.NewShort(ecc.BN254.ScalarField(), ecc.BN254.ScalarField())
+	assert.NoError(err)
+	hs.Write(com.Marshal())
+	var comDigest fr_bn254.Element
+	comDigest.SetBytes(hs.Sum(nil))
+
+	leaves := make([]fr_bn254.Element, 1<<testMerkleDepth)
+	for i := range leaves {
+		leaves[i].SetRandom()
+	}
+	leaves[testAnchoredIndex] = comDigest
+	nodes := make([]fr_bn254.Element, len(leaves))
+	for i := range leaves {
+		nodes[i] = mimcSum(leaves[i])
+	}
+	left := mimcSum(nodes[0], nodes[1])
+	right := mimcSum(nodes[2], nodes[3])
+	blockRoot := mimcSum(left, right)
+
+	// header chain from the anchoring block to the head
+	roots := make([]fr_bn254.Element, testNbHeaders)
+	roots[0] = blockRoot
+	for i := 1; i < testNbHeaders; i++ {
+		roots[i].SetRandom()
+	}
+	var parentDigest fr_bn254.Element
+	parentDigest.SetRandom()
+	headDigest := parentDigest
+	for i := range roots {
+		headDigest = mimcSum(headDigest, roots[i])
+	}
+
+	wVk, err := ValueOfVerifyingKey[sw_bn254.G1Affine, sw_bn254.G2Affine](srs.Vk)
+	assert.NoError(err)
+	wCom, err := ValueOfCommitment[sw_bn254.G1Affine](com)
+	assert.NoError(err)
+	wProof, err := ValueOfOpeningProof[sw_bn254.ScalarField, sw_bn254.G1Affine](proof)
+	assert.NoError(err)
+	wPoint, err := ValueOfScalar[sw_bn254.ScalarField](point)
+	assert.NoError(err)
+
+	circuit := AnchoredOpeningCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{
+		HeaderChain: HeaderChainProof{
+			BlockRoots: make([]frontend.Variable, testNbHeaders),
+			Merkle: merkle.MerkleProof{
+				Path: make([]frontend.Variable, testMerkleDepth+1),
+			},
+		},
+	}
+	assignment := AnchoredOpeningCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{
+		VerifyingKey: wVk,
+		Commitment:   wCom,
+		OpeningProof: wProof,
+		Point:        wPoint,
+		HeaderChain: HeaderChainProof{
+			HeadDigest:   headDigest,
+			ParentDigest: parentDigest,
+			BlockRoots:   []frontend.Variable{roots[0], roots[1], roots[2]},
+			Merkle: merkle.MerkleProof{
+				RootHash: blockRoot,
+				Path:     []frontend.Variable{comDigest, nodes[3], left},
+			},
+			LeafIndex: testAnchoredIndex,
+		},
+	}
+	assert.NoError(test.IsSolved(&circuit, &assignment, ecc.BN254.ScalarField()))
+
+	// a head digest of a chain not containing the block is rejected
+	badAssignment := assignment
+	var otherHead fr_bn254.Element
+	otherHead.SetRandom()
+	badAssignment.HeaderChain.HeadDigest = otherHead
+	assert.Error(test.IsSolved(&circuit, &badAssignment, ecc.BN254.ScalarField()))
+}
@@ -0,0 +1,68 @@
+package kzg
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/consensys/gnark/std/math/emulated"
+)
+
+// AssertBarycentricOpening asserts that claimedValue equals the barycentric
+// interpolation of the evaluations over the domain at the given point,
+//
+//	f(z) = (zⁿ-1)/n · Σᵢ evals[i]·ωⁱ/(z-ωⁱ),
+//
+// verifying an opening of a polynomial given in evaluation form without
+// interpolating it, as produced by FRI/STARK provers. The barycentric weights
+// are derived from the domain parameters at compile time. To additionally tie
+// the evaluations to a KZG commitment, pass the constrained claimed value
+// into the usual pairing check ([Verifier.CheckOpeningProof]) or recompute
+// the commitment from the evaluations ([Verifier.AssertLowDegree]).
+//
+// The barycentric formula is undefined when the point is a domain element. In
+// that case the evaluation is one of the inputs and needs no interpolation,
+// so the circuit explicitly constrains zⁿ-1 to be nonzero, rejecting
+// in-domain points.
+func (v *Verifier[FR, G1El, G2El, GTEl]) AssertBarycentricOpening(evals []emulated.Element[FR], point, claimedValue emulated.Element[FR], domain *Domain) error {
+	if uint64(len(evals)) != domain.Size {
+		return fmt.Errorf("number of evaluations %d doesn't match domain size %d", len(evals), domain.Size)
+	}
+	var fr FR
+	rMod := fr.Modulus()
+	omega := new(big.Int).ModInverse(domain.GeneratorInv, rMod)
+	if omega == nil {
+		return fmt.Errorf("domain generator inverse is not invertible")
+	}
+
+	// zⁿ with a square-and-multiply ladder over the constant exponent
+	zn := v.scalarApi.One()
+	sq := &point
+	for n := domain.Size; n > 0; n >>= 1 {
+		if n&1 == 1 {
+			zn = v.scalarApi.Mul(zn, sq)
+		}
+		sq = v.scalarApi.Mul(sq, sq)
+	}
+	znMinusOne := v.scalarApi.Sub(zn, v.scalarApi.One())
+	// reject in-domain points: zⁿ-1 = ∏ᵢ (z-ωⁱ) must be nonzero, which also
+	// makes the divisions below safe
+	v.api.AssertIsEqual(v.scalarApi.IsZero(znMinusOne), 0)
+
+	// Σᵢ evals[i]·ωⁱ/(z-ωⁱ)
+	omegaPow := big.NewInt(1)
+	terms := make([]*emulated.Element[FR], len(evals))
+	for i := range evals {
+		w := v.scalarApi.NewElement(new(big.Int).Set(omegaPow))
+		num := v.scalarApi.Mul(&evals[i], w)
+		den := v.scalarApi.Sub(&point, w)
+		terms[i] = v.scalarApi.Div(num, den)
+		omegaPow.Mul(omegaPow, omega).Mod(omegaPow, rMod)
+	}
+	sum := v.scalarApi.Sum(terms...)
+
+	// (zⁿ-1)/n
+	factor := v.scalarApi.Mul(znMinusOne, v.scalarApi.NewElement(domain.CardinalityInv))
+	derived := v.scalarApi.Mul(factor, sum)
+	v.scalarApi.AssertIsEqual(derived, &claimedValue)
+	return nil
+}
@@ -0,0 +1,88 @@
+package kzg
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	fr_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr/fft"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/algebra"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_bn254"
+	"github.com/consensys/gnark/std/math/emulated"
+	"github.com/consensys/gnark/test"
+)
+
+const barycentricDomainSize = 8
+
+type BarycentricOpeningCircuit[FR emulated.FieldParams, G1El algebra.G1ElementT, G2El algebra.G2ElementT, GTEl algebra.GtElementT] struct {
+	Evaluations  [barycentricDomainSize]emulated.Element[FR]
+	Point        emulated.Element[FR]
+	ClaimedValue emulated.Element[FR]
+
+	domain *Domain
+}
+
+func (c *BarycentricOpeningCircuit[FR, G1El, G2El, GTEl]) Define(api frontend.API) error {
+	verifier, err := NewVerifier[FR, G1El, G2El, GTEl](api)
+	if err != nil {
+		return fmt.Errorf("new verifier: %w", err)
+	}
+	return verifier.AssertBarycentricOpening(c.Evaluations[:], c.Point, c.ClaimedValue, c.domain)
+}
+
+func TestAssertBarycentricOpening(t *testing.T) {
+	assert := test.NewAssert(t)
+
+	domain := fft.NewDomain(barycentricDomainSize)
+	wDomain, err := NewDomain(barycentricDomainSize, domain.GeneratorInv.BigInt(new(big.Int)), domain.CardinalityInv.BigInt(new(big.Int)))
+	assert.NoError(err)
+
+	// native reference: interpolate the evaluations and evaluate at the point
+	evals := make([]fr_bn254.Element, barycentricDomainSize)
+	for i := range evals {
+		evals[i].SetRandom()
+	}
+	coeffs := make([]fr_bn254.Element, barycentricDomainSize)
+	copy(coeffs, evals)
+	domain.FFTInverse(coeffs, fft.DIF)
+	fft.BitReverse(coeffs)
+	var point fr_bn254.Element
+	point.SetRandom()
+	value := evalPolynomial(coeffs, point)
+
+	assignment := BarycentricOpeningCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{
+		Point:        emulated.ValueOf[sw_bn254.ScalarField](point),
+		ClaimedValue: emulated.ValueOf[sw_bn254.ScalarField](value),
+	}
+	for i := range evals {
+		assignment.Evaluations[i] = emulated.ValueOf[sw_bn254.ScalarField](evals[i])
+	}
+
+	circuit := BarycentricOpeningCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{
+		domain: wDomain,
+	}
+
+	assert.Run(func(assert *test.Assert) {
+		assert.NoError(test.IsSolved(&circuit, &assignment, ecc.BN254.ScalarField()))
+	}, "valid")
+
+	assert.Run(func(assert *test.Assert) {
+		// a claimed value differing from the interpolation is rejected
+		badAssignment := assignment
+		var other fr_bn254.Element
+		other.SetRandom()
+		badAssignment.ClaimedValue = emulated.ValueOf[sw_bn254.ScalarField](other)
+		assert.Error(test.IsSolved(&circuit, &badAssignment, ecc.BN254.ScalarField()))
+	}, "wrong value")
+
+	assert.Run(func(assert *test.Assert) {
+		// in-domain points are rejected as the formula is undefined there
+		badAssignment := assignment
+		badAssignment.Point = emulated.ValueOf[sw_bn254.ScalarField](1)
+		badAssignment.ClaimedValue = emulated.ValueOf[sw_bn254.ScalarField](evals[0])
+		assert.Error(test.IsSolved(&circuit, &badAssignment, ecc.BN254.ScalarField()))
+	}, "in-domain point")
+}
@@ -0,0 +1,38 @@
+package kzg
+
+import (
+	"fmt"
+
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/hash"
+	"github.com/consensys/gnark/std/math/bits"
+	"github.com/consensys/gnark/std/math/emulated"
+)
+
+// BatchVerifyExposingValues verifies the opening proofs at the common point
+// challenge with a single batched pairing check through
+// [Verifier.BatchAssertSinglePoint] and returns the per-proof claimed values
+// repacked as native variables. The caller gets the efficiency of batching
+// while still being able to expose or constrain each value individually, for
+// example as public outputs of a settlement circuit.
+//
+// The values are decomposed canonically before repacking, so each returned
+// variable is the unique native representation of the corresponding claimed
+// value. This requires the emulated scalar field to fit the native one; in
+// practice the gadget is used with the native parametrizations or with BN254
+// inside a BN254 circuit.
+func (v *Verifier[FR, G1El, G2El, GTEl]) BatchVerifyExposingValues(commitments []Commitment[G1El], proofs []OpeningProof[FR, G1El], challenge emulated.Element[FR], vk VerifyingKey[G1El, G2El], hasher hash.FieldHasher) ([]frontend.Variable, error) {
+	var fr FR
+	if fr.Modulus().Cmp(v.api.Compiler().Field()) > 0 {
+		return nil, fmt.Errorf("emulated scalar field doesn't fit the native field")
+	}
+	if err := v.BatchAssertSinglePoint(commitments, proofs, challenge, vk, hasher); err != nil {
+		return nil, fmt.Errorf("batch verify: %w", err)
+	}
+	values := make([]frontend.Variable, len(proofs))
+	for i := range proofs {
+		bts := v.scalarApi.ToBitsCanonical(&proofs[i].ClaimedValue)
+		values[i] = bits.FromBinary(v.api, bts)
+	}
+	return values, nil
+}
@@ -0,0 +1,95 @@
+package kzg
+
+import (
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	fr_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	kzg_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/kzg"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/algebra"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_bn254"
+	"github.com/consensys/gnark/std/hash/mimc"
+	"github.com/consensys/gnark/std/math/emulated"
+	"github.com/consensys/gnark/test"
+)
+
+const testNbExposed = 3
+
+type BatchExposeValuesCircuit[FR emulated.FieldParams, G1El algebra.G1ElementT, G2El algebra.G2ElementT, GTEl algebra.GtElementT] struct {
+	VerifyingKey[G1El, G2El]
+	Commitments [testNbExposed]Commitment[G1El]
+	Proofs      [testNbExposed]OpeningProof[FR, G1El]
+	Point       emulated.Element[FR]
+	Values      [testNbExposed]frontend.Variable `gnark:",public"`
+}
+
+func (c *BatchExposeValuesCircuit[FR, G1El, G2El, GTEl]) Define(api frontend.API) error {
+	verifier, err := NewVerifier[FR, G1El, G2El, GTEl](api)
+	if err != nil {
+		return fmt.Errorf("new verifier: %w", err)
+	}
+	hasher, err := mimc.NewMiMC(api)
+	if err != nil {
+		return fmt.Errorf("new mimc: %w", err)
+	}
+	values, err := verifier.BatchVerifyExposingValues(c.Commitments[:], c.Proofs[:], c.Point, c.VerifyingKey, &hasher)
+	if err != nil {
+		return fmt.Errorf("batch verify exposing values: %w", err)
+	}
+	for i := range values {
+		api.AssertIsEqual(values[i], c.Values[i])
+	}
+	return nil
+}
+
+func TestBatchVerifyExposingValues(t *testing.T) {
+	assert := test.NewAssert(t)
+
+	alpha, err := rand.Int(rand.Reader, ecc.BN254.ScalarField())
+	assert.NoError(err)
+	srs, err := kzg_bn254.NewSRS(kzgSize, alpha)
+	assert.NoError(err)
+
+	var point fr_bn254.Element
+	point.SetRandom()
+
+	circuit := BatchExposeValuesCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{}
+	assignment := BatchExposeValuesCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{}
+	assignment.VerifyingKey, err = ValueOfVerifyingKey[sw_bn254.G1Affine, sw_bn254.G2Affine](srs.Vk)
+	assert.NoError(err)
+	assignment.Point, err = ValueOfScalar[sw_bn254.ScalarField](point)
+	assert.NoError(err)
+
+	for i := 0; i < testNbExposed; i++ {
+		f := make([]fr_bn254.Element, polynomialSize)
+		for j := range f {
+			f[j].SetRandom()
+		}
+		com, err := kzg_bn254.Commit(f, srs.Pk)
+		assert.NoError(err)
+		proof, err := kzg_bn254.Open(f, point, srs.Pk)
+		assert.NoError(err)
+		assignment.Commitments[i], err = ValueOfCommitment[sw_bn254.G1Affine](com)
+		assert.NoError(err)
+		assignment.Proofs[i], err = ValueOfOpeningProof[sw_bn254.ScalarField, sw_bn254.G1Affine](proof)
+		assert.NoError(err)
+		assignment.Values[i] = proof.ClaimedValue.BigInt(new(big.Int))
+	}
+
+	assert.Run(func(assert *test.Assert) {
+		assert.NoError(test.IsSolved(&circuit, &assignment, ecc.BN254.ScalarField()))
+	}, "valid")
+
+	assert.Run(func(assert *test.Assert) {
+		// an exposed value inconsistent with the verified proof is rejected
+		badAssignment := assignment
+		var otherValue fr_bn254.Element
+		otherValue.SetRandom()
+		badAssignment.Values[1] = otherValue.BigInt(new(big.Int))
+		assert.Error(test.IsSolved(&circuit, &badAssignment, ecc.BN254.ScalarField()))
+	}, "mismatched value")
+}
@@ -0,0 +1,38 @@
+package kzg
+
+import (
+	"fmt"
+
+	"github.com/consensys/gnark/std/math/emulated"
+)
+
+// OpeningProofAtPoint bundles an opening proof with the point it opens at,
+// for batches where every proof carries its own evaluation point.
+type OpeningProofAtPoint[FR emulated.FieldParams, G1El any] struct {
+	OpeningProof[FR, G1El]
+	Point emulated.Element[FR]
+}
+
+// BatchAssertMultiPoint verifies opening proofs of several commitments, each
+// at its own point, with a single pairing check. The per-proof folding
+// scalars are derived from an in-circuit transcript over all commitments,
+// quotients, claimed values and points (see
+// [Verifier.FoldProofsMultiPoint]), and the folded relation is algebraically
+// identical to verifying each proof independently. Proofs sharing the same
+// point keep their own folding scalar and fold like any other pair, they are
+// never merged.
+func (v *Verifier[FR, G1El, G2El, GTEl]) BatchAssertMultiPoint(commitments []Commitment[G1El], proofs []OpeningProofAtPoint[FR, G1El], vk VerifyingKey[G1El, G2El]) error {
+	if len(commitments) != len(proofs) {
+		return fmt.Errorf("number of commitments doesn't match number of proofs")
+	}
+	if len(commitments) == 0 {
+		return fmt.Errorf("number of commitments should be nonzero")
+	}
+	openings := make([]OpeningProof[FR, G1El], len(proofs))
+	points := make([]emulated.Element[FR], len(proofs))
+	for i := range proofs {
+		openings[i] = proofs[i].OpeningProof
+		points[i] = proofs[i].Point
+	}
+	return v.BatchVerifyMultiPoints(commitments, openings, points, vk)
+}
@@ -0,0 +1,83 @@
+package kzg
+
+import (
+	"crypto/rand"
+	"fmt"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	fr_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	kzg_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/kzg"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/algebra"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_bn254"
+	"github.com/consensys/gnark/std/math/emulated"
+	"github.com/consensys/gnark/test"
+)
+
+const testNbMultiPoint = 3
+
+type BatchMultiPointCircuit[FR emulated.FieldParams, G1El algebra.G1ElementT, G2El algebra.G2ElementT, GTEl algebra.GtElementT] struct {
+	VerifyingKey[G1El, G2El]
+	Commitments [testNbMultiPoint]Commitment[G1El]
+	Proofs      [testNbMultiPoint]OpeningProofAtPoint[FR, G1El]
+}
+
+func (c *BatchMultiPointCircuit[FR, G1El, G2El, GTEl]) Define(api frontend.API) error {
+	verifier, err := NewVerifier[FR, G1El, G2El, GTEl](api)
+	if err != nil {
+		return fmt.Errorf("new verifier: %w", err)
+	}
+	return verifier.BatchAssertMultiPoint(c.Commitments[:], c.Proofs[:], c.VerifyingKey)
+}
+
+func TestBatchAssertMultiPoint(t *testing.T) {
+	assert := test.NewAssert(t)
+
+	alpha, err := rand.Int(rand.Reader, ecc.BN254.ScalarField())
+	assert.NoError(err)
+	srs, err := kzg_bn254.NewSRS(kzgSize, alpha)
+	assert.NoError(err)
+
+	// the last two proofs share a point (shifted-opening style)
+	var points [testNbMultiPoint]fr_bn254.Element
+	points[0].SetRandom()
+	points[1].SetRandom()
+	points[2] = points[1]
+
+	digests := make([]kzg_bn254.Digest, testNbMultiPoint)
+	proofs := make([]kzg_bn254.OpeningProof, testNbMultiPoint)
+	circuit := BatchMultiPointCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{}
+	assignment := BatchMultiPointCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{}
+	assignment.VerifyingKey, err = ValueOfVerifyingKey[sw_bn254.G1Affine, sw_bn254.G2Affine](srs.Vk)
+	assert.NoError(err)
+
+	for i := 0; i < testNbMultiPoint; i++ {
+		f := make([]fr_bn254.Element, polynomialSize)
+		for j := range f {
+			f[j].SetRandom()
+		}
+		digests[i], err = kzg_bn254.Commit(f, srs.Pk)
+		assert.NoError(err)
+		proofs[i], err = kzg_bn254.Open(f, points[i], srs.Pk)
+		assert.NoError(err)
+		assignment.Commitments[i], err = ValueOfCommitment[sw_bn254.G1Affine](digests[i])
+		assert.NoError(err)
+		assignment.Proofs[i].OpeningProof, err = ValueOfOpeningProof[sw_bn254.ScalarField, sw_bn254.G1Affine](proofs[i])
+		assert.NoError(err)
+		assignment.Proofs[i].Point, err = ValueOfScalar[sw_bn254.ScalarField](points[i])
+		assert.NoError(err)
+	}
+
+	// the fixture passes gnark-crypto's native batch verifier
+	assert.NoError(kzg_bn254.BatchVerifyMultiPoints(digests, proofs, points[:], srs.Vk))
+
+	assert.NoError(test.IsSolved(&circuit, &assignment, ecc.BN254.ScalarField()))
+
+	// a tampered claimed value breaks the folded pairing check
+	badAssignment := assignment
+	var otherValue fr_bn254.Element
+	otherValue.SetRandom()
+	badAssignment.Proofs[2].ClaimedValue = emulated.ValueOf[sw_bn254.ScalarField](otherValue)
+	assert.Error(test.IsSolved(&circuit, &badAssignment, ecc.BN254.ScalarField()))
+}
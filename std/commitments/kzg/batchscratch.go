@@ -0,0 +1,35 @@
+package kzg
+
+import (
+	"github.com/consensys/gnark/std/math/emulated"
+)
+
+// foldScratch holds buffers reused by the batch folding methods across calls.
+// Folding a batch allocates several transient slices per proof; for large or
+// repeated batches this inflates peak memory during circuit definition, so the
+// verifier keeps the buffers and regrows them only when a larger batch comes
+// in. The two slots of each kind exist because a fold uses at most two
+// same-typed buffers simultaneously. The buffers are only valid within a
+// single fold: every borrow overwrites the previous content. Only slices of
+// pointers are reusable — the deferred emulated-field checks keep references
+// to the elements themselves, so element storage must stay unshared.
+type foldScratch[FR emulated.FieldParams, G1El any] struct {
+	scalars [2][]*emulated.Element[FR]
+	points  [2][]*G1El
+}
+
+func (s *foldScratch[FR, G1El]) borrowScalars(slot, n int) []*emulated.Element[FR] {
+	if cap(s.scalars[slot]) < n {
+		s.scalars[slot] = make([]*emulated.Element[FR], n)
+	}
+	s.scalars[slot] = s.scalars[slot][:n]
+	return s.scalars[slot]
+}
+
+func (s *foldScratch[FR, G1El]) borrowPoints(slot, n int) []*G1El {
+	if cap(s.points[slot]) < n {
+		s.points[slot] = make([]*G1El, n)
+	}
+	s.points[slot] = s.points[slot][:n]
+	return s.points[slot]
+}
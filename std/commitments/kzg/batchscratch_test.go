@@ -0,0 +1,98 @@
+package kzg
+
+import (
+	"crypto/rand"
+	"fmt"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	fr_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	kzg_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/kzg"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_bn254"
+	"github.com/consensys/gnark/std/math/emulated"
+	"github.com/consensys/gnark/test"
+)
+
+type batchDefinitionCircuit struct {
+	Vk      VerifyingKey[sw_bn254.G1Affine, sw_bn254.G2Affine]
+	Digests []Commitment[sw_bn254.G1Affine]
+	Proofs  []OpeningProof[sw_bn254.ScalarField, sw_bn254.G1Affine]
+	Points  []emulated.Element[sw_bn254.ScalarField]
+}
+
+func (c *batchDefinitionCircuit) Define(api frontend.API) error {
+	verifier, err := NewVerifier[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl](api)
+	if err != nil {
+		return fmt.Errorf("new verifier: %w", err)
+	}
+	return verifier.BatchVerifyMultiPoints(c.Digests, c.Proofs, c.Points, c.Vk)
+}
+
+// BenchmarkBatchDefinition measures definition time and allocation churn of
+// the multi-point batch verifier, which reuses the verifier's fold scratch
+// buffers. The per-proof cost is linear, so the figures extrapolate to large
+// (e.g. 1000-proof) batches.
+func BenchmarkBatchDefinition(b *testing.B) {
+	for _, nbProofs := range []int{4, 16} {
+		alpha, err := rand.Int(rand.Reader, ecc.BN254.ScalarField())
+		if err != nil {
+			b.Fatal(err)
+		}
+		srs, err := kzg_bn254.NewSRS(kzgSize, alpha)
+		if err != nil {
+			b.Fatal(err)
+		}
+		digests := make([]Commitment[sw_bn254.G1Affine], nbProofs)
+		proofs := make([]OpeningProof[sw_bn254.ScalarField, sw_bn254.G1Affine], nbProofs)
+		points := make([]emulated.Element[sw_bn254.ScalarField], nbProofs)
+		for i := 0; i < nbProofs; i++ {
+			f := make([]fr_bn254.Element, polynomialSize)
+			for j := range f {
+				f[j].SetRandom()
+			}
+			com, err := kzg_bn254.Commit(f, srs.Pk)
+			if err != nil {
+				b.Fatal(err)
+			}
+			var point fr_bn254.Element
+			point.SetRandom()
+			proof, err := kzg_bn254.Open(f, point, srs.Pk)
+			if err != nil {
+				b.Fatal(err)
+			}
+			if digests[i], err = ValueOfCommitment[sw_bn254.G1Affine](com); err != nil {
+				b.Fatal(err)
+			}
+			if proofs[i], err = ValueOfOpeningProof[sw_bn254.ScalarField, sw_bn254.G1Affine](proof); err != nil {
+				b.Fatal(err)
+			}
+			if points[i], err = ValueOfScalar[sw_bn254.ScalarField](point); err != nil {
+				b.Fatal(err)
+			}
+		}
+		wVk, err := ValueOfVerifyingKey[sw_bn254.G1Affine, sw_bn254.G2Affine](srs.Vk)
+		if err != nil {
+			b.Fatal(err)
+		}
+		circuit := batchDefinitionCircuit{
+			Digests: make([]Commitment[sw_bn254.G1Affine], nbProofs),
+			Proofs:  make([]OpeningProof[sw_bn254.ScalarField, sw_bn254.G1Affine], nbProofs),
+			Points:  make([]emulated.Element[sw_bn254.ScalarField], nbProofs),
+		}
+		assignment := batchDefinitionCircuit{
+			Vk:      wVk,
+			Digests: digests,
+			Proofs:  proofs,
+			Points:  points,
+		}
+		b.Run(fmt.Sprintf("%d-proofs", nbProofs), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				if err := test.IsSolved(&circuit, &assignment, ecc.BN254.ScalarField()); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,91 @@
+package kzg
+
+import (
+	"fmt"
+
+	"github.com/consensys/gnark/std/hash"
+	"github.com/consensys/gnark/std/math/bits"
+	"github.com/consensys/gnark/std/math/emulated"
+)
+
+// BatchAssertSinglePoint verifies opening proofs of several commitments at a
+// common point with a single pairing check. The commitments, quotients and
+// claimed values are folded with powers of a challenge γ squeezed from the
+// caller-provided hasher after absorbing the point and every commitment,
+// quotient and claimed value, so the native prover reproduces γ by hashing
+// the same serializations in the same order. Since all proofs share the
+// point, the folded quotient opens the folded commitment to the folded value
+// and the batch collapses into one [Verifier.CheckOpeningProof]. As γ is
+// squeezed from a native hasher, the scalar field must be at least as large
+// as the native field; the method errors on the native 2-chain
+// parametrizations.
+func (v *Verifier[FR, G1El, G2El, GTEl]) BatchAssertSinglePoint(commitments []Commitment[G1El], proofs []OpeningProof[FR, G1El], point emulated.Element[FR], vk VerifyingKey[G1El, G2El], hasher hash.FieldHasher) error {
+	var fr FR
+	if len(commitments) != len(proofs) {
+		return fmt.Errorf("number of commitments doesn't match number of proofs")
+	}
+	if len(commitments) == 0 {
+		return fmt.Errorf("number of commitments should be nonzero")
+	}
+	if len(commitments) == 1 {
+		return v.CheckOpeningProof(commitments[0], proofs[0], point, vk)
+	}
+
+	nbBits := v.api.Compiler().FieldBitLen()
+	if fr.Modulus().BitLen() < nbBits {
+		// the native hash output may not fit the scalar field digit count, in
+		// which case the decomposition below is unsatisfiable for honest
+		// witnesses; this rules out the native 2-chain parametrizations
+		return fmt.Errorf("emulated scalar field smaller than native field")
+	}
+
+	// derive the folding challenge γ from the transcript
+	hasher.Reset()
+	hasher.Write(v.curve.MarshalScalar(point)...)
+	for i := range commitments {
+		hasher.Write(v.curve.MarshalG1(commitments[i].G1El)...)
+		hasher.Write(v.curve.MarshalG1(proofs[i].Quotient)...)
+		hasher.Write(v.curve.MarshalScalar(proofs[i].ClaimedValue)...)
+	}
+	seed := hasher.Sum()
+	binSeed := bits.ToBinary(v.api, seed, bits.WithNbDigits(nbBits))
+	gamma := v.scalarApi.FromBits(binSeed...)
+
+	// gammai = [1,γ,γ²,..,γⁿ⁻¹]
+	gammai := make([]*emulated.Element[FR], len(commitments))
+	gammai[0] = v.scalarApi.One()
+	gammai[1] = gamma
+	for i := 2; i < len(gammai); i++ {
+		gammai[i] = v.scalarApi.Mul(gammai[i-1], gamma)
+	}
+
+	// fold the commitments and the claimed values
+	evals := make([]emulated.Element[FR], len(proofs))
+	for i := range proofs {
+		evals[i] = proofs[i].ClaimedValue
+	}
+	foldedCommitment, foldedEval, err := v.fold(commitments, evals, gammai)
+	if err != nil {
+		return fmt.Errorf("fold: %w", err)
+	}
+
+	// fold the quotients ∑ᵢ γⁱ·[Hᵢ(α)]G₁
+	quotients := make([]*G1El, len(proofs))
+	for i := range proofs {
+		quotients[i] = &proofs[i].Quotient
+	}
+	foldedQuotient, err := v.curve.MultiScalarMul(quotients[1:], gammai[1:])
+	if err != nil {
+		return fmt.Errorf("fold quotients: %w", err)
+	}
+	foldedQuotient = v.curve.Add(foldedQuotient, quotients[0])
+
+	foldedProof := OpeningProof[FR, G1El]{
+		Quotient:     *foldedQuotient,
+		ClaimedValue: *foldedEval,
+	}
+	if err := v.CheckOpeningProof(foldedCommitment, foldedProof, point, vk); err != nil {
+		return fmt.Errorf("check opening proof: %w", err)
+	}
+	return nil
+}
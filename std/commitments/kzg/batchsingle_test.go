@@ -0,0 +1,99 @@
+package kzg
+
+import (
+	"crypto/rand"
+	"fmt"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	fr_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	kzg_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/kzg"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/algebra"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_bn254"
+	"github.com/consensys/gnark/std/hash/mimc"
+	"github.com/consensys/gnark/std/math/emulated"
+	"github.com/consensys/gnark/test"
+)
+
+const testNbSinglePoint = 3
+
+type BatchSinglePointCircuit[FR emulated.FieldParams, G1El algebra.G1ElementT, G2El algebra.G2ElementT, GTEl algebra.GtElementT] struct {
+	VerifyingKey[G1El, G2El]
+	Commitments [testNbSinglePoint]Commitment[G1El]
+	Proofs      [testNbSinglePoint]OpeningProof[FR, G1El]
+	Point       emulated.Element[FR]
+
+	emptyBatch bool
+}
+
+func (c *BatchSinglePointCircuit[FR, G1El, G2El, GTEl]) Define(api frontend.API) error {
+	verifier, err := NewVerifier[FR, G1El, G2El, GTEl](api)
+	if err != nil {
+		return fmt.Errorf("new verifier: %w", err)
+	}
+	hasher, err := mimc.NewMiMC(api)
+	if err != nil {
+		return fmt.Errorf("new mimc: %w", err)
+	}
+	nb := testNbSinglePoint
+	if c.emptyBatch {
+		nb = 0
+	}
+	return verifier.BatchAssertSinglePoint(c.Commitments[:nb], c.Proofs[:nb], c.Point, c.VerifyingKey, &hasher)
+}
+
+func TestBatchAssertSinglePoint(t *testing.T) {
+	assert := test.NewAssert(t)
+
+	alpha, err := rand.Int(rand.Reader, ecc.BN254.ScalarField())
+	assert.NoError(err)
+	srs, err := kzg_bn254.NewSRS(kzgSize, alpha)
+	assert.NoError(err)
+
+	var point fr_bn254.Element
+	point.SetRandom()
+
+	circuit := BatchSinglePointCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{}
+	assignment := BatchSinglePointCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{}
+	assignment.VerifyingKey, err = ValueOfVerifyingKey[sw_bn254.G1Affine, sw_bn254.G2Affine](srs.Vk)
+	assert.NoError(err)
+	assignment.Point, err = ValueOfScalar[sw_bn254.ScalarField](point)
+	assert.NoError(err)
+
+	for i := 0; i < testNbSinglePoint; i++ {
+		f := make([]fr_bn254.Element, polynomialSize)
+		for j := range f {
+			f[j].SetRandom()
+		}
+		com, err := kzg_bn254.Commit(f, srs.Pk)
+		assert.NoError(err)
+		proof, err := kzg_bn254.Open(f, point, srs.Pk)
+		assert.NoError(err)
+		assignment.Commitments[i], err = ValueOfCommitment[sw_bn254.G1Affine](com)
+		assert.NoError(err)
+		assignment.Proofs[i], err = ValueOfOpeningProof[sw_bn254.ScalarField, sw_bn254.G1Affine](proof)
+		assert.NoError(err)
+	}
+
+	assert.Run(func(assert *test.Assert) {
+		assert.NoError(test.IsSolved(&circuit, &assignment, ecc.BN254.ScalarField()))
+	}, "valid")
+
+	assert.Run(func(assert *test.Assert) {
+		// a wrong claimed value breaks the folded pairing check
+		badAssignment := assignment
+		var otherValue fr_bn254.Element
+		otherValue.SetRandom()
+		badAssignment.Proofs[1].ClaimedValue = emulated.ValueOf[sw_bn254.ScalarField](otherValue)
+		assert.Error(test.IsSolved(&circuit, &badAssignment, ecc.BN254.ScalarField()))
+	}, "tampered")
+
+	assert.Run(func(assert *test.Assert) {
+		// an empty batch is rejected at definition time
+		emptyCircuit := BatchSinglePointCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{emptyBatch: true}
+		emptyAssignment := assignment
+		emptyAssignment.emptyBatch = true
+		assert.Error(test.IsSolved(&emptyCircuit, &emptyAssignment, ecc.BN254.ScalarField()))
+	}, "empty")
+}
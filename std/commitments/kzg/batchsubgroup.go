@@ -0,0 +1,30 @@
+package kzg
+
+import (
+	"fmt"
+
+	"github.com/consensys/gnark/std/algebra"
+	"github.com/consensys/gnark/std/math/emulated"
+)
+
+// BatchVerifyMultiPointsWithSubgroupCheck verifies the batch like
+// [Verifier.BatchVerifyMultiPoints], additionally asserting subgroup
+// membership of the commitments and quotients (see
+// [algebra.AssertBatchSubgroup]). Use it when the proofs come from an
+// untrusted serialization layer that does not guarantee subgroup membership.
+func (v *Verifier[FR, G1El, G2El, GTEl]) BatchVerifyMultiPointsWithSubgroupCheck(digests []Commitment[G1El], proofs []OpeningProof[FR, G1El], points []emulated.Element[FR], vk VerifyingKey[G1El, G2El]) error {
+	if len(digests) != len(proofs) {
+		return fmt.Errorf("number of commitments doesn't match number of proofs")
+	}
+	batch := make([]*G1El, 0, 2*len(digests))
+	for i := range digests {
+		batch = append(batch, &digests[i].G1El)
+	}
+	for i := range proofs {
+		batch = append(batch, &proofs[i].Quotient)
+	}
+	if err := algebra.AssertBatchSubgroup(v.pairing, batch); err != nil {
+		return fmt.Errorf("batch subgroup check: %w", err)
+	}
+	return v.BatchVerifyMultiPoints(digests, proofs, points, vk)
+}
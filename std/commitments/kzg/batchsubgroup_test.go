@@ -0,0 +1,49 @@
+package kzg
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/algebra"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_bn254"
+	"github.com/consensys/gnark/std/math/emulated"
+	"github.com/consensys/gnark/test"
+)
+
+type BatchSubgroupCircuit[FR emulated.FieldParams, G1El algebra.G1ElementT, G2El algebra.G2ElementT, GTEl algebra.GtElementT] struct {
+	Batch MultiSRSBatch[FR, G1El, G2El]
+}
+
+func (c *BatchSubgroupCircuit[FR, G1El, G2El, GTEl]) Define(api frontend.API) error {
+	verifier, err := NewVerifier[FR, G1El, G2El, GTEl](api)
+	if err != nil {
+		return fmt.Errorf("new verifier: %w", err)
+	}
+	return verifier.BatchVerifyMultiPointsWithSubgroupCheck(c.Batch.Digests, c.Batch.Proofs, c.Batch.Points, c.Batch.VK)
+}
+
+func TestBatchVerifyMultiPointsWithSubgroupCheck(t *testing.T) {
+	assert := test.NewAssert(t)
+
+	batch := openingsUnderSRS(t, 2)
+
+	circuit := BatchSubgroupCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{
+		Batch: MultiSRSBatch[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine]{
+			Digests: make([]Commitment[sw_bn254.G1Affine], len(batch.Digests)),
+			Proofs:  make([]OpeningProof[sw_bn254.ScalarField, sw_bn254.G1Affine], len(batch.Proofs)),
+			Points:  make([]emulated.Element[sw_bn254.ScalarField], len(batch.Points)),
+		},
+	}
+	assignment := BatchSubgroupCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{
+		Batch: batch,
+	}
+	assert.NoError(test.IsSolved(&circuit, &assignment, ecc.BN254.ScalarField()))
+
+	// a tampered quotient fails the folded pairing check
+	badAssignment := assignment
+	badAssignment.Batch.Proofs = append([]OpeningProof[sw_bn254.ScalarField, sw_bn254.G1Affine]{}, batch.Proofs...)
+	badAssignment.Batch.Proofs[0].Quotient = badAssignment.Batch.Proofs[1].Quotient
+	assert.Error(test.IsSolved(&circuit, &badAssignment, ecc.BN254.ScalarField()))
+}
@@ -0,0 +1,27 @@
+package kzg
+
+import (
+	"github.com/consensys/gnark/std/algebra"
+	"github.com/consensys/gnark/std/math/emulated"
+)
+
+// WithCommitmentCofactorClear makes the verifier assert prime-order subgroup
+// membership of the commitment and the quotient (via
+// [algebra.Pairing.AssertIsOnG1]) before using them in an opening check. On
+// curves whose G1 has a nontrivial cofactor — BLS12-381, BLS12-377,
+// BLS24-315 and BW6-761 among the supported ones — a maliciously supplied
+// point can lie on the curve but outside the prime-order subgroup, and
+// pairing-based checks are only sound on subgroup elements. BN254 G1 has
+// cofactor one, so there the option only adds an on-curve check.
+//
+// The option is off by default because witness points deserialized through
+// gnark-crypto are already subgroup-checked; enable it when the points come
+// from an untrusted serialization layer. See also
+// [Verifier.BatchVerifyMultiPointsWithSubgroupCheck] which amortizes the
+// membership checks over a batch.
+func WithCommitmentCofactorClear[FR emulated.FieldParams, G1El algebra.G1ElementT]() VerifierOption[FR, G1El] {
+	return func(cfg *verifierConfig[FR, G1El]) error {
+		cfg.cofactorClear = true
+		return nil
+	}
+}
@@ -0,0 +1,101 @@
+package kzg
+
+import (
+	"crypto/rand"
+	"fmt"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381"
+	fp_bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381/fp"
+	fr_bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+	kzg_bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381/kzg"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/algebra"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_bls12381"
+	"github.com/consensys/gnark/std/math/emulated"
+	"github.com/consensys/gnark/test"
+)
+
+type CofactorClearCircuit[FR emulated.FieldParams, G1El algebra.G1ElementT, G2El algebra.G2ElementT, GTEl algebra.GtElementT] struct {
+	VerifyingKey[G1El, G2El]
+	Commitment Commitment[G1El]
+	Proof      OpeningProof[FR, G1El]
+	Point      emulated.Element[FR]
+}
+
+func (c *CofactorClearCircuit[FR, G1El, G2El, GTEl]) Define(api frontend.API) error {
+	verifier, err := NewVerifier[FR, G1El, G2El, GTEl](api, WithCommitmentCofactorClear[FR, G1El]())
+	if err != nil {
+		return fmt.Errorf("new verifier: %w", err)
+	}
+	return verifier.CheckOpeningProof(c.Commitment, c.Proof, c.Point, c.VerifyingKey)
+}
+
+// nonSubgroupPointBLS12381 returns a point on the BLS12-381 G1 curve outside
+// the prime-order subgroup; such points exist because G1 has a nontrivial
+// cofactor.
+func nonSubgroupPointBLS12381(t *testing.T) bls12381.G1Affine {
+	var x, rhs fp_bls12381.Element
+	var p bls12381.G1Affine
+	for i := uint64(1); ; i++ {
+		x.SetUint64(i)
+		rhs.Square(&x).Mul(&rhs, &x)
+		var four fp_bls12381.Element
+		four.SetUint64(4)
+		rhs.Add(&rhs, &four)
+		if rhs.Legendre() != 1 {
+			continue
+		}
+		p.X = x
+		p.Y.Sqrt(&rhs)
+		if p.IsOnCurve() && !p.IsInSubGroup() {
+			return p
+		}
+	}
+}
+
+func TestVerifierWithCommitmentCofactorClear(t *testing.T) {
+	assert := test.NewAssert(t)
+
+	alpha, err := rand.Int(rand.Reader, ecc.BLS12_381.ScalarField())
+	assert.NoError(err)
+	srs, err := kzg_bls12381.NewSRS(kzgSize, alpha)
+	assert.NoError(err)
+
+	f := make([]fr_bls12381.Element, polynomialSize)
+	for i := range f {
+		f[i].SetRandom()
+	}
+	com, err := kzg_bls12381.Commit(f, srs.Pk)
+	assert.NoError(err)
+	var point fr_bls12381.Element
+	point.SetRandom()
+	proof, err := kzg_bls12381.Open(f, point, srs.Pk)
+	assert.NoError(err)
+
+	assignment := CofactorClearCircuit[sw_bls12381.ScalarField, sw_bls12381.G1Affine, sw_bls12381.G2Affine, sw_bls12381.GTEl]{
+		Point: emulated.ValueOf[sw_bls12381.ScalarField](point),
+	}
+	assignment.VerifyingKey, err = ValueOfVerifyingKey[sw_bls12381.G1Affine, sw_bls12381.G2Affine](srs.Vk)
+	assert.NoError(err)
+	assignment.Commitment, err = ValueOfCommitment[sw_bls12381.G1Affine](com)
+	assert.NoError(err)
+	assignment.Proof, err = ValueOfOpeningProof[sw_bls12381.ScalarField, sw_bls12381.G1Affine](proof)
+	assert.NoError(err)
+
+	circuit := CofactorClearCircuit[sw_bls12381.ScalarField, sw_bls12381.G1Affine, sw_bls12381.G2Affine, sw_bls12381.GTEl]{}
+
+	assert.Run(func(assert *test.Assert) {
+		assert.NoError(test.IsSolved(&circuit, &assignment, ecc.BN254.ScalarField()))
+	}, "valid")
+
+	assert.Run(func(assert *test.Assert) {
+		// an on-curve commitment outside the prime-order subgroup is rejected
+		// by the membership check
+		badAssignment := assignment
+		badAssignment.Commitment, err = ValueOfCommitment[sw_bls12381.G1Affine](nonSubgroupPointBLS12381(t))
+		assert.NoError(err)
+		assert.Error(test.IsSolved(&circuit, &badAssignment, ecc.BN254.ScalarField()))
+	}, "non-subgroup commitment")
+}
@@ -0,0 +1,29 @@
+package kzg
+
+import (
+	"fmt"
+
+	"github.com/consensys/gnark/std/math/emulated"
+)
+
+// AssertCommonValueOpenings asserts that the committed polynomials all
+// evaluate to the same value, each at its own point: the claimed values of
+// the proofs are constrained equal to each other and the openings are then
+// batched into a single pairing check with [Verifier.BatchVerifyMultiPoints].
+// The common value stays in the witness; bind it externally (e.g. hash it
+// into a public input) if the outer protocol needs it.
+func (v *Verifier[FR, G1El, G2El, GTEl]) AssertCommonValueOpenings(commitments []Commitment[G1El], proofs []OpeningProof[FR, G1El], points []emulated.Element[FR], vk VerifyingKey[G1El, G2El]) error {
+	if len(commitments) != len(proofs) {
+		return fmt.Errorf("number of commitments doesn't match number of proofs")
+	}
+	if len(commitments) != len(points) {
+		return fmt.Errorf("number of commitments doesn't match number of points")
+	}
+	if len(commitments) == 0 {
+		return fmt.Errorf("number of commitments should be nonzero")
+	}
+	for i := 1; i < len(proofs); i++ {
+		v.scalarApi.AssertIsEqual(&proofs[0].ClaimedValue, &proofs[i].ClaimedValue)
+	}
+	return v.BatchVerifyMultiPoints(commitments, proofs, points, vk)
+}
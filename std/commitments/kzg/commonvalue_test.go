@@ -0,0 +1,86 @@
+package kzg
+
+import (
+	"crypto/rand"
+	"fmt"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	fr_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	kzg_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/kzg"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/algebra"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_bn254"
+	"github.com/consensys/gnark/std/math/emulated"
+	"github.com/consensys/gnark/test"
+)
+
+const testNbCommonValue = 3
+
+type CommonValueCircuit[FR emulated.FieldParams, G1El algebra.G1ElementT, G2El algebra.G2ElementT, GTEl algebra.GtElementT] struct {
+	VerifyingKey[G1El, G2El]
+	Commitments [testNbCommonValue]Commitment[G1El]
+	Proofs      [testNbCommonValue]OpeningProof[FR, G1El]
+	Points      [testNbCommonValue]emulated.Element[FR]
+}
+
+func (c *CommonValueCircuit[FR, G1El, G2El, GTEl]) Define(api frontend.API) error {
+	verifier, err := NewVerifier[FR, G1El, G2El, GTEl](api)
+	if err != nil {
+		return fmt.Errorf("new verifier: %w", err)
+	}
+	return verifier.AssertCommonValueOpenings(c.Commitments[:], c.Proofs[:], c.Points[:], c.VerifyingKey)
+}
+
+func TestAssertCommonValueOpenings(t *testing.T) {
+	assert := test.NewAssert(t)
+
+	alpha, err := rand.Int(rand.Reader, ecc.BN254.ScalarField())
+	assert.NoError(err)
+	srs, err := kzg_bn254.NewSRS(kzgSize, alpha)
+	assert.NoError(err)
+
+	var common fr_bn254.Element
+	common.SetRandom()
+
+	wVk, err := ValueOfVerifyingKey[sw_bn254.G1Affine, sw_bn254.G2Affine](srs.Vk)
+	assert.NoError(err)
+	circuit := CommonValueCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{}
+	assignment := CommonValueCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{VerifyingKey: wVk}
+
+	for i := 0; i < testNbCommonValue; i++ {
+		f := make([]fr_bn254.Element, polynomialSize)
+		for j := range f {
+			f[j].SetRandom()
+		}
+		var point fr_bn254.Element
+		point.SetRandom()
+		// shift the constant term so that f(point) = common
+		var eval fr_bn254.Element
+		for j := len(f) - 1; j >= 0; j-- {
+			eval.Mul(&eval, &point).Add(&eval, &f[j])
+		}
+		f[0].Sub(&f[0], &eval).Add(&f[0], &common)
+
+		com, err := kzg_bn254.Commit(f, srs.Pk)
+		assert.NoError(err)
+		proof, err := kzg_bn254.Open(f, point, srs.Pk)
+		assert.NoError(err)
+		assert.True(proof.ClaimedValue.Equal(&common))
+
+		assignment.Commitments[i], err = ValueOfCommitment[sw_bn254.G1Affine](com)
+		assert.NoError(err)
+		assignment.Proofs[i], err = ValueOfOpeningProof[sw_bn254.ScalarField, sw_bn254.G1Affine](proof)
+		assert.NoError(err)
+		assignment.Points[i], err = ValueOfScalar[sw_bn254.ScalarField](point)
+		assert.NoError(err)
+	}
+	assert.NoError(test.IsSolved(&circuit, &assignment, ecc.BN254.ScalarField()))
+
+	// a proof opening to a different value breaks the equality constraints
+	badAssignment := assignment
+	var other fr_bn254.Element
+	other.SetOne().Add(&other, &common)
+	badAssignment.Proofs[1].ClaimedValue = emulated.ValueOf[sw_bn254.ScalarField](other)
+	assert.Error(test.IsSolved(&circuit, &badAssignment, ecc.BN254.ScalarField()))
+}
@@ -0,0 +1,78 @@
+package kzg
+
+import (
+	"fmt"
+
+	"github.com/consensys/gnark/std/math/emulated"
+)
+
+// AssertCompressedBatch verifies openings of several commitments at a common
+// point where the individual quotient points are compressed into a single
+// committed vector. With challenge γ, commitments Cᵢ to fᵢ and claimed
+// values vᵢ = fᵢ(z), the prover commits to the combined quotient
+//
+//	W(X) = ∑ᵢ γⁱ·(fᵢ(X) - vᵢ)/(X - z)
+//
+// and sends only quotientVectorCommitment = [W(α)]G₁ together with an opening
+// of it at γ. The polynomial identity ∑ᵢ γⁱ·fᵢ(X) - ∑ᵢ γⁱ·vᵢ = W(X)·(X - z)
+// is checked as a single opening of the γ-folded commitment at z with
+// quotient [W(α)]G₁, so the per-proof data shrinks from one G1 point each to
+// one G1 point and one opening for the whole batch.
+//
+// Soundness: if any individual opening is wrong, the folded opening fails for
+// all but at most n choices of γ (Schwartz-Zippel over the challenge), so γ
+// must be sampled after the commitments and claimed values are fixed. The
+// auxiliary opening of the quotient-vector commitment at γ makes W
+// extractable as an SRS-degree-bounded polynomial, giving knowledge soundness
+// of the compressed quotients.
+func (v *Verifier[FR, G1El, G2El, GTEl]) AssertCompressedBatch(quotientVectorCommitment Commitment[G1El], quotientOpening OpeningProof[FR, G1El], commitments []Commitment[G1El], claimedValues []emulated.Element[FR], point, challenge emulated.Element[FR], vk VerifyingKey[G1El, G2El]) error {
+	if len(commitments) != len(claimedValues) {
+		return fmt.Errorf("number of commitments doesn't match number of claimed values")
+	}
+	if len(commitments) == 0 {
+		return fmt.Errorf("number of commitments should be nonzero")
+	}
+
+	// powers γⁱ of the batch challenge
+	powers := make([]*emulated.Element[FR], len(commitments))
+	powers[0] = v.scalarApi.One()
+	for i := 1; i < len(powers); i++ {
+		powers[i] = v.scalarApi.Mul(powers[i-1], &challenge)
+	}
+
+	// fold the commitments: ∑ᵢ γⁱ·Cᵢ
+	foldedCommitment := &commitments[0].G1El
+	if len(commitments) > 1 {
+		rest := make([]*G1El, len(commitments)-1)
+		for i := range rest {
+			rest[i] = &commitments[i+1].G1El
+		}
+		folded, err := v.curve.MultiScalarMul(rest, powers[1:])
+		if err != nil {
+			return fmt.Errorf("fold commitments: %w", err)
+		}
+		foldedCommitment = v.curve.Add(folded, foldedCommitment)
+	}
+
+	// fold the claimed values: ∑ᵢ γⁱ·vᵢ
+	foldedValue := &claimedValues[0]
+	for i := 1; i < len(claimedValues); i++ {
+		foldedValue = v.scalarApi.Add(foldedValue, v.scalarApi.Mul(powers[i], &claimedValues[i]))
+	}
+
+	// the combined quotient opens the folded commitment at the common point
+	combinedProof := OpeningProof[FR, G1El]{
+		Quotient:     quotientVectorCommitment.G1El,
+		ClaimedValue: *foldedValue,
+	}
+	if err := v.CheckOpeningProof(Commitment[G1El]{G1El: *foldedCommitment}, combinedProof, point, vk); err != nil {
+		return fmt.Errorf("compressed batch opening: %w", err)
+	}
+
+	// the prover opens the quotient-vector commitment at the challenge,
+	// proving knowledge of the compressed quotients
+	if err := v.CheckOpeningProof(quotientVectorCommitment, quotientOpening, challenge, vk); err != nil {
+		return fmt.Errorf("quotient vector opening: %w", err)
+	}
+	return nil
+}
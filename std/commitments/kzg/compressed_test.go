@@ -0,0 +1,138 @@
+package kzg
+
+import (
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	bn254 "github.com/consensys/gnark-crypto/ecc/bn254"
+	fr_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	kzg_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/kzg"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/algebra"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_bn254"
+	"github.com/consensys/gnark/std/math/emulated"
+	"github.com/consensys/gnark/test"
+)
+
+const testNbCompressed = 3
+
+type CompressedBatchCircuit[FR emulated.FieldParams, G1El algebra.G1ElementT, G2El algebra.G2ElementT, GTEl algebra.GtElementT] struct {
+	VerifyingKey[G1El, G2El]
+	QuotientVector  Commitment[G1El]
+	QuotientOpening OpeningProof[FR, G1El]
+	Commitments     [testNbCompressed]Commitment[G1El]
+	ClaimedValues   [testNbCompressed]emulated.Element[FR]
+	Point           emulated.Element[FR]
+	Challenge       emulated.Element[FR]
+}
+
+func (c *CompressedBatchCircuit[FR, G1El, G2El, GTEl]) Define(api frontend.API) error {
+	verifier, err := NewVerifier[FR, G1El, G2El, GTEl](api)
+	if err != nil {
+		return fmt.Errorf("new verifier: %w", err)
+	}
+	return verifier.AssertCompressedBatch(c.QuotientVector, c.QuotientOpening, c.Commitments[:], c.ClaimedValues[:], c.Point, c.Challenge, c.VerifyingKey)
+}
+
+// compressedQuotient returns the γ-weighted combination of the quotients
+// (fᵢ - fᵢ(z))/(X - z) by synthetic division.
+func compressedQuotient(polys [][]fr_bn254.Element, z, gamma fr_bn254.Element) []fr_bn254.Element {
+	w := make([]fr_bn254.Element, len(polys[0])-1)
+	var power fr_bn254.Element
+	power.SetOne()
+	for i := range polys {
+		q := make([]fr_bn254.Element, len(polys[i])-1)
+		var acc fr_bn254.Element
+		for j := len(polys[i]) - 1; j > 0; j-- {
+			acc.Mul(&acc, &z).Add(&acc, &polys[i][j])
+			q[j-1] = acc
+		}
+		var tmp fr_bn254.Element
+		for j := range q {
+			tmp.Mul(&q[j], &power)
+			w[j].Add(&w[j], &tmp)
+		}
+		power.Mul(&power, &gamma)
+	}
+	return w
+}
+
+func TestAssertCompressedBatch(t *testing.T) {
+	assert := test.NewAssert(t)
+
+	alpha, err := rand.Int(rand.Reader, ecc.BN254.ScalarField())
+	assert.NoError(err)
+	srs, err := kzg_bn254.NewSRS(kzgSize, alpha)
+	assert.NoError(err)
+
+	var point, gamma fr_bn254.Element
+	point.SetRandom()
+	gamma.SetRandom()
+
+	polys := make([][]fr_bn254.Element, testNbCompressed)
+	commitments := make([]bn254.G1Affine, testNbCompressed)
+	values := make([]fr_bn254.Element, testNbCompressed)
+	for i := range polys {
+		polys[i] = make([]fr_bn254.Element, polynomialSize)
+		for j := range polys[i] {
+			polys[i][j].SetRandom()
+		}
+		commitments[i], err = kzg_bn254.Commit(polys[i], srs.Pk)
+		assert.NoError(err)
+		for j := len(polys[i]) - 1; j >= 0; j-- {
+			values[i].Mul(&values[i], &point).Add(&values[i], &polys[i][j])
+		}
+	}
+
+	// combined quotient, its commitment and its opening at the challenge
+	w := compressedQuotient(polys, point, gamma)
+	wCommit, err := kzg_bn254.Commit(w, srs.Pk)
+	assert.NoError(err)
+	wOpening, err := kzg_bn254.Open(w, gamma, srs.Pk)
+	assert.NoError(err)
+
+	// cross-check against the uncompressed batch: the folded digest opens at
+	// point to the folded value with the combined quotient
+	var foldedDigest bn254.G1Affine
+	var foldedValue, power fr_bn254.Element
+	power.SetOne()
+	var tmp bn254.G1Affine
+	var tmpS fr_bn254.Element
+	for i := range commitments {
+		tmp.ScalarMultiplication(&commitments[i], power.BigInt(new(big.Int)))
+		foldedDigest.Add(&foldedDigest, &tmp)
+		tmpS.Mul(&values[i], &power)
+		foldedValue.Add(&foldedValue, &tmpS)
+		power.Mul(&power, &gamma)
+	}
+	assert.NoError(kzg_bn254.Verify(&foldedDigest, &kzg_bn254.OpeningProof{H: wCommit, ClaimedValue: foldedValue}, point, srs.Vk))
+
+	wVk, err := ValueOfVerifyingKey[sw_bn254.G1Affine, sw_bn254.G2Affine](srs.Vk)
+	assert.NoError(err)
+	circuit := CompressedBatchCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{}
+	assignment := CompressedBatchCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{
+		VerifyingKey: wVk,
+		Point:        emulated.ValueOf[sw_bn254.ScalarField](point),
+		Challenge:    emulated.ValueOf[sw_bn254.ScalarField](gamma),
+	}
+	assignment.QuotientVector, err = ValueOfCommitment[sw_bn254.G1Affine](wCommit)
+	assert.NoError(err)
+	assignment.QuotientOpening, err = ValueOfOpeningProof[sw_bn254.ScalarField, sw_bn254.G1Affine](wOpening)
+	assert.NoError(err)
+	for i := range commitments {
+		assignment.Commitments[i], err = ValueOfCommitment[sw_bn254.G1Affine](commitments[i])
+		assert.NoError(err)
+		assignment.ClaimedValues[i] = emulated.ValueOf[sw_bn254.ScalarField](values[i])
+	}
+	assert.NoError(test.IsSolved(&circuit, &assignment, ecc.BN254.ScalarField()))
+
+	// a wrong claimed value breaks the folded opening
+	badAssignment := assignment
+	var otherValue fr_bn254.Element
+	otherValue.SetOne().Add(&otherValue, &values[1])
+	badAssignment.ClaimedValues[1] = emulated.ValueOf[sw_bn254.ScalarField](otherValue)
+	assert.Error(test.IsSolved(&circuit, &badAssignment, ecc.BN254.ScalarField()))
+}
@@ -0,0 +1,101 @@
+package kzg
+
+import (
+	"fmt"
+	"math/big"
+
+	fp_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/fp"
+	"github.com/consensys/gnark/constraint/solver"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_bn254"
+	"github.com/consensys/gnark/std/math/bits"
+	"github.com/consensys/gnark/std/math/emulated"
+)
+
+func init() {
+	solver.RegisterHint(smallestSqrtHint)
+}
+
+// ValueOfCommitmentFromPublicField reconstructs a commitment from its
+// compressed serialization packed into public-input field elements and
+// decompresses it in-circuit. On-chain verifiers pass commitments through the
+// public input, where a compressed point packed into two field elements
+// halves the input count compared to affine coordinates.
+//
+// The packing follows the gnark-crypto compressed encoding: packed[0] is the
+// big-endian integer of the first 16 bytes, including the two flag bits on
+// top, and packed[1] that of the last 16 bytes. The gadget asserts the
+// compression flag, recovers the y coordinate from the curve equation with
+// the lexicographically-largest flag selecting the root, and fails to solve
+// for the point at infinity or an x coordinate off the curve or out of range.
+// It is only available for the BN254 parametrization, whose base field
+// element spills over a single native field element.
+func (v *Verifier[FR, G1El, G2El, GTEl]) ValueOfCommitmentFromPublicField(packed []frontend.Variable) (*Commitment[G1El], error) {
+	var ret Commitment[G1El]
+	switch s := any(&ret).(type) {
+	case *Commitment[sw_bn254.G1Affine]:
+		if len(packed) != 2 {
+			return nil, fmt.Errorf("expecting two packed variables, got %d", len(packed))
+		}
+		fp, err := emulated.NewField[sw_bn254.BaseField](v.api)
+		if err != nil {
+			return nil, fmt.Errorf("new base field: %w", err)
+		}
+		// split off the flag bits: the top bit asserts the compressed
+		// non-infinity encoding, the next selects the larger root
+		hiBits := bits.ToBinary(v.api, packed[0], bits.WithNbDigits(128))
+		loBits := bits.ToBinary(v.api, packed[1], bits.WithNbDigits(128))
+		v.api.AssertIsEqual(hiBits[127], 1)
+		flagLargest := hiBits[126]
+		xBits := append(loBits, hiBits[:126]...)
+		x := fp.FromBits(xBits...)
+		fp.AssertIsInRange(x)
+
+		// y² = x³ + 3
+		ySq := fp.Add(fp.Mul(fp.Mul(x, x), x), fp.NewElement(3))
+
+		// the hint returns the lexicographically smallest root, which the
+		// range check against (p-1)/2 pins down uniquely
+		roots, err := fp.NewHint(smallestSqrtHint, 1, ySq)
+		if err != nil {
+			return nil, fmt.Errorf("sqrt hint: %w", err)
+		}
+		ySmall := roots[0]
+		fp.AssertIsEqual(fp.Mul(ySmall, ySmall), ySq)
+		var halfP big.Int
+		halfP.Sub(fp_bn254.Modulus(), big.NewInt(1)).Rsh(&halfP, 1)
+		fp.AssertIsLessOrEqual(ySmall, fp.NewElement(halfP))
+		y := fp.Select(flagLargest, fp.Neg(ySmall), ySmall)
+
+		s.G1El = sw_bn254.G1Affine{X: *x, Y: *y}
+	default:
+		return nil, fmt.Errorf("unsupported type parametrisation %T", ret)
+	}
+	return &ret, nil
+}
+
+// smallestSqrtHint returns the lexicographically smallest square root of its
+// input, erroring when the input is not a quadratic residue.
+func smallestSqrtHint(mod *big.Int, inputs, outputs []*big.Int) error {
+	return emulated.UnwrapHint(inputs, outputs, func(field *big.Int, inputs, outputs []*big.Int) error {
+		if len(inputs) != 1 {
+			return fmt.Errorf("expecting one input")
+		}
+		if len(outputs) != 1 {
+			return fmt.Errorf("expecting one output")
+		}
+		if field.Cmp(fp_bn254.Modulus()) != 0 {
+			return fmt.Errorf("expecting BN254 base field")
+		}
+		var ySq, y fp_bn254.Element
+		ySq.SetBigInt(inputs[0])
+		if y.Sqrt(&ySq) == nil {
+			return fmt.Errorf("not a quadratic residue")
+		}
+		if y.LexicographicallyLargest() {
+			y.Neg(&y)
+		}
+		y.BigInt(outputs[0])
+		return nil
+	})
+}
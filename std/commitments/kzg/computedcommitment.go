@@ -0,0 +1,44 @@
+package kzg
+
+import (
+	"fmt"
+
+	"github.com/consensys/gnark/std/math/emulated"
+)
+
+// AssertProofOfComputedCommitment computes the commitment in-circuit as the
+// multi-scalar multiplication of the generators by the scalars,
+//
+//	commitment = Σ scalars[i]·generators[i],
+//
+// and verifies the opening proof against it, coupling commitment computation
+// and opening in one call. With the generators set to the G1 powers of the
+// SRS (see [FullSRS]) and the scalars to the coefficients, this opens a
+// polynomial committed from private coefficients without ever materializing
+// the commitment as a witness.
+//
+// The in-circuit MSM dominates the cost: it grows linearly in the number of
+// generators on top of the fixed pairing check, so the call is practical for
+// small vectors only.
+func (v *Verifier[FR, G1El, G2El, GTEl]) AssertProofOfComputedCommitment(scalars []emulated.Element[FR], generators []G1El, proof OpeningProof[FR, G1El], point emulated.Element[FR], vk VerifyingKey[G1El, G2El]) error {
+	if len(scalars) != len(generators) {
+		return fmt.Errorf("length mismatch for scalars and generators")
+	}
+	if len(scalars) == 0 {
+		return fmt.Errorf("number of scalars should be nonzero")
+	}
+	genPoints := make([]*G1El, len(generators))
+	scalarPoints := make([]*emulated.Element[FR], len(scalars))
+	for i := range generators {
+		genPoints[i] = &generators[i]
+		scalarPoints[i] = &scalars[i]
+	}
+	commitment, err := v.curve.MultiScalarMul(genPoints, scalarPoints)
+	if err != nil {
+		return fmt.Errorf("compute commitment: %w", err)
+	}
+	if err := v.CheckOpeningProof(Commitment[G1El]{G1El: *commitment}, proof, point, vk); err != nil {
+		return fmt.Errorf("check opening proof: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,81 @@
+package kzg
+
+import (
+	"crypto/rand"
+	"fmt"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	fr_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	kzg_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/kzg"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/algebra"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_bn254"
+	"github.com/consensys/gnark/std/math/emulated"
+	"github.com/consensys/gnark/test"
+)
+
+const computedCommitmentSize = 8
+
+type ComputedCommitmentCircuit[FR emulated.FieldParams, G1El algebra.G1ElementT, G2El algebra.G2ElementT, GTEl algebra.GtElementT] struct {
+	VerifyingKey[G1El, G2El]
+	Scalars    [computedCommitmentSize]emulated.Element[FR]
+	Generators [computedCommitmentSize]G1El
+	Proof      OpeningProof[FR, G1El]
+	Point      emulated.Element[FR]
+}
+
+func (c *ComputedCommitmentCircuit[FR, G1El, G2El, GTEl]) Define(api frontend.API) error {
+	verifier, err := NewVerifier[FR, G1El, G2El, GTEl](api)
+	if err != nil {
+		return fmt.Errorf("new verifier: %w", err)
+	}
+	return verifier.AssertProofOfComputedCommitment(c.Scalars[:], c.Generators[:], c.Proof, c.Point, c.VerifyingKey)
+}
+
+func TestAssertProofOfComputedCommitment(t *testing.T) {
+	assert := test.NewAssert(t)
+
+	alpha, err := rand.Int(rand.Reader, ecc.BN254.ScalarField())
+	assert.NoError(err)
+	srs, err := kzg_bn254.NewSRS(kzgSize, alpha)
+	assert.NoError(err)
+
+	// commit to a small polynomial from its coefficients over the SRS powers
+	f := make([]fr_bn254.Element, computedCommitmentSize)
+	for i := range f {
+		f[i].SetRandom()
+	}
+	var point fr_bn254.Element
+	point.SetRandom()
+	proof, err := kzg_bn254.Open(f, point, srs.Pk)
+	assert.NoError(err)
+
+	assignment := ComputedCommitmentCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{
+		Point: emulated.ValueOf[sw_bn254.ScalarField](point),
+	}
+	for i := 0; i < computedCommitmentSize; i++ {
+		assignment.Scalars[i] = emulated.ValueOf[sw_bn254.ScalarField](f[i])
+		assignment.Generators[i] = sw_bn254.NewG1Affine(srs.Pk.G1[i])
+	}
+	assignment.VerifyingKey, err = ValueOfVerifyingKey[sw_bn254.G1Affine, sw_bn254.G2Affine](srs.Vk)
+	assert.NoError(err)
+	assignment.Proof, err = ValueOfOpeningProof[sw_bn254.ScalarField, sw_bn254.G1Affine](proof)
+	assert.NoError(err)
+
+	circuit := ComputedCommitmentCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{}
+
+	assert.Run(func(assert *test.Assert) {
+		assert.NoError(test.IsSolved(&circuit, &assignment, ecc.BN254.ScalarField()))
+	}, "valid")
+
+	assert.Run(func(assert *test.Assert) {
+		// a scalar not matching the opened polynomial changes the computed
+		// commitment and the opening fails
+		badAssignment := assignment
+		var other fr_bn254.Element
+		other.SetRandom()
+		badAssignment.Scalars[0] = emulated.ValueOf[sw_bn254.ScalarField](other)
+		assert.Error(test.IsSolved(&circuit, &badAssignment, ecc.BN254.ScalarField()))
+	}, "wrong scalar")
+}
@@ -0,0 +1,33 @@
+package kzg
+
+import (
+	"fmt"
+
+	"github.com/consensys/gnark/std/math/emulated"
+)
+
+// AssertCoupledCommitments asserts that two separately committed polynomials
+// f and q satisfy the opening relation f(X) - value = q(X)·(X - point), i.e.
+// that f opens to value at point with q as the quotient, directly from the two
+// commitments without a separate opening-proof object:
+//
+//	e(commitmentF - [value]G₁, G₂) == e(commitmentQ, [α]G₂ - [point]G₂)
+//
+// Since the generic pairing context exposes no G2 arithmetic, the right-hand
+// side is rearranged using e(Q, [α-a]G₂) = e(Q, [α]G₂)·e([-a]Q, G₂) into the
+// equivalent product
+//
+//	e([value - point·q(α) ]G₁ - commitmentF, G₂)·e(commitmentQ, [α]G₂) == 1
+//
+// which is the standard opening check with the quotient commitment playing
+// the role of the proof.
+func (v *Verifier[FR, G1El, G2El, GTEl]) AssertCoupledCommitments(commitmentF, commitmentQ Commitment[G1El], point, value emulated.Element[FR], vk VerifyingKey[G1El, G2El]) error {
+	proof := OpeningProof[FR, G1El]{
+		Quotient:     commitmentQ.G1El,
+		ClaimedValue: value,
+	}
+	if err := v.CheckOpeningProof(commitmentF, proof, point, vk); err != nil {
+		return fmt.Errorf("check opening proof: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,90 @@
+package kzg
+
+import (
+	"crypto/rand"
+	"fmt"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	fr_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	kzg_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/kzg"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/algebra"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_bn254"
+	"github.com/consensys/gnark/std/math/emulated"
+	"github.com/consensys/gnark/test"
+)
+
+type CoupledCommitmentsCircuit[FR emulated.FieldParams, G1El algebra.G1ElementT, G2El algebra.G2ElementT, GTEl algebra.GtElementT] struct {
+	VerifyingKey[G1El, G2El]
+	CommitmentF Commitment[G1El]
+	CommitmentQ Commitment[G1El]
+	Point       emulated.Element[FR]
+	Value       emulated.Element[FR]
+}
+
+func (c *CoupledCommitmentsCircuit[FR, G1El, G2El, GTEl]) Define(api frontend.API) error {
+	verifier, err := NewVerifier[FR, G1El, G2El, GTEl](api)
+	if err != nil {
+		return fmt.Errorf("new verifier: %w", err)
+	}
+	return verifier.AssertCoupledCommitments(c.CommitmentF, c.CommitmentQ, c.Point, c.Value, c.VerifyingKey)
+}
+
+func TestAssertCoupledCommitments(t *testing.T) {
+	assert := test.NewAssert(t)
+
+	alpha, err := rand.Int(rand.Reader, ecc.BN254.ScalarField())
+	assert.NoError(err)
+	srs, err := kzg_bn254.NewSRS(kzgSize, alpha)
+	assert.NoError(err)
+
+	f := make([]fr_bn254.Element, polynomialSize)
+	for i := range f {
+		f[i].SetRandom()
+	}
+	var point fr_bn254.Element
+	point.SetRandom()
+	value := evalPolynomial(f, point)
+
+	// synthetic division: q(X) = (f(X) - f(a)) / (X - a)
+	q := make([]fr_bn254.Element, len(f)-1)
+	acc := f[len(f)-1]
+	for i := len(f) - 2; i >= 0; i-- {
+		q[i] = acc
+		acc.Mul(&acc, &point).Add(&acc, &f[i])
+	}
+	// the remainder is the evaluation
+	assert.True(acc.Equal(&value))
+
+	comF, err := kzg_bn254.Commit(f, srs.Pk)
+	assert.NoError(err)
+	comQ, err := kzg_bn254.Commit(q, srs.Pk)
+	assert.NoError(err)
+
+	assignment := CoupledCommitmentsCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{
+		Point: emulated.ValueOf[sw_bn254.ScalarField](point),
+		Value: emulated.ValueOf[sw_bn254.ScalarField](value),
+	}
+	assignment.VerifyingKey, err = ValueOfVerifyingKey[sw_bn254.G1Affine, sw_bn254.G2Affine](srs.Vk)
+	assert.NoError(err)
+	assignment.CommitmentF, err = ValueOfCommitment[sw_bn254.G1Affine](comF)
+	assert.NoError(err)
+	assignment.CommitmentQ, err = ValueOfCommitment[sw_bn254.G1Affine](comQ)
+	assert.NoError(err)
+
+	circuit := CoupledCommitmentsCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{}
+
+	assert.Run(func(assert *test.Assert) {
+		assert.NoError(test.IsSolved(&circuit, &assignment, ecc.BN254.ScalarField()))
+	}, "valid")
+
+	assert.Run(func(assert *test.Assert) {
+		// a wrong claimed value breaks the division relation
+		badAssignment := assignment
+		var otherValue fr_bn254.Element
+		otherValue.SetRandom()
+		badAssignment.Value = emulated.ValueOf[sw_bn254.ScalarField](otherValue)
+		assert.Error(test.IsSolved(&circuit, &badAssignment, ecc.BN254.ScalarField()))
+	}, "wrong value")
+}
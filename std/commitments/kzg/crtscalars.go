@@ -0,0 +1,15 @@
+package kzg
+
+import (
+	"github.com/consensys/gnark/std/math/emulated"
+)
+
+// ScalarCRT returns an experimental CRT context over the verifier's scalar
+// field. Folding computations with long chains of scalar products can opt
+// into the residue representation through it, converting to and from the
+// standard emulated form at the boundaries with [emulated.CRT.ToCRT] and
+// [emulated.CRT.FromCRT]. See [emulated.CRT] for the trade-offs and
+// correctness conditions.
+func (v *Verifier[FR, G1El, G2El, GTEl]) ScalarCRT() (*emulated.CRT[FR], error) {
+	return emulated.NewCRT[FR](v.api)
+}
@@ -0,0 +1,77 @@
+package kzg
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	fr_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/algebra"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_bn254"
+	"github.com/consensys/gnark/std/math/emulated"
+	"github.com/consensys/gnark/test"
+)
+
+type CRTScalarCircuit[FR emulated.FieldParams, G1El algebra.G1ElementT, G2El algebra.G2ElementT, GTEl algebra.GtElementT] struct {
+	Gamma  emulated.Element[FR]
+	Values [2]emulated.Element[FR]
+}
+
+func (c *CRTScalarCircuit[FR, G1El, G2El, GTEl]) Define(api frontend.API) error {
+	verifier, err := NewVerifier[FR, G1El, G2El, GTEl](api)
+	if err != nil {
+		return fmt.Errorf("new verifier: %w", err)
+	}
+	crt, err := verifier.ScalarCRT()
+	if err != nil {
+		return fmt.Errorf("scalar crt: %w", err)
+	}
+	// fold v₀ + γ·v₁ in residue form and compare with the standard form
+	gamma, err := crt.ToCRT(&c.Gamma)
+	if err != nil {
+		return err
+	}
+	v0, err := crt.ToCRT(&c.Values[0])
+	if err != nil {
+		return err
+	}
+	v1, err := crt.ToCRT(&c.Values[1])
+	if err != nil {
+		return err
+	}
+	scaled, err := crt.Mul(gamma, v1)
+	if err != nil {
+		return err
+	}
+	folded, err := crt.Add(v0, scaled)
+	if err != nil {
+		return err
+	}
+	res, err := crt.FromCRT(folded)
+	if err != nil {
+		return err
+	}
+	expected := verifier.scalarApi.Add(&c.Values[0], verifier.scalarApi.Mul(&c.Gamma, &c.Values[1]))
+	verifier.scalarApi.AssertIsEqual(res, expected)
+	return nil
+}
+
+func TestScalarCRT(t *testing.T) {
+	assert := test.NewAssert(t)
+
+	var gamma, v0, v1 fr_bn254.Element
+	gamma.SetRandom()
+	v0.SetRandom()
+	v1.SetRandom()
+
+	circuit := CRTScalarCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{}
+	assignment := CRTScalarCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{
+		Gamma: emulated.ValueOf[sw_bn254.ScalarField](gamma),
+		Values: [2]emulated.Element[sw_bn254.ScalarField]{
+			emulated.ValueOf[sw_bn254.ScalarField](v0),
+			emulated.ValueOf[sw_bn254.ScalarField](v1),
+		},
+	}
+	assert.NoError(test.IsSolved(&circuit, &assignment, ecc.BN254.ScalarField()))
+}
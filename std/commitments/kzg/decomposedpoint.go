@@ -0,0 +1,38 @@
+package kzg
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/consensys/gnark/std/math/emulated"
+)
+
+// AssertProofDecomposedPoint asserts the validity of the opening proof for
+// the given commitment at the point reconstructed as
+//
+//	point = pointHi·lambda + pointLo mod r
+//
+// from a pre-decomposed evaluation point, as produced by GLV-style scalar
+// decomposition with endomorphism eigenvalue lambda. Both half-scalars are
+// constrained to half the scalar field bit width (plus one slack bit,
+// matching the bounds of lattice-based decomposition), so a prover cannot
+// widen them to reach evaluation points outside the decomposition's image.
+// The opening check itself proceeds as [Verifier.CheckOpeningProof] on the
+// reconstructed point; the in-circuit scalar multiplications use the curve's
+// GLV path where available.
+func (v *Verifier[FR, G1El, G2El, GTEl]) AssertProofDecomposedPoint(commitment Commitment[G1El], proof OpeningProof[FR, G1El], pointHi, pointLo, lambda emulated.Element[FR], vk VerifyingKey[G1El, G2El]) error {
+	var fr FR
+	// enforce half-width decomposition: hi, lo < 2^(⌈n/2⌉+1)
+	nbHalfBits := (fr.Modulus().BitLen()+1)/2 + 1
+	bound := new(big.Int).Lsh(big.NewInt(1), uint(nbHalfBits))
+	bound.Sub(bound, big.NewInt(1))
+	boundEl := v.scalarApi.NewElement(bound)
+	v.scalarApi.AssertIsLessOrEqual(&pointHi, boundEl)
+	v.scalarApi.AssertIsLessOrEqual(&pointLo, boundEl)
+
+	point := v.scalarApi.Add(v.scalarApi.Mul(&pointHi, &lambda), &pointLo)
+	if err := v.CheckOpeningProof(commitment, proof, *point, vk); err != nil {
+		return fmt.Errorf("check opening proof: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,107 @@
+package kzg
+
+import (
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	fr_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	kzg_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/kzg"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/algebra"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_bn254"
+	"github.com/consensys/gnark/std/math/emulated"
+	"github.com/consensys/gnark/test"
+)
+
+type DecomposedPointCircuit[FR emulated.FieldParams, G1El algebra.G1ElementT, G2El algebra.G2ElementT, GTEl algebra.GtElementT] struct {
+	VerifyingKey[G1El, G2El]
+	Commitment Commitment[G1El]
+	Proof      OpeningProof[FR, G1El]
+	PointHi    emulated.Element[FR]
+	PointLo    emulated.Element[FR]
+	Lambda     emulated.Element[FR]
+}
+
+func (c *DecomposedPointCircuit[FR, G1El, G2El, GTEl]) Define(api frontend.API) error {
+	verifier, err := NewVerifier[FR, G1El, G2El, GTEl](api)
+	if err != nil {
+		return fmt.Errorf("new verifier: %w", err)
+	}
+	return verifier.AssertProofDecomposedPoint(c.Commitment, c.Proof, c.PointHi, c.PointLo, c.Lambda, c.VerifyingKey)
+}
+
+func TestAssertProofDecomposedPoint(t *testing.T) {
+	assert := test.NewAssert(t)
+
+	alpha, err := rand.Int(rand.Reader, ecc.BN254.ScalarField())
+	assert.NoError(err)
+	srs, err := kzg_bn254.NewSRS(kzgSize, alpha)
+	assert.NoError(err)
+
+	f := make([]fr_bn254.Element, polynomialSize)
+	for i := range f {
+		f[i].SetRandom()
+	}
+	com, err := kzg_bn254.Commit(f, srs.Pk)
+	assert.NoError(err)
+
+	// a prover with a GLV-style decomposition holds half-width scalars and
+	// the eigenvalue; the evaluation point is hi*λ + lo
+	halfBound := new(big.Int).Lsh(big.NewInt(1), uint((ecc.BN254.ScalarField().BitLen()+1)/2))
+	hi, err := rand.Int(rand.Reader, halfBound)
+	assert.NoError(err)
+	lo, err := rand.Int(rand.Reader, halfBound)
+	assert.NoError(err)
+	lambda, err := rand.Int(rand.Reader, ecc.BN254.ScalarField())
+	assert.NoError(err)
+	var hiEl, loEl, lambdaEl, point fr_bn254.Element
+	hiEl.SetBigInt(hi)
+	loEl.SetBigInt(lo)
+	lambdaEl.SetBigInt(lambda)
+	point.Mul(&hiEl, &lambdaEl).Add(&point, &loEl)
+
+	proof, err := kzg_bn254.Open(f, point, srs.Pk)
+	assert.NoError(err)
+
+	assignment := DecomposedPointCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{
+		PointHi: emulated.ValueOf[sw_bn254.ScalarField](hi),
+		PointLo: emulated.ValueOf[sw_bn254.ScalarField](lo),
+		Lambda:  emulated.ValueOf[sw_bn254.ScalarField](lambda),
+	}
+	assignment.VerifyingKey, err = ValueOfVerifyingKey[sw_bn254.G1Affine, sw_bn254.G2Affine](srs.Vk)
+	assert.NoError(err)
+	assignment.Commitment, err = ValueOfCommitment[sw_bn254.G1Affine](com)
+	assert.NoError(err)
+	assignment.Proof, err = ValueOfOpeningProof[sw_bn254.ScalarField, sw_bn254.G1Affine](proof)
+	assert.NoError(err)
+
+	circuit := DecomposedPointCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{}
+
+	assert.Run(func(assert *test.Assert) {
+		assert.NoError(test.IsSolved(&circuit, &assignment, ecc.BN254.ScalarField()))
+	}, "valid")
+
+	assert.Run(func(assert *test.Assert) {
+		// the undecomposed check accepts the same opening at the
+		// reconstructed point
+		plainCircuit := KZGVerificationCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{}
+		plainAssignment := KZGVerificationCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{
+			VerifyingKey: assignment.VerifyingKey,
+			Commitment:   assignment.Commitment,
+			OpeningProof: assignment.Proof,
+			Point:        emulated.ValueOf[sw_bn254.ScalarField](point),
+		}
+		assert.NoError(test.IsSolved(&plainCircuit, &plainAssignment, ecc.BN254.ScalarField()))
+	}, "matches undecomposed")
+
+	assert.Run(func(assert *test.Assert) {
+		// a full-width half-scalar violates the decomposition range check
+		badAssignment := assignment
+		wideHi := new(big.Int).Sub(ecc.BN254.ScalarField(), big.NewInt(1))
+		badAssignment.PointHi = emulated.ValueOf[sw_bn254.ScalarField](wideHi)
+		assert.Error(test.IsSolved(&circuit, &badAssignment, ecc.BN254.ScalarField()))
+	}, "out of range")
+}
@@ -0,0 +1,48 @@
+package kzg
+
+import (
+	"fmt"
+
+	"github.com/consensys/gnark/std/algebra"
+	"github.com/consensys/gnark/std/math/emulated"
+)
+
+// AssertDegreeViaRandomPoint asserts that the committed polynomial f has
+// degree at most maxDegree, via a single opening at the random challenge ρ
+// with a degree-shifted quotient. With D the maximal power of the SRS and
+// s = D - maxDegree + 1, the prover computes q = (f - f(ρ))/(X - ρ) and
+// commits the shifted quotient Q = [α^s·q(α)]G₁; shiftedProof carries Q and
+// the claimed value f(ρ). srsShift is the ceremony point [α^s]G₂, which, like
+// [FullSRS], must be bound to the trusted setup by the caller. The verifier
+// checks
+//
+//	e(C - [f(ρ)]G₁, [α^s]G₂)·e(Q, G₂)^ρ·e(-Q, [α]G₂) == 1
+//
+// i.e. α^s·(f(α) - f(ρ)) = (α - ρ)·α^s·q(α). Soundness: a prover restricted
+// to the G1 powers of the SRS (in the algebraic group model) can only produce
+// Q representing a polynomial of degree at most D; the identity forces that
+// polynomial to be X^s·q, so s + deg(f) - 1 ≤ D, i.e. deg(f) ≤ maxDegree.
+// The shift makes the quotient of an over-degree polynomial overflow the SRS,
+// so no valid Q exists for it. The challenge must be sampled after the
+// commitment is fixed, e.g. by a Fiat-Shamir transcript.
+//
+// See [Verifier.AssertLowDegree] for the variant recomputing the commitment
+// from evaluations, which does not need a shifted ceremony point.
+func (v *Verifier[FR, G1El, G2El, GTEl]) AssertDegreeViaRandomPoint(commitment Commitment[G1El], shiftedProof OpeningProof[FR, G1El], maxDegree int, srsShift G2El, challenge emulated.Element[FR], vk VerifyingKey[G1El, G2El]) error {
+	if maxDegree < 0 {
+		return fmt.Errorf("negative degree bound %d", maxDegree)
+	}
+
+	// C - [f(ρ)]G₁
+	folded := v.curve.ScalarMul(&vk.G1, &shiftedProof.ClaimedValue)
+	folded = v.curve.Add(v.curve.Neg(folded), &commitment.G1El)
+
+	eq := algebra.NewEquation(v.curve, v.pairing)
+	eq.AddPairing(folded, &srsShift)
+	eq.AddScaledPairing(&shiftedProof.Quotient, &vk.G2[0], &challenge)
+	eq.AddPairing(v.curve.Neg(&shiftedProof.Quotient), &vk.G2[1])
+	if err := eq.AssertEqualsOne(); err != nil {
+		return fmt.Errorf("pairing check: %w", err)
+	}
+	return nil
+}
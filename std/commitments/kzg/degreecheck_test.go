@@ -0,0 +1,120 @@
+package kzg
+
+import (
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bn254"
+	fr_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	kzg_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/kzg"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/algebra"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_bn254"
+	"github.com/consensys/gnark/std/math/emulated"
+	"github.com/consensys/gnark/test"
+)
+
+const testDegreeBound = 10
+
+type DegreeCheckCircuit[FR emulated.FieldParams, G1El algebra.G1ElementT, G2El algebra.G2ElementT, GTEl algebra.GtElementT] struct {
+	VerifyingKey[G1El, G2El]
+	Commitment[G1El]
+	ShiftedProof OpeningProof[FR, G1El]
+	SrsShift     G2El
+	Challenge    emulated.Element[FR]
+}
+
+func (c *DegreeCheckCircuit[FR, G1El, G2El, GTEl]) Define(api frontend.API) error {
+	verifier, err := NewVerifier[FR, G1El, G2El, GTEl](api)
+	if err != nil {
+		return fmt.Errorf("new verifier: %w", err)
+	}
+	return verifier.AssertDegreeViaRandomPoint(c.Commitment, c.ShiftedProof, testDegreeBound, c.SrsShift, c.Challenge, c.VerifyingKey)
+}
+
+// shiftedQuotientProof commits the shifted quotient X^shift·(f - f(rho))/(X - rho),
+// truncating over-degree coefficients to what the SRS can commit, as a
+// cheating prover would have to.
+func shiftedQuotientProof(t *testing.T, f []fr_bn254.Element, rho fr_bn254.Element, shift int, srs *kzg_bn254.SRS) (bn254.G1Affine, fr_bn254.Element) {
+	t.Helper()
+	var value fr_bn254.Element
+	for i := len(f) - 1; i >= 0; i-- {
+		value.Mul(&value, &rho).Add(&value, &f[i])
+	}
+	q := make([]fr_bn254.Element, len(f)-1)
+	var acc fr_bn254.Element
+	for i := len(f) - 1; i > 0; i-- {
+		acc.Mul(&acc, &rho).Add(&acc, &f[i])
+		q[i-1].Set(&acc)
+	}
+	shifted := make([]fr_bn254.Element, shift+len(q))
+	copy(shifted[shift:], q)
+	if len(shifted) > kzgSize {
+		shifted = shifted[:kzgSize]
+	}
+	quotient, err := kzg_bn254.Commit(shifted, srs.Pk)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return quotient, value
+}
+
+func TestAssertDegreeViaRandomPoint(t *testing.T) {
+	assert := test.NewAssert(t)
+
+	alpha, err := rand.Int(rand.Reader, ecc.BN254.ScalarField())
+	assert.NoError(err)
+	srs, err := kzg_bn254.NewSRS(kzgSize, alpha)
+	assert.NoError(err)
+
+	// s = D - maxDegree + 1 with D the maximal SRS power
+	shift := (kzgSize - 1) - testDegreeBound + 1
+	shiftExp := new(big.Int).Exp(alpha, big.NewInt(int64(shift)), ecc.BN254.ScalarField())
+	var srsShift bn254.G2Affine
+	srsShift.ScalarMultiplication(&srs.Vk.G2[0], shiftExp)
+
+	var rho fr_bn254.Element
+	rho.SetRandom()
+
+	buildAssignment := func(f []fr_bn254.Element) DegreeCheckCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl] {
+		com, err := kzg_bn254.Commit(f, srs.Pk)
+		assert.NoError(err)
+		quotient, value := shiftedQuotientProof(t, f, rho, shift, srs)
+		wVk, err := ValueOfVerifyingKey[sw_bn254.G1Affine, sw_bn254.G2Affine](srs.Vk)
+		assert.NoError(err)
+		wCom, err := ValueOfCommitment[sw_bn254.G1Affine](com)
+		assert.NoError(err)
+		return DegreeCheckCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{
+			VerifyingKey: wVk,
+			Commitment:   wCom,
+			ShiftedProof: OpeningProof[sw_bn254.ScalarField, sw_bn254.G1Affine]{
+				Quotient:     sw_bn254.NewG1Affine(quotient),
+				ClaimedValue: emulated.ValueOf[sw_bn254.ScalarField](value),
+			},
+			SrsShift:  sw_bn254.NewG2Affine(srsShift),
+			Challenge: emulated.ValueOf[sw_bn254.ScalarField](rho),
+		}
+	}
+
+	circuit := DegreeCheckCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{}
+
+	// a polynomial of degree exactly maxDegree passes
+	f := make([]fr_bn254.Element, testDegreeBound+1)
+	for i := range f {
+		f[i].SetRandom()
+	}
+	assignment := buildAssignment(f)
+	assert.NoError(test.IsSolved(&circuit, &assignment, ecc.BN254.ScalarField()))
+
+	// an over-degree polynomial is rejected: its shifted quotient overflows
+	// the SRS
+	g := make([]fr_bn254.Element, testDegreeBound+2)
+	for i := range g {
+		g[i].SetRandom()
+	}
+	badAssignment := buildAssignment(g)
+	assert.Error(test.IsSolved(&circuit, &badAssignment, ecc.BN254.ScalarField()))
+}
@@ -0,0 +1,24 @@
+package kzg
+
+import (
+	"fmt"
+
+	"github.com/consensys/gnark/std/math/emulated"
+)
+
+// AssertDeltaOpening verifies that proof opens the difference of the two
+// commitments to delta at point. By homomorphism commitmentNew -
+// commitmentOld commits to the difference of the two datasets, so a sparse
+// change — a single modified row — is proven with one opening of the
+// difference, without ever committing the difference polynomial separately.
+// The proof's claimed value is constrained to equal delta.
+func (v *Verifier[FR, G1El, G2El, GTEl]) AssertDeltaOpening(commitmentOld, commitmentNew Commitment[G1El], point, delta emulated.Element[FR], proof OpeningProof[FR, G1El], vk VerifyingKey[G1El, G2El]) error {
+	diff := Commitment[G1El]{
+		G1El: *v.curve.Add(&commitmentNew.G1El, v.curve.Neg(&commitmentOld.G1El)),
+	}
+	v.scalarApi.AssertIsEqual(&proof.ClaimedValue, &delta)
+	if err := v.CheckOpeningProof(diff, proof, point, vk); err != nil {
+		return fmt.Errorf("check opening proof: %w", err)
+	}
+	return nil
+}
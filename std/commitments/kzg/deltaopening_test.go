@@ -0,0 +1,93 @@
+package kzg
+
+import (
+	"crypto/rand"
+	"fmt"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	fr_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	kzg_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/kzg"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/algebra"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_bn254"
+	"github.com/consensys/gnark/std/math/emulated"
+	"github.com/consensys/gnark/test"
+)
+
+type DeltaOpeningCircuit[FR emulated.FieldParams, G1El algebra.G1ElementT, G2El algebra.G2ElementT, GTEl algebra.GtElementT] struct {
+	VerifyingKey[G1El, G2El]
+	CommitmentOld Commitment[G1El]
+	CommitmentNew Commitment[G1El]
+	Proof         OpeningProof[FR, G1El]
+	Point         emulated.Element[FR]
+	Delta         emulated.Element[FR]
+}
+
+func (c *DeltaOpeningCircuit[FR, G1El, G2El, GTEl]) Define(api frontend.API) error {
+	verifier, err := NewVerifier[FR, G1El, G2El, GTEl](api)
+	if err != nil {
+		return fmt.Errorf("new verifier: %w", err)
+	}
+	return verifier.AssertDeltaOpening(c.CommitmentOld, c.CommitmentNew, c.Point, c.Delta, c.Proof, c.VerifyingKey)
+}
+
+func TestAssertDeltaOpening(t *testing.T) {
+	assert := test.NewAssert(t)
+
+	alpha, err := rand.Int(rand.Reader, ecc.BN254.ScalarField())
+	assert.NoError(err)
+	srs, err := kzg_bn254.NewSRS(kzgSize, alpha)
+	assert.NoError(err)
+
+	// the new dataset differs from the old one in a single row
+	fOld := make([]fr_bn254.Element, polynomialSize)
+	for i := range fOld {
+		fOld[i].SetRandom()
+	}
+	fNew := make([]fr_bn254.Element, polynomialSize)
+	copy(fNew, fOld)
+	fNew[polynomialSize/2].SetRandom()
+
+	comOld, err := kzg_bn254.Commit(fOld, srs.Pk)
+	assert.NoError(err)
+	comNew, err := kzg_bn254.Commit(fNew, srs.Pk)
+	assert.NoError(err)
+
+	// open the difference polynomial at a random point
+	fDiff := make([]fr_bn254.Element, polynomialSize)
+	for i := range fDiff {
+		fDiff[i].Sub(&fNew[i], &fOld[i])
+	}
+	var point fr_bn254.Element
+	point.SetRandom()
+	proof, err := kzg_bn254.Open(fDiff, point, srs.Pk)
+	assert.NoError(err)
+
+	circuit := DeltaOpeningCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{}
+	assignment := DeltaOpeningCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{}
+	assignment.VerifyingKey, err = ValueOfVerifyingKey[sw_bn254.G1Affine, sw_bn254.G2Affine](srs.Vk)
+	assert.NoError(err)
+	assignment.CommitmentOld, err = ValueOfCommitment[sw_bn254.G1Affine](comOld)
+	assert.NoError(err)
+	assignment.CommitmentNew, err = ValueOfCommitment[sw_bn254.G1Affine](comNew)
+	assert.NoError(err)
+	assignment.Proof, err = ValueOfOpeningProof[sw_bn254.ScalarField, sw_bn254.G1Affine](proof)
+	assert.NoError(err)
+	assignment.Point, err = ValueOfScalar[sw_bn254.ScalarField](point)
+	assert.NoError(err)
+	assignment.Delta = emulated.ValueOf[sw_bn254.ScalarField](proof.ClaimedValue)
+
+	assert.Run(func(assert *test.Assert) {
+		assert.NoError(test.IsSolved(&circuit, &assignment, ecc.BN254.ScalarField()))
+	}, "valid")
+
+	assert.Run(func(assert *test.Assert) {
+		// a delta inconsistent with the committed difference is rejected
+		badAssignment := assignment
+		var otherDelta fr_bn254.Element
+		otherDelta.SetRandom()
+		badAssignment.Delta = emulated.ValueOf[sw_bn254.ScalarField](otherDelta)
+		assert.Error(test.IsSolved(&circuit, &badAssignment, ecc.BN254.ScalarField()))
+	}, "wrong delta")
+}
@@ -0,0 +1,93 @@
+package kzg
+
+import (
+	"crypto/rand"
+	mrand "math/rand"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	bn254 "github.com/consensys/gnark-crypto/ecc/bn254"
+	fr_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	kzg_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/kzg"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_bn254"
+	"github.com/consensys/gnark/test"
+)
+
+// checkAgainstNative runs both the in-circuit opening check and gnark-crypto's
+// native kzg.Verify on the same inputs and asserts that they agree on
+// accepting or rejecting.
+func checkAgainstNative(assert *test.Assert, com kzg_bn254.Digest, proof kzg_bn254.OpeningProof, point fr_bn254.Element, vk kzg_bn254.VerifyingKey) {
+	nativeErr := kzg_bn254.Verify(&com, &proof, point, vk)
+
+	wVk, err := ValueOfVerifyingKey[sw_bn254.G1Affine, sw_bn254.G2Affine](vk)
+	assert.NoError(err)
+	wCom, err := ValueOfCommitment[sw_bn254.G1Affine](com)
+	assert.NoError(err)
+	wProof, err := ValueOfOpeningProof[sw_bn254.ScalarField, sw_bn254.G1Affine](proof)
+	assert.NoError(err)
+	wPoint, err := ValueOfScalar[sw_bn254.ScalarField](point)
+	assert.NoError(err)
+	assignment := KZGVerificationCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{
+		VerifyingKey: wVk,
+		Commitment:   wCom,
+		OpeningProof: wProof,
+		Point:        wPoint,
+	}
+	circuitErr := test.IsSolved(&KZGVerificationCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{}, &assignment, ecc.BN254.ScalarField())
+
+	if nativeErr == nil {
+		assert.NoError(circuitErr, "native verifier accepts but the circuit rejects")
+	} else {
+		assert.Error(circuitErr, "native verifier rejects but the circuit accepts")
+	}
+}
+
+// TestDifferentialAgainstNative checks that the in-circuit verifier agrees
+// with the native implementation on a seeded corpus of valid and adversarial
+// openings.
+func TestDifferentialAgainstNative(t *testing.T) {
+	assert := test.NewAssert(t)
+
+	alpha, err := rand.Int(rand.Reader, ecc.BN254.ScalarField())
+	assert.NoError(err)
+	srs, err := kzg_bn254.NewSRS(kzgSize, alpha)
+	assert.NoError(err)
+
+	rng := mrand.New(mrand.NewSource(42)) //nolint:gosec // deterministic corpus
+	_, _, g1, _ := bn254.Generators()
+
+	for i := 0; i < 2; i++ {
+		f := make([]fr_bn254.Element, polynomialSize)
+		for j := range f {
+			f[j].SetUint64(rng.Uint64()).Mul(&f[j], &f[j])
+		}
+		com, err := kzg_bn254.Commit(f, srs.Pk)
+		assert.NoError(err)
+		var point fr_bn254.Element
+		point.SetUint64(rng.Uint64())
+		proof, err := kzg_bn254.Open(f, point, srs.Pk)
+		assert.NoError(err)
+
+		assert.Run(func(assert *test.Assert) {
+			checkAgainstNative(assert, com, proof, point, srs.Vk)
+		}, "valid")
+		assert.Run(func(assert *test.Assert) {
+			tampered := proof
+			tampered.H.Add(&tampered.H, &g1)
+			checkAgainstNative(assert, com, tampered, point, srs.Vk)
+		}, "tampered-quotient")
+		assert.Run(func(assert *test.Assert) {
+			tampered := proof
+			var one fr_bn254.Element
+			one.SetOne()
+			tampered.ClaimedValue.Add(&tampered.ClaimedValue, &one)
+			checkAgainstNative(assert, com, tampered, point, srs.Vk)
+		}, "tampered-value")
+		assert.Run(func(assert *test.Assert) {
+			var badPoint fr_bn254.Element
+			badPoint.SetOne()
+			badPoint.Add(&badPoint, &point)
+			checkAgainstNative(assert, com, proof, badPoint, srs.Vk)
+		}, "tampered-point")
+	}
+}
@@ -0,0 +1,90 @@
+package kzg
+
+import (
+	"fmt"
+
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/math/bits"
+	"github.com/consensys/gnark/std/math/emulated"
+	"github.com/consensys/gnark/std/recursion"
+	"golang.org/x/exp/slices"
+)
+
+// VerificationDigest computes a single field element binding all the inputs of
+// an opening-proof verification: the commitment, the quotient, the claimed
+// value, the point and a caller-provided digest of the SRS. The digest is
+// computed with the hash returned by [recursion.NewHash] and can be reproduced
+// natively with [recursion.NewShort] by writing the gnark-crypto
+// serializations of the same values in the same order, followed by the SRS
+// digest as big-endian bytes padded to the native field byte length.
+//
+// The method only computes the digest, it does not verify the opening proof.
+// See [Verifier.AssertProofAndBindDigest] for the bundled check.
+func (v *Verifier[FR, G1El, G2El, GTEl]) VerificationDigest(commitment Commitment[G1El], proof OpeningProof[FR, G1El], point emulated.Element[FR], srsDigest frontend.Variable) (frontend.Variable, error) {
+	var fr FR
+	h, err := recursion.NewHash(v.api, fr.Modulus(), true)
+	if err != nil {
+		return nil, fmt.Errorf("new hash: %w", err)
+	}
+	h.Write(v.curve.MarshalG1(commitment.G1El)...)
+	h.Write(v.curve.MarshalG1(proof.Quotient)...)
+	h.Write(v.curve.MarshalScalar(proof.ClaimedValue)...)
+	h.Write(v.curve.MarshalScalar(point)...)
+	// the SRS digest is a native field element. We absorb it as big-endian
+	// bits over the full byte length so that the native counterpart writes the
+	// big-endian byte serialization of the same value.
+	nbBits := ((v.api.Compiler().FieldBitLen() + 7) / 8) * 8
+	dBits := bits.ToBinary(v.api, srsDigest, bits.WithNbDigits(nbBits))
+	slices.Reverse(dBits)
+	h.Write(dBits...)
+	return h.Sum(), nil
+}
+
+// AssertNestedOpening asserts a two-level (nested) opening: the outer
+// commitment opens at outerPoint to an encoding of the inner commitment, and
+// the inner commitment opens at innerPoint to innerProof.ClaimedValue. This
+// supports committing to a vector of commitments and then opening through both
+// levels in one call.
+//
+// A G1 point does not fit into a scalar, so the inner commitment is encoded as
+// the short hash (see [recursion.NewHash]) of its serialization. The native
+// prover must commit the outer polynomial to the matching value, computed with
+// [recursion.NewShort] over the gnark-crypto serialization of the inner
+// commitment.
+func (v *Verifier[FR, G1El, G2El, GTEl]) AssertNestedOpening(outerCommitment Commitment[G1El], outerProof OpeningProof[FR, G1El], outerPoint emulated.Element[FR], innerCommitment Commitment[G1El], innerProof OpeningProof[FR, G1El], innerPoint emulated.Element[FR], vk VerifyingKey[G1El, G2El]) error {
+	var fr FR
+	h, err := recursion.NewHash(v.api, fr.Modulus(), true)
+	if err != nil {
+		return fmt.Errorf("new hash: %w", err)
+	}
+	h.Write(v.curve.MarshalG1(innerCommitment.G1El)...)
+	digest := h.Sum()
+	dBits := bits.ToBinary(v.api, digest, bits.WithNbDigits(fr.Modulus().BitLen()))
+	encoded := v.scalarApi.FromBits(dBits...)
+	v.scalarApi.AssertIsEqual(encoded, &outerProof.ClaimedValue)
+
+	if err := v.CheckOpeningProof(outerCommitment, outerProof, outerPoint, vk); err != nil {
+		return fmt.Errorf("outer opening: %w", err)
+	}
+	if err := v.CheckOpeningProof(innerCommitment, innerProof, innerPoint, vk); err != nil {
+		return fmt.Errorf("inner opening: %w", err)
+	}
+	return nil
+}
+
+// AssertProofAndBindDigest asserts the validity of the opening proof for the
+// given commitment at point and additionally asserts that the verification
+// digest, as computed by [Verifier.VerificationDigest], equals expected. This
+// allows an outer protocol (e.g. a smart contract) to bind the whole
+// verification to a single public field element.
+func (v *Verifier[FR, G1El, G2El, GTEl]) AssertProofAndBindDigest(commitment Commitment[G1El], proof OpeningProof[FR, G1El], point emulated.Element[FR], vk VerifyingKey[G1El, G2El], srsDigest, expected frontend.Variable) error {
+	if err := v.CheckOpeningProof(commitment, proof, point, vk); err != nil {
+		return fmt.Errorf("check opening proof: %w", err)
+	}
+	digest, err := v.VerificationDigest(commitment, proof, point, srsDigest)
+	if err != nil {
+		return fmt.Errorf("verification digest: %w", err)
+	}
+	v.api.AssertIsEqual(digest, expected)
+	return nil
+}
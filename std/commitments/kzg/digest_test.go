@@ -0,0 +1,191 @@
+package kzg
+
+import (
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	fr_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	kzg_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/kzg"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/algebra"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_bn254"
+	"github.com/consensys/gnark/std/math/emulated"
+	"github.com/consensys/gnark/std/recursion"
+	"github.com/consensys/gnark/test"
+)
+
+type KZGVerificationDigestCircuit[FR emulated.FieldParams, G1El algebra.G1ElementT, G2El algebra.G2ElementT, GTEl algebra.GtElementT] struct {
+	VerifyingKey[G1El, G2El]
+	Commitment[G1El]
+	OpeningProof[FR, G1El]
+	Point     emulated.Element[FR]
+	SRSDigest frontend.Variable
+	Expected  frontend.Variable `gnark:",public"`
+}
+
+func (c *KZGVerificationDigestCircuit[FR, G1El, G2El, GTEl]) Define(api frontend.API) error {
+	verifier, err := NewVerifier[FR, G1El, G2El, GTEl](api)
+	if err != nil {
+		return fmt.Errorf("new verifier: %w", err)
+	}
+	if err := verifier.AssertProofAndBindDigest(c.Commitment, c.OpeningProof, c.Point, c.VerifyingKey, c.SRSDigest, c.Expected); err != nil {
+		return fmt.Errorf("assert proof and bind digest: %w", err)
+	}
+	return nil
+}
+
+type NestedOpeningCircuit[FR emulated.FieldParams, G1El algebra.G1ElementT, G2El algebra.G2ElementT, GTEl algebra.GtElementT] struct {
+	VerifyingKey[G1El, G2El]
+	OuterCommitment Commitment[G1El]
+	OuterProof      OpeningProof[FR, G1El]
+	OuterPoint      emulated.Element[FR]
+	InnerCommitment Commitment[G1El]
+	InnerProof      OpeningProof[FR, G1El]
+	InnerPoint      emulated.Element[FR]
+}
+
+func (c *NestedOpeningCircuit[FR, G1El, G2El, GTEl]) Define(api frontend.API) error {
+	verifier, err := NewVerifier[FR, G1El, G2El, GTEl](api)
+	if err != nil {
+		return fmt.Errorf("new verifier: %w", err)
+	}
+	if err := verifier.AssertNestedOpening(c.OuterCommitment, c.OuterProof, c.OuterPoint, c.InnerCommitment, c.InnerProof, c.InnerPoint, c.VerifyingKey); err != nil {
+		return fmt.Errorf("assert nested opening: %w", err)
+	}
+	return nil
+}
+
+func TestNestedOpening(t *testing.T) {
+	assert := test.NewAssert(t)
+
+	alpha, err := rand.Int(rand.Reader, ecc.BN254.ScalarField())
+	assert.NoError(err)
+	srs, err := kzg_bn254.NewSRS(kzgSize, alpha)
+	assert.NoError(err)
+
+	// inner polynomial and its opening
+	fInner := make([]fr_bn254.Element, polynomialSize)
+	for i := range fInner {
+		fInner[i].SetRandom()
+	}
+	comInner, err := kzg_bn254.Commit(fInner, srs.Pk)
+	assert.NoError(err)
+	var innerPoint fr_bn254.Element
+	innerPoint.SetRandom()
+	proofInner, err := kzg_bn254.Open(fInner, innerPoint, srs.Pk)
+	assert.NoError(err)
+
+	// the outer polynomial opens at outerPoint to the encoding of the inner
+	// commitment; adjust the constant term of a random polynomial to hit it.
+	h, err := recursion.NewShort(ecc.BN254.ScalarField(), ecc.BN254.ScalarField())
+	assert.NoError(err)
+	h.Write(comInner.Marshal())
+	var encoded fr_bn254.Element
+	encoded.SetBytes(h.Sum(nil))
+	var outerPoint fr_bn254.Element
+	outerPoint.SetRandom()
+	fOuter := make([]fr_bn254.Element, polynomialSize)
+	for i := range fOuter {
+		fOuter[i].SetRandom()
+	}
+	evalOuter := evalPolynomial(fOuter, outerPoint)
+	fOuter[0].Sub(&fOuter[0], &evalOuter).Add(&fOuter[0], &encoded)
+	comOuter, err := kzg_bn254.Commit(fOuter, srs.Pk)
+	assert.NoError(err)
+	proofOuter, err := kzg_bn254.Open(fOuter, outerPoint, srs.Pk)
+	assert.NoError(err)
+
+	wVk, err := ValueOfVerifyingKey[sw_bn254.G1Affine, sw_bn254.G2Affine](srs.Vk)
+	assert.NoError(err)
+	wComOuter, err := ValueOfCommitment[sw_bn254.G1Affine](comOuter)
+	assert.NoError(err)
+	wComInner, err := ValueOfCommitment[sw_bn254.G1Affine](comInner)
+	assert.NoError(err)
+	wProofOuter, err := ValueOfOpeningProof[sw_bn254.ScalarField, sw_bn254.G1Affine](proofOuter)
+	assert.NoError(err)
+	wProofInner, err := ValueOfOpeningProof[sw_bn254.ScalarField, sw_bn254.G1Affine](proofInner)
+	assert.NoError(err)
+	wOuterPoint, err := ValueOfScalar[sw_bn254.ScalarField](outerPoint)
+	assert.NoError(err)
+	wInnerPoint, err := ValueOfScalar[sw_bn254.ScalarField](innerPoint)
+	assert.NoError(err)
+
+	assignment := NestedOpeningCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{
+		VerifyingKey:    wVk,
+		OuterCommitment: wComOuter,
+		OuterProof:      wProofOuter,
+		OuterPoint:      wOuterPoint,
+		InnerCommitment: wComInner,
+		InnerProof:      wProofInner,
+		InnerPoint:      wInnerPoint,
+	}
+	err = test.IsSolved(&NestedOpeningCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{}, &assignment, ecc.BN254.ScalarField())
+	assert.NoError(err)
+}
+
+func TestKZGVerificationDigest(t *testing.T) {
+	assert := test.NewAssert(t)
+
+	alpha, err := rand.Int(rand.Reader, ecc.BN254.ScalarField())
+	assert.NoError(err)
+	srs, err := kzg_bn254.NewSRS(kzgSize, alpha)
+	assert.NoError(err)
+
+	f := make([]fr_bn254.Element, polynomialSize)
+	for i := range f {
+		f[i].SetRandom()
+	}
+
+	com, err := kzg_bn254.Commit(f, srs.Pk)
+	assert.NoError(err)
+
+	var point fr_bn254.Element
+	point.SetRandom()
+	proof, err := kzg_bn254.Open(f, point, srs.Pk)
+	assert.NoError(err)
+
+	// arbitrary SRS digest; in practice this is a hash of the full SRS agreed
+	// on by the out-circuit protocol.
+	srsDigest, err := rand.Int(rand.Reader, ecc.BN254.ScalarField())
+	assert.NoError(err)
+
+	// native reference digest
+	h, err := recursion.NewShort(ecc.BN254.ScalarField(), ecc.BN254.ScalarField())
+	assert.NoError(err)
+	comBytes := com.Marshal()
+	h.Write(comBytes)
+	quotBytes := proof.H.Marshal()
+	h.Write(quotBytes)
+	claimedBytes := proof.ClaimedValue.Marshal()
+	h.Write(claimedBytes)
+	pointBytes := point.Marshal()
+	h.Write(pointBytes)
+	nbBytes := (ecc.BN254.ScalarField().BitLen() + 7) / 8
+	h.Write(srsDigest.FillBytes(make([]byte, nbBytes)))
+	expected := new(big.Int).SetBytes(h.Sum(nil))
+
+	wCmt, err := ValueOfCommitment[sw_bn254.G1Affine](com)
+	assert.NoError(err)
+	wProof, err := ValueOfOpeningProof[sw_bn254.ScalarField, sw_bn254.G1Affine](proof)
+	assert.NoError(err)
+	wVk, err := ValueOfVerifyingKey[sw_bn254.G1Affine, sw_bn254.G2Affine](srs.Vk)
+	assert.NoError(err)
+	wPt, err := ValueOfScalar[sw_bn254.ScalarField](point)
+	assert.NoError(err)
+
+	assignment := KZGVerificationDigestCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{
+		VerifyingKey: wVk,
+		Commitment:   wCmt,
+		OpeningProof: wProof,
+		Point:        wPt,
+		SRSDigest:    srsDigest,
+		Expected:     expected,
+	}
+	assert.CheckCircuit(&KZGVerificationDigestCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{},
+		test.WithValidAssignment(&assignment),
+		test.WithCurves(ecc.BN254),
+	)
+}
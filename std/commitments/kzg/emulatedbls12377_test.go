@@ -0,0 +1,100 @@
+package kzg
+
+import (
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	bls12377 "github.com/consensys/gnark-crypto/ecc/bls12-377"
+	fr_bls12377 "github.com/consensys/gnark-crypto/ecc/bls12-377/fr"
+	kzg_bls12377 "github.com/consensys/gnark-crypto/ecc/bls12-377/kzg"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/algebra"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_emulated"
+	"github.com/consensys/gnark/std/math/emulated"
+	"github.com/consensys/gnark/std/math/emulated/emparams"
+	"github.com/consensys/gnark/test"
+)
+
+// EmulatedBLS12377Circuit exercises the emulated-bls12377 witness
+// parametrization over a foreign outer curve: it loads a native commitment
+// and opening proof via the [sw_emulated.AffinePoint] branches and checks
+// group operations on them against natively computed expectations.
+type EmulatedBLS12377Circuit struct {
+	Commitment     Commitment[sw_emulated.AffinePoint[emparams.BLS12377Fp]]
+	Proof          OpeningProof[emparams.BLS12377Fr, sw_emulated.AffinePoint[emparams.BLS12377Fp]]
+	Scalar         emulated.Element[emparams.BLS12377Fr]
+	ScaledExpected Commitment[sw_emulated.AffinePoint[emparams.BLS12377Fp]]
+	SumExpected    Commitment[sw_emulated.AffinePoint[emparams.BLS12377Fp]]
+	ValueExpected  emulated.Element[emparams.BLS12377Fr]
+}
+
+func (c *EmulatedBLS12377Circuit) Define(api frontend.API) error {
+	curve, err := algebra.GetCurve[emparams.BLS12377Fr, sw_emulated.AffinePoint[emparams.BLS12377Fp]](api)
+	if err != nil {
+		return fmt.Errorf("get curve: %w", err)
+	}
+	scaled := curve.ScalarMul(&c.Commitment.G1El, &c.Scalar)
+	curve.AssertIsEqual(scaled, &c.ScaledExpected.G1El)
+	sum := curve.Add(&c.Commitment.G1El, &c.Proof.Quotient)
+	curve.AssertIsEqual(sum, &c.SumExpected.G1El)
+	scalarApi, err := emulated.NewField[emparams.BLS12377Fr](api)
+	if err != nil {
+		return fmt.Errorf("new scalar api: %w", err)
+	}
+	scalarApi.AssertIsEqual(&c.Proof.ClaimedValue, &c.ValueExpected)
+	return nil
+}
+
+func TestEmulatedBLS12377Witness(t *testing.T) {
+	assert := test.NewAssert(t)
+
+	alpha, err := rand.Int(rand.Reader, ecc.BLS12_377.ScalarField())
+	assert.NoError(err)
+	srs, err := kzg_bls12377.NewSRS(kzgSize, alpha)
+	assert.NoError(err)
+	f := make([]fr_bls12377.Element, polynomialSize)
+	for i := range f {
+		f[i].SetRandom()
+	}
+	com, err := kzg_bls12377.Commit(f, srs.Pk)
+	assert.NoError(err)
+	var point fr_bls12377.Element
+	point.SetRandom()
+	proof, err := kzg_bls12377.Open(f, point, srs.Pk)
+	assert.NoError(err)
+
+	var k fr_bls12377.Element
+	k.SetRandom()
+	var scaled, sum bls12377.G1Affine
+	scaled.ScalarMultiplication(&com, k.BigInt(new(big.Int)))
+	sum.Add(&com, &proof.H)
+
+	assignment := EmulatedBLS12377Circuit{
+		Scalar:        emulated.ValueOf[emparams.BLS12377Fr](k),
+		ValueExpected: emulated.ValueOf[emparams.BLS12377Fr](proof.ClaimedValue),
+	}
+	assignment.Commitment, err = ValueOfCommitment[sw_emulated.AffinePoint[emparams.BLS12377Fp]](com)
+	assert.NoError(err)
+	assignment.Proof, err = ValueOfOpeningProof[emparams.BLS12377Fr, sw_emulated.AffinePoint[emparams.BLS12377Fp]](proof)
+	assert.NoError(err)
+	assignment.ScaledExpected, err = ValueOfCommitment[sw_emulated.AffinePoint[emparams.BLS12377Fp]](scaled)
+	assert.NoError(err)
+	assignment.SumExpected, err = ValueOfCommitment[sw_emulated.AffinePoint[emparams.BLS12377Fp]](sum)
+	assert.NoError(err)
+
+	var circuit EmulatedBLS12377Circuit
+
+	assert.Run(func(assert *test.Assert) {
+		assert.NoError(test.IsSolved(&circuit, &assignment, ecc.BN254.ScalarField()))
+	}, "valid")
+
+	assert.Run(func(assert *test.Assert) {
+		// a wrong scalar multiple is rejected
+		badAssignment := assignment
+		badAssignment.ScaledExpected = assignment.SumExpected
+		assert.Error(test.IsSolved(&circuit, &badAssignment, ecc.BN254.ScalarField()))
+	}, "wrong scaled")
+}
@@ -0,0 +1,101 @@
+package kzg
+
+import (
+	"fmt"
+
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/algebra"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_bls12381"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_bn254"
+	"github.com/consensys/gnark/std/math/emulated"
+)
+
+// muxG2 returns inputs[sel]. The generic [algebra.Curve] interface only
+// multiplexes G1 points, so the G2 coordinates are multiplexed in the base
+// field per parametrization. It returns an error for parametrizations without
+// a G2 multiplexer.
+func muxG2[G2El algebra.G2ElementT](api frontend.API, sel frontend.Variable, inputs []*G2El) (*G2El, error) {
+	var ret G2El
+	switch r := any(&ret).(type) {
+	case *sw_bn254.G2Affine:
+		fp, err := emulated.NewField[sw_bn254.BaseField](api)
+		if err != nil {
+			return nil, fmt.Errorf("new base field: %w", err)
+		}
+		xA0 := make([]*emulated.Element[sw_bn254.BaseField], len(inputs))
+		xA1 := make([]*emulated.Element[sw_bn254.BaseField], len(inputs))
+		yA0 := make([]*emulated.Element[sw_bn254.BaseField], len(inputs))
+		yA1 := make([]*emulated.Element[sw_bn254.BaseField], len(inputs))
+		for i := range inputs {
+			in := any(inputs[i]).(*sw_bn254.G2Affine)
+			xA0[i] = &in.P.X.A0
+			xA1[i] = &in.P.X.A1
+			yA0[i] = &in.P.Y.A0
+			yA1[i] = &in.P.Y.A1
+		}
+		r.P.X.A0 = *fp.Mux(sel, xA0...)
+		r.P.X.A1 = *fp.Mux(sel, xA1...)
+		r.P.Y.A0 = *fp.Mux(sel, yA0...)
+		r.P.Y.A1 = *fp.Mux(sel, yA1...)
+	case *sw_bls12381.G2Affine:
+		fp, err := emulated.NewField[sw_bls12381.BaseField](api)
+		if err != nil {
+			return nil, fmt.Errorf("new base field: %w", err)
+		}
+		xA0 := make([]*emulated.Element[sw_bls12381.BaseField], len(inputs))
+		xA1 := make([]*emulated.Element[sw_bls12381.BaseField], len(inputs))
+		yA0 := make([]*emulated.Element[sw_bls12381.BaseField], len(inputs))
+		yA1 := make([]*emulated.Element[sw_bls12381.BaseField], len(inputs))
+		for i := range inputs {
+			in := any(inputs[i]).(*sw_bls12381.G2Affine)
+			xA0[i] = &in.P.X.A0
+			xA1[i] = &in.P.X.A1
+			yA0[i] = &in.P.Y.A0
+			yA1[i] = &in.P.Y.A1
+		}
+		r.P.X.A0 = *fp.Mux(sel, xA0...)
+		r.P.X.A1 = *fp.Mux(sel, xA1...)
+		r.P.Y.A0 = *fp.Mux(sel, yA0...)
+		r.P.Y.A1 = *fp.Mux(sel, yA1...)
+	default:
+		return nil, fmt.Errorf("unknown type parametrization")
+	}
+	return &ret, nil
+}
+
+// AssertProofWithEpochSRS asserts the validity of the opening proof against
+// the epoch-th verifying key of srsList. The epoch index is range-checked
+// against the list length and the selected G1 generator and G2 points are
+// multiplexed in-circuit, so a deployment rotating its SRS per epoch can keep
+// a single compiled circuit and pass the epoch as a public input. The list
+// itself is expected to be committed to by the outer protocol (e.g. as
+// circuit constants).
+//
+// The muxed line precomputations are dropped, so the selected [α]G₂ point is
+// processed as a fresh input by the pairing.
+func (v *Verifier[FR, G1El, G2El, GTEl]) AssertProofWithEpochSRS(srsList []VerifyingKey[G1El, G2El], epoch frontend.Variable, commitment Commitment[G1El], proof OpeningProof[FR, G1El], point emulated.Element[FR]) error {
+	if len(srsList) == 0 {
+		return fmt.Errorf("empty SRS list")
+	}
+	v.api.AssertIsLessOrEqual(epoch, len(srsList)-1)
+
+	g1s := make([]*G1El, len(srsList))
+	g20s := make([]*G2El, len(srsList))
+	g21s := make([]*G2El, len(srsList))
+	for i := range srsList {
+		g1s[i] = &srsList[i].G1
+		g20s[i] = &srsList[i].G2[0]
+		g21s[i] = &srsList[i].G2[1]
+	}
+	vk := VerifyingKey[G1El, G2El]{G1: *v.curve.Mux(epoch, g1s...)}
+	g20, err := muxG2(v.api, epoch, g20s)
+	if err != nil {
+		return fmt.Errorf("select G2 generator: %w", err)
+	}
+	g21, err := muxG2(v.api, epoch, g21s)
+	if err != nil {
+		return fmt.Errorf("select shifted G2 point: %w", err)
+	}
+	vk.G2 = [2]G2El{*g20, *g21}
+	return v.CheckOpeningProof(commitment, proof, point, vk)
+}
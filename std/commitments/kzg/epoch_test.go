@@ -0,0 +1,88 @@
+package kzg
+
+import (
+	"crypto/rand"
+	"fmt"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	fr_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	kzg_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/kzg"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/algebra"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_bn254"
+	"github.com/consensys/gnark/std/math/emulated"
+	"github.com/consensys/gnark/test"
+)
+
+const testNbEpochs = 3
+
+type EpochSRSCircuit[FR emulated.FieldParams, G1El algebra.G1ElementT, G2El algebra.G2ElementT, GTEl algebra.GtElementT] struct {
+	SRSList [testNbEpochs]VerifyingKey[G1El, G2El]
+	Epoch   frontend.Variable `gnark:",public"`
+	Commitment[G1El]
+	OpeningProof[FR, G1El]
+	Point emulated.Element[FR]
+}
+
+func (c *EpochSRSCircuit[FR, G1El, G2El, GTEl]) Define(api frontend.API) error {
+	verifier, err := NewVerifier[FR, G1El, G2El, GTEl](api)
+	if err != nil {
+		return fmt.Errorf("new verifier: %w", err)
+	}
+	return verifier.AssertProofWithEpochSRS(c.SRSList[:], c.Epoch, c.Commitment, c.OpeningProof, c.Point)
+}
+
+func TestAssertProofWithEpochSRS(t *testing.T) {
+	assert := test.NewAssert(t)
+
+	// one SRS per epoch, the opening is produced under the current one
+	const currentEpoch = 1
+	var srsList [testNbEpochs]*kzg_bn254.SRS
+	for i := range srsList {
+		alpha, err := rand.Int(rand.Reader, ecc.BN254.ScalarField())
+		assert.NoError(err)
+		srsList[i], err = kzg_bn254.NewSRS(kzgSize, alpha)
+		assert.NoError(err)
+	}
+	f := make([]fr_bn254.Element, polynomialSize)
+	for i := range f {
+		f[i].SetRandom()
+	}
+	com, err := kzg_bn254.Commit(f, srsList[currentEpoch].Pk)
+	assert.NoError(err)
+	var point fr_bn254.Element
+	point.SetRandom()
+	proof, err := kzg_bn254.Open(f, point, srsList[currentEpoch].Pk)
+	assert.NoError(err)
+
+	wCom, err := ValueOfCommitment[sw_bn254.G1Affine](com)
+	assert.NoError(err)
+	wProof, err := ValueOfOpeningProof[sw_bn254.ScalarField, sw_bn254.G1Affine](proof)
+	assert.NoError(err)
+	wPoint, err := ValueOfScalar[sw_bn254.ScalarField](point)
+	assert.NoError(err)
+
+	circuit := EpochSRSCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{}
+	assignment := EpochSRSCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{
+		Epoch:        currentEpoch,
+		Commitment:   wCom,
+		OpeningProof: wProof,
+		Point:        wPoint,
+	}
+	for i := range srsList {
+		assignment.SRSList[i], err = ValueOfVerifyingKey[sw_bn254.G1Affine, sw_bn254.G2Affine](srsList[i].Vk)
+		assert.NoError(err)
+	}
+	assert.NoError(test.IsSolved(&circuit, &assignment, ecc.BN254.ScalarField()))
+
+	// selecting another epoch verifies against the wrong SRS
+	badEpoch := assignment
+	badEpoch.Epoch = 0
+	assert.Error(test.IsSolved(&circuit, &badEpoch, ecc.BN254.ScalarField()))
+
+	// out-of-range epochs fail the range check
+	outOfRange := assignment
+	outOfRange.Epoch = testNbEpochs
+	assert.Error(test.IsSolved(&circuit, &outOfRange, ecc.BN254.ScalarField()))
+}
@@ -0,0 +1,41 @@
+package kzg
+
+import (
+	"fmt"
+
+	"github.com/consensys/gnark/std/math/emulated"
+)
+
+// VerifyAndExportTranscript verifies the opening proof while absorbing it into
+// the caller's transcript, leaving the transcript in the post-verification
+// state so an outer protocol can continue the Fiat-Shamir chain with
+// challenges bound to the verified opening. The method absorbs, in order, the
+// commitment, the point, the claimed value and the quotient into the next
+// unsqueezed challenge of the transcript, then verifies the proof; the
+// transcript is not squeezed, so the caller can absorb further protocol
+// messages before deriving the next challenge.
+//
+// The native side reproduces the state by binding the gnark-crypto
+// serialization of the same elements in the same order (see
+// [InterleavedTranscript]).
+func (v *Verifier[FR, G1El, G2El, GTEl]) VerifyAndExportTranscript(commitment Commitment[G1El], proof OpeningProof[FR, G1El], point emulated.Element[FR], vk VerifyingKey[G1El, G2El], transcript *InterleavedTranscript[FR, G1El, G2El, GTEl]) error {
+	if transcript == nil {
+		return fmt.Errorf("nil transcript")
+	}
+	if err := transcript.Absorb(commitment); err != nil {
+		return fmt.Errorf("absorb commitment: %w", err)
+	}
+	if err := transcript.AbsorbScalar(point); err != nil {
+		return fmt.Errorf("absorb point: %w", err)
+	}
+	if err := transcript.AbsorbScalar(proof.ClaimedValue); err != nil {
+		return fmt.Errorf("absorb claimed value: %w", err)
+	}
+	if err := transcript.Absorb(Commitment[G1El]{G1El: proof.Quotient}); err != nil {
+		return fmt.Errorf("absorb quotient: %w", err)
+	}
+	if err := v.CheckOpeningProof(commitment, proof, point, vk); err != nil {
+		return fmt.Errorf("check opening proof: %w", err)
+	}
+	return nil
+}
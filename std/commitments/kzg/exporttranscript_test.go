@@ -0,0 +1,106 @@
+package kzg
+
+import (
+	"crypto/rand"
+	"fmt"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	fr_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	kzg_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/kzg"
+	cryptofs "github.com/consensys/gnark-crypto/fiat-shamir"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/algebra"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_bn254"
+	"github.com/consensys/gnark/std/math/emulated"
+	"github.com/consensys/gnark/std/recursion"
+	"github.com/consensys/gnark/test"
+)
+
+type ExportTranscriptCircuit[FR emulated.FieldParams, G1El algebra.G1ElementT, G2El algebra.G2ElementT, GTEl algebra.GtElementT] struct {
+	VerifyingKey[G1El, G2El]
+	Commitment[G1El]
+	OpeningProof[FR, G1El]
+	Point             emulated.Element[FR]
+	ExpectedChallenge emulated.Element[FR]
+}
+
+func (c *ExportTranscriptCircuit[FR, G1El, G2El, GTEl]) Define(api frontend.API) error {
+	verifier, err := NewVerifier[FR, G1El, G2El, GTEl](api)
+	if err != nil {
+		return fmt.Errorf("new verifier: %w", err)
+	}
+	transcript, err := verifier.NewInterleavedTranscript([]string{"outer"})
+	if err != nil {
+		return fmt.Errorf("new interleaved transcript: %w", err)
+	}
+	if err := verifier.VerifyAndExportTranscript(c.Commitment, c.OpeningProof, c.Point, c.VerifyingKey, transcript); err != nil {
+		return fmt.Errorf("verify and export transcript: %w", err)
+	}
+	// the outer protocol's next challenge is bound to the verified opening
+	challenge, err := transcript.Squeeze()
+	if err != nil {
+		return fmt.Errorf("squeeze outer challenge: %w", err)
+	}
+	verifier.scalarApi.AssertIsEqual(challenge, &c.ExpectedChallenge)
+	return nil
+}
+
+func TestVerifyAndExportTranscript(t *testing.T) {
+	assert := test.NewAssert(t)
+
+	alpha, err := rand.Int(rand.Reader, ecc.BN254.ScalarField())
+	assert.NoError(err)
+	srs, err := kzg_bn254.NewSRS(kzgSize, alpha)
+	assert.NoError(err)
+
+	f := make([]fr_bn254.Element, polynomialSize)
+	for i := range f {
+		f[i].SetRandom()
+	}
+	com, err := kzg_bn254.Commit(f, srs.Pk)
+	assert.NoError(err)
+	var point fr_bn254.Element
+	point.SetRandom()
+	proof, err := kzg_bn254.Open(f, point, srs.Pk)
+	assert.NoError(err)
+
+	// native reference of the post-verification transcript state
+	h, err := recursion.NewShort(ecc.BN254.ScalarField(), ecc.BN254.ScalarField())
+	assert.NoError(err)
+	fs := cryptofs.NewTranscript(h, "outer")
+	assert.NoError(fs.Bind("outer", com.Marshal()))
+	assert.NoError(fs.Bind("outer", point.Marshal()))
+	assert.NoError(fs.Bind("outer", proof.ClaimedValue.Marshal()))
+	assert.NoError(fs.Bind("outer", proof.H.Marshal()))
+	cOuter, err := fs.ComputeChallenge("outer")
+	assert.NoError(err)
+	var expected fr_bn254.Element
+	expected.SetBytes(cOuter)
+
+	assignment := ExportTranscriptCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{
+		Point:             emulated.ValueOf[sw_bn254.ScalarField](point),
+		ExpectedChallenge: emulated.ValueOf[sw_bn254.ScalarField](expected),
+	}
+	assignment.VerifyingKey, err = ValueOfVerifyingKey[sw_bn254.G1Affine, sw_bn254.G2Affine](srs.Vk)
+	assert.NoError(err)
+	assignment.Commitment, err = ValueOfCommitment[sw_bn254.G1Affine](com)
+	assert.NoError(err)
+	assignment.OpeningProof, err = ValueOfOpeningProof[sw_bn254.ScalarField, sw_bn254.G1Affine](proof)
+	assert.NoError(err)
+
+	circuit := ExportTranscriptCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{}
+
+	assert.Run(func(assert *test.Assert) {
+		assert.NoError(test.IsSolved(&circuit, &assignment, ecc.BN254.ScalarField()))
+	}, "valid")
+
+	assert.Run(func(assert *test.Assert) {
+		// a transcript state not matching the verified opening is rejected
+		var otherChallenge fr_bn254.Element
+		otherChallenge.SetRandom()
+		badAssignment := assignment
+		badAssignment.ExpectedChallenge = emulated.ValueOf[sw_bn254.ScalarField](otherChallenge)
+		assert.Error(test.IsSolved(&circuit, &badAssignment, ecc.BN254.ScalarField()))
+	}, "mismatched state")
+}
@@ -0,0 +1,161 @@
+package kzg
+
+import (
+	"fmt"
+
+	bls12377 "github.com/consensys/gnark-crypto/ecc/bls12-377"
+	kzg_bls12377 "github.com/consensys/gnark-crypto/ecc/bls12-377/kzg"
+	bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381"
+	kzg_bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381/kzg"
+	bls24315 "github.com/consensys/gnark-crypto/ecc/bls24-315"
+	kzg_bls24315 "github.com/consensys/gnark-crypto/ecc/bls24-315/kzg"
+	"github.com/consensys/gnark-crypto/ecc/bn254"
+	kzg_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/kzg"
+	bw6761 "github.com/consensys/gnark-crypto/ecc/bw6-761"
+	kzg_bw6761 "github.com/consensys/gnark-crypto/ecc/bw6-761/kzg"
+	"github.com/consensys/gnark/std/algebra"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_bls12381"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_bn254"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_bw6761"
+	"github.com/consensys/gnark/std/algebra/native/sw_bls12377"
+	"github.com/consensys/gnark/std/algebra/native/sw_bls24315"
+	"github.com/consensys/gnark/std/math/emulated"
+)
+
+// ExtendedVerifyingKey is a verifying key extended with additional G2 powers
+// of the ceremony. The basic [VerifyingKey] stores only G₂ and [α]G₂, which
+// suffices for plain openings; degree-bounded and shifted openings also need
+// a higher power [αᵏ]G₂. G2Powers[i] holds [αⁱ]G₂ and Degree is the maximal
+// polynomial degree the G1 side of the SRS supports.
+type ExtendedVerifyingKey[G1El algebra.G1ElementT, G2El algebra.G2ElementT] struct {
+	VerifyingKey[G1El, G2El]
+	G2Powers []G2El
+	Degree   int
+}
+
+// ValueOfExtendedVerifyingKey initializes the extended verifying key witness
+// from the native SRS and the additional G2 powers [αⁱ]G₂ of the ceremony,
+// which the native SRS type does not carry. It returns an error if there is
+// a mismatch between the type parameters and the provided types.
+func ValueOfExtendedVerifyingKey[G1El algebra.G1ElementT, G2El algebra.G2ElementT](srs any, g2Powers any) (ExtendedVerifyingKey[G1El, G2El], error) {
+	var ret ExtendedVerifyingKey[G1El, G2El]
+	switch s := any(&ret).(type) {
+	case *ExtendedVerifyingKey[sw_bn254.G1Affine, sw_bn254.G2Affine]:
+		tSrs, ok := srs.(*kzg_bn254.SRS)
+		if !ok {
+			return ret, fmt.Errorf("mismatching types %T %T", ret, srs)
+		}
+		tPowers, ok := g2Powers.([]bn254.G2Affine)
+		if !ok {
+			return ret, fmt.Errorf("mismatching types %T %T", ret, g2Powers)
+		}
+		vk, err := ValueOfVerifyingKey[sw_bn254.G1Affine, sw_bn254.G2Affine](tSrs.Vk)
+		if err != nil {
+			return ret, fmt.Errorf("verifying key: %w", err)
+		}
+		s.VerifyingKey = vk
+		s.Degree = len(tSrs.Pk.G1) - 1
+		s.G2Powers = make([]sw_bn254.G2Affine, len(tPowers))
+		for i := range tPowers {
+			s.G2Powers[i] = sw_bn254.NewG2Affine(tPowers[i])
+		}
+	case *ExtendedVerifyingKey[sw_bls12377.G1Affine, sw_bls12377.G2Affine]:
+		tSrs, ok := srs.(*kzg_bls12377.SRS)
+		if !ok {
+			return ret, fmt.Errorf("mismatching types %T %T", ret, srs)
+		}
+		tPowers, ok := g2Powers.([]bls12377.G2Affine)
+		if !ok {
+			return ret, fmt.Errorf("mismatching types %T %T", ret, g2Powers)
+		}
+		vk, err := ValueOfVerifyingKey[sw_bls12377.G1Affine, sw_bls12377.G2Affine](tSrs.Vk)
+		if err != nil {
+			return ret, fmt.Errorf("verifying key: %w", err)
+		}
+		s.VerifyingKey = vk
+		s.Degree = len(tSrs.Pk.G1) - 1
+		s.G2Powers = make([]sw_bls12377.G2Affine, len(tPowers))
+		for i := range tPowers {
+			s.G2Powers[i] = sw_bls12377.NewG2Affine(tPowers[i])
+		}
+	case *ExtendedVerifyingKey[sw_bls12381.G1Affine, sw_bls12381.G2Affine]:
+		tSrs, ok := srs.(*kzg_bls12381.SRS)
+		if !ok {
+			return ret, fmt.Errorf("mismatching types %T %T", ret, srs)
+		}
+		tPowers, ok := g2Powers.([]bls12381.G2Affine)
+		if !ok {
+			return ret, fmt.Errorf("mismatching types %T %T", ret, g2Powers)
+		}
+		vk, err := ValueOfVerifyingKey[sw_bls12381.G1Affine, sw_bls12381.G2Affine](tSrs.Vk)
+		if err != nil {
+			return ret, fmt.Errorf("verifying key: %w", err)
+		}
+		s.VerifyingKey = vk
+		s.Degree = len(tSrs.Pk.G1) - 1
+		s.G2Powers = make([]sw_bls12381.G2Affine, len(tPowers))
+		for i := range tPowers {
+			s.G2Powers[i] = sw_bls12381.NewG2Affine(tPowers[i])
+		}
+	case *ExtendedVerifyingKey[sw_bw6761.G1Affine, sw_bw6761.G2Affine]:
+		tSrs, ok := srs.(*kzg_bw6761.SRS)
+		if !ok {
+			return ret, fmt.Errorf("mismatching types %T %T", ret, srs)
+		}
+		tPowers, ok := g2Powers.([]bw6761.G2Affine)
+		if !ok {
+			return ret, fmt.Errorf("mismatching types %T %T", ret, g2Powers)
+		}
+		vk, err := ValueOfVerifyingKey[sw_bw6761.G1Affine, sw_bw6761.G2Affine](tSrs.Vk)
+		if err != nil {
+			return ret, fmt.Errorf("verifying key: %w", err)
+		}
+		s.VerifyingKey = vk
+		s.Degree = len(tSrs.Pk.G1) - 1
+		s.G2Powers = make([]sw_bw6761.G2Affine, len(tPowers))
+		for i := range tPowers {
+			s.G2Powers[i] = sw_bw6761.NewG2Affine(tPowers[i])
+		}
+	case *ExtendedVerifyingKey[sw_bls24315.G1Affine, sw_bls24315.G2Affine]:
+		tSrs, ok := srs.(*kzg_bls24315.SRS)
+		if !ok {
+			return ret, fmt.Errorf("mismatching types %T %T", ret, srs)
+		}
+		tPowers, ok := g2Powers.([]bls24315.G2Affine)
+		if !ok {
+			return ret, fmt.Errorf("mismatching types %T %T", ret, g2Powers)
+		}
+		vk, err := ValueOfVerifyingKey[sw_bls24315.G1Affine, sw_bls24315.G2Affine](tSrs.Vk)
+		if err != nil {
+			return ret, fmt.Errorf("verifying key: %w", err)
+		}
+		s.VerifyingKey = vk
+		s.Degree = len(tSrs.Pk.G1) - 1
+		s.G2Powers = make([]sw_bls24315.G2Affine, len(tPowers))
+		for i := range tPowers {
+			s.G2Powers[i] = sw_bls24315.NewG2Affine(tPowers[i])
+		}
+	default:
+		return ret, fmt.Errorf("unknown type parametrisation")
+	}
+	return ret, nil
+}
+
+// AssertProofWithDegreeBound asserts that the committed polynomial has degree
+// at most maxDegree via the degree-shifted opening of
+// [Verifier.AssertDegreeViaRandomPoint], fetching the required shift point
+// [αˢ]G₂, s = Degree - maxDegree + 1, from the extended verifying key. It
+// returns an error if the stored G2 powers do not reach the required shift.
+func (v *Verifier[FR, G1El, G2El, GTEl]) AssertProofWithDegreeBound(commitment Commitment[G1El], shiftedProof OpeningProof[FR, G1El], maxDegree int, challenge emulated.Element[FR], evk ExtendedVerifyingKey[G1El, G2El]) error {
+	if maxDegree < 0 {
+		return fmt.Errorf("negative degree bound %d", maxDegree)
+	}
+	if maxDegree > evk.Degree {
+		return fmt.Errorf("degree bound %d exceeds SRS degree %d", maxDegree, evk.Degree)
+	}
+	shift := evk.Degree - maxDegree + 1
+	if shift >= len(evk.G2Powers) {
+		return fmt.Errorf("degree bound %d needs G2 power %d but only %d stored", maxDegree, shift, len(evk.G2Powers))
+	}
+	return v.AssertDegreeViaRandomPoint(commitment, shiftedProof, maxDegree, evk.G2Powers[shift], challenge, evk.VerifyingKey)
+}
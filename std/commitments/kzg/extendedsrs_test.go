@@ -0,0 +1,107 @@
+package kzg
+
+import (
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bn254"
+	fr_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	kzg_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/kzg"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/algebra"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_bn254"
+	"github.com/consensys/gnark/std/math/emulated"
+	"github.com/consensys/gnark/test"
+)
+
+type DegreeBoundCircuit[FR emulated.FieldParams, G1El algebra.G1ElementT, G2El algebra.G2ElementT, GTEl algebra.GtElementT] struct {
+	ExtendedVerifyingKey[G1El, G2El]
+	Commitment[G1El]
+	ShiftedProof OpeningProof[FR, G1El]
+	Challenge    emulated.Element[FR]
+
+	maxDegree int
+}
+
+func (c *DegreeBoundCircuit[FR, G1El, G2El, GTEl]) Define(api frontend.API) error {
+	verifier, err := NewVerifier[FR, G1El, G2El, GTEl](api)
+	if err != nil {
+		return fmt.Errorf("new verifier: %w", err)
+	}
+	return verifier.AssertProofWithDegreeBound(c.Commitment, c.ShiftedProof, c.maxDegree, c.Challenge, c.ExtendedVerifyingKey)
+}
+
+func TestAssertProofWithDegreeBound(t *testing.T) {
+	assert := test.NewAssert(t)
+
+	alpha, err := rand.Int(rand.Reader, ecc.BN254.ScalarField())
+	assert.NoError(err)
+	srs, err := kzg_bn254.NewSRS(kzgSize, alpha)
+	assert.NoError(err)
+
+	// ceremony G2 powers [α^i]G₂ up to the shift needed for testDegreeBound
+	shift := (kzgSize - 1) - testDegreeBound + 1
+	g2Powers := make([]bn254.G2Affine, shift+1)
+	pow := big.NewInt(1)
+	for i := range g2Powers {
+		g2Powers[i].ScalarMultiplication(&srs.Vk.G2[0], pow)
+		pow.Mul(pow, alpha).Mod(pow, ecc.BN254.ScalarField())
+	}
+
+	var rho fr_bn254.Element
+	rho.SetRandom()
+
+	buildAssignment := func(f []fr_bn254.Element) DegreeBoundCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl] {
+		com, err := kzg_bn254.Commit(f, srs.Pk)
+		assert.NoError(err)
+		quotient, value := shiftedQuotientProof(t, f, rho, shift, srs)
+		wEvk, err := ValueOfExtendedVerifyingKey[sw_bn254.G1Affine, sw_bn254.G2Affine](srs, g2Powers)
+		assert.NoError(err)
+		wCom, err := ValueOfCommitment[sw_bn254.G1Affine](com)
+		assert.NoError(err)
+		return DegreeBoundCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{
+			ExtendedVerifyingKey: wEvk,
+			Commitment:           wCom,
+			ShiftedProof: OpeningProof[sw_bn254.ScalarField, sw_bn254.G1Affine]{
+				Quotient:     sw_bn254.NewG1Affine(quotient),
+				ClaimedValue: emulated.ValueOf[sw_bn254.ScalarField](value),
+			},
+			Challenge: emulated.ValueOf[sw_bn254.ScalarField](rho),
+			maxDegree: testDegreeBound,
+		}
+	}
+
+	f := make([]fr_bn254.Element, testDegreeBound+1)
+	for i := range f {
+		f[i].SetRandom()
+	}
+	assignment := buildAssignment(f)
+	circuit := assignment
+	circuit.maxDegree = testDegreeBound
+
+	assert.Run(func(assert *test.Assert) {
+		assert.NoError(test.IsSolved(&circuit, &assignment, ecc.BN254.ScalarField()))
+	}, "valid")
+
+	assert.Run(func(assert *test.Assert) {
+		// an over-degree polynomial is rejected
+		g := make([]fr_bn254.Element, testDegreeBound+2)
+		for i := range g {
+			g[i].SetRandom()
+		}
+		badAssignment := buildAssignment(g)
+		assert.Error(test.IsSolved(&circuit, &badAssignment, ecc.BN254.ScalarField()))
+	}, "over degree")
+
+	assert.Run(func(assert *test.Assert) {
+		// a tighter bound needs a higher G2 power than stored
+		tightCircuit := assignment
+		tightCircuit.maxDegree = testDegreeBound - 1
+		tightAssignment := assignment
+		tightAssignment.maxDegree = testDegreeBound - 1
+		assert.Error(test.IsSolved(&tightCircuit, &tightAssignment, ecc.BN254.ScalarField()))
+	}, "missing power")
+}
@@ -0,0 +1,33 @@
+package kzg
+
+import (
+	"fmt"
+
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/math/emulated"
+)
+
+// AssertProofAndDecodeFlags asserts the validity of the opening proof for the
+// given commitment at point and decomposes the low nbFlags bits of the claimed
+// value into boolean variables, returning them in little-endian order. The
+// full claimed value is bit-decomposed, so the flags are bound to the verified
+// evaluation: a prover cannot present flags inconsistent with the opening. The
+// remaining high bits are range-checked as part of the decomposition but not
+// returned.
+//
+// This is a convenience for protocols which pack boolean flags into the low
+// bits of a committed evaluation and branch on them downstream.
+func (v *Verifier[FR, G1El, G2El, GTEl]) AssertProofAndDecodeFlags(commitment Commitment[G1El], proof OpeningProof[FR, G1El], point emulated.Element[FR], vk VerifyingKey[G1El, G2El], nbFlags int) ([]frontend.Variable, error) {
+	var fr FR
+	if nbFlags <= 0 {
+		return nil, fmt.Errorf("number of flags should be positive")
+	}
+	if nbFlags > fr.Modulus().BitLen() {
+		return nil, fmt.Errorf("number of flags exceeds scalar field bit length")
+	}
+	if err := v.CheckOpeningProof(commitment, proof, point, vk); err != nil {
+		return nil, fmt.Errorf("check opening proof: %w", err)
+	}
+	valueBits := v.scalarApi.ToBits(&proof.ClaimedValue)
+	return valueBits[:nbFlags], nil
+}
@@ -0,0 +1,91 @@
+package kzg
+
+import (
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	fr_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	kzg_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/kzg"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/algebra"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_bn254"
+	"github.com/consensys/gnark/std/math/emulated"
+	"github.com/consensys/gnark/test"
+)
+
+const testNbFlags = 4
+
+type DecodeFlagsCircuit[FR emulated.FieldParams, G1El algebra.G1ElementT, G2El algebra.G2ElementT, GTEl algebra.GtElementT] struct {
+	VerifyingKey[G1El, G2El]
+	Commitment    Commitment[G1El]
+	Proof         OpeningProof[FR, G1El]
+	Point         emulated.Element[FR]
+	ExpectedFlags [testNbFlags]frontend.Variable
+}
+
+func (c *DecodeFlagsCircuit[FR, G1El, G2El, GTEl]) Define(api frontend.API) error {
+	verifier, err := NewVerifier[FR, G1El, G2El, GTEl](api)
+	if err != nil {
+		return fmt.Errorf("new verifier: %w", err)
+	}
+	flags, err := verifier.AssertProofAndDecodeFlags(c.Commitment, c.Proof, c.Point, c.VerifyingKey, testNbFlags)
+	if err != nil {
+		return fmt.Errorf("assert proof and decode flags: %w", err)
+	}
+	for i := range flags {
+		api.AssertIsEqual(flags[i], c.ExpectedFlags[i])
+	}
+	return nil
+}
+
+func TestAssertProofAndDecodeFlags(t *testing.T) {
+	assert := test.NewAssert(t)
+
+	alpha, err := rand.Int(rand.Reader, ecc.BN254.ScalarField())
+	assert.NoError(err)
+	srs, err := kzg_bn254.NewSRS(kzgSize, alpha)
+	assert.NoError(err)
+
+	f := make([]fr_bn254.Element, polynomialSize)
+	for i := range f {
+		f[i].SetRandom()
+	}
+	com, err := kzg_bn254.Commit(f, srs.Pk)
+	assert.NoError(err)
+	var point fr_bn254.Element
+	point.SetRandom()
+	proof, err := kzg_bn254.Open(f, point, srs.Pk)
+	assert.NoError(err)
+
+	// the flags are the low bits of the claimed evaluation
+	claimedValue := proof.ClaimedValue.BigInt(new(big.Int))
+
+	assignment := DecodeFlagsCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{
+		Point: emulated.ValueOf[sw_bn254.ScalarField](point),
+	}
+	assignment.VerifyingKey, err = ValueOfVerifyingKey[sw_bn254.G1Affine, sw_bn254.G2Affine](srs.Vk)
+	assert.NoError(err)
+	assignment.Commitment, err = ValueOfCommitment[sw_bn254.G1Affine](com)
+	assert.NoError(err)
+	assignment.Proof, err = ValueOfOpeningProof[sw_bn254.ScalarField, sw_bn254.G1Affine](proof)
+	assert.NoError(err)
+	for i := 0; i < testNbFlags; i++ {
+		assignment.ExpectedFlags[i] = claimedValue.Bit(i)
+	}
+
+	circuit := DecodeFlagsCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{}
+
+	assert.Run(func(assert *test.Assert) {
+		assert.NoError(test.IsSolved(&circuit, &assignment, ecc.BN254.ScalarField()))
+	}, "valid")
+
+	assert.Run(func(assert *test.Assert) {
+		// a flipped flag contradicts the decomposition of the opened value
+		badAssignment := assignment
+		badAssignment.ExpectedFlags[0] = 1 - claimedValue.Bit(0)
+		assert.Error(test.IsSolved(&circuit, &badAssignment, ecc.BN254.ScalarField()))
+	}, "flipped flag")
+}
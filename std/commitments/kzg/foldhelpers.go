@@ -0,0 +1,44 @@
+package kzg
+
+import (
+	"fmt"
+
+	"github.com/consensys/gnark/std/math/emulated"
+)
+
+// FoldCommitments computes the linear combination Σᵢ coeffs[i]·commitments[i]
+// as a standalone [Commitment], for higher-level verifiers that feed the same
+// folded commitment into several downstream checks instead of folding inside
+// a batch method such as [Verifier.BatchAssertSinglePoint]. With coefficients
+// that are powers of a random challenge the fold is binding by the usual
+// Schwartz-Zippel argument.
+func (v *Verifier[FR, G1El, G2El, GTEl]) FoldCommitments(commitments []Commitment[G1El], coeffs []emulated.Element[FR]) (Commitment[G1El], error) {
+	if len(commitments) != len(coeffs) {
+		return Commitment[G1El]{}, fmt.Errorf("number of commitments doesn't match number of coefficients")
+	}
+	if len(commitments) == 0 {
+		return Commitment[G1El]{}, fmt.Errorf("number of commitments should be nonzero")
+	}
+	folded := v.curve.ScalarMul(&commitments[0].G1El, &coeffs[0])
+	for i := 1; i < len(commitments); i++ {
+		folded = v.curve.Add(folded, v.curve.ScalarMul(&commitments[i].G1El, &coeffs[i]))
+	}
+	return Commitment[G1El]{G1El: *folded}, nil
+}
+
+// FoldClaimedValues computes the linear combination Σᵢ coeffs[i]·values[i],
+// the scalar-side counterpart of [Verifier.FoldCommitments]: by homomorphism
+// the folded commitment opens to the folded value.
+func (v *Verifier[FR, G1El, G2El, GTEl]) FoldClaimedValues(values []emulated.Element[FR], coeffs []emulated.Element[FR]) (*emulated.Element[FR], error) {
+	if len(values) != len(coeffs) {
+		return nil, fmt.Errorf("number of values doesn't match number of coefficients")
+	}
+	if len(values) == 0 {
+		return nil, fmt.Errorf("number of values should be nonzero")
+	}
+	folded := v.scalarApi.Mul(&values[0], &coeffs[0])
+	for i := 1; i < len(values); i++ {
+		folded = v.scalarApi.Add(folded, v.scalarApi.Mul(&values[i], &coeffs[i]))
+	}
+	return folded, nil
+}
@@ -0,0 +1,90 @@
+package kzg
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bn254"
+	fr_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/algebra"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_bn254"
+	"github.com/consensys/gnark/std/math/emulated"
+	"github.com/consensys/gnark/test"
+)
+
+const testNbFolded = 3
+
+type FoldHelpersCircuit[FR emulated.FieldParams, G1El algebra.G1ElementT, G2El algebra.G2ElementT, GTEl algebra.GtElementT] struct {
+	Commitments    [testNbFolded]Commitment[G1El]
+	Values         [testNbFolded]emulated.Element[FR]
+	Coeffs         [testNbFolded]emulated.Element[FR]
+	FoldedExpected Commitment[G1El]
+	ValueExpected  emulated.Element[FR]
+}
+
+func (c *FoldHelpersCircuit[FR, G1El, G2El, GTEl]) Define(api frontend.API) error {
+	verifier, err := NewVerifier[FR, G1El, G2El, GTEl](api)
+	if err != nil {
+		return fmt.Errorf("new verifier: %w", err)
+	}
+	folded, err := verifier.FoldCommitments(c.Commitments[:], c.Coeffs[:])
+	if err != nil {
+		return fmt.Errorf("fold commitments: %w", err)
+	}
+	verifier.curve.AssertIsEqual(&folded.G1El, &c.FoldedExpected.G1El)
+	foldedValue, err := verifier.FoldClaimedValues(c.Values[:], c.Coeffs[:])
+	if err != nil {
+		return fmt.Errorf("fold claimed values: %w", err)
+	}
+	verifier.scalarApi.AssertIsEqual(foldedValue, &c.ValueExpected)
+	return nil
+}
+
+func TestFoldHelpers(t *testing.T) {
+	assert := test.NewAssert(t)
+
+	_, _, g1Gen, _ := bn254.Generators()
+	assignment := FoldHelpersCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{}
+
+	// random commitments, values and coefficients with their native fold
+	var foldedPoint bn254.G1Affine
+	var foldedValue fr_bn254.Element
+	for i := 0; i < testNbFolded; i++ {
+		var k, coeff, value fr_bn254.Element
+		k.SetRandom()
+		coeff.SetRandom()
+		value.SetRandom()
+		var com, tmp bn254.G1Affine
+		com.ScalarMultiplication(&g1Gen, k.BigInt(new(big.Int)))
+		tmp.ScalarMultiplication(&com, coeff.BigInt(new(big.Int)))
+		foldedPoint.Add(&foldedPoint, &tmp)
+		var tmpValue fr_bn254.Element
+		tmpValue.Mul(&coeff, &value)
+		foldedValue.Add(&foldedValue, &tmpValue)
+		var err error
+		assignment.Commitments[i], err = ValueOfCommitment[sw_bn254.G1Affine](com)
+		assert.NoError(err)
+		assignment.Values[i] = emulated.ValueOf[sw_bn254.ScalarField](value)
+		assignment.Coeffs[i] = emulated.ValueOf[sw_bn254.ScalarField](coeff)
+	}
+	var err error
+	assignment.FoldedExpected, err = ValueOfCommitment[sw_bn254.G1Affine](foldedPoint)
+	assert.NoError(err)
+	assignment.ValueExpected = emulated.ValueOf[sw_bn254.ScalarField](foldedValue)
+
+	circuit := FoldHelpersCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{}
+
+	assert.Run(func(assert *test.Assert) {
+		assert.NoError(test.IsSolved(&circuit, &assignment, ecc.BN254.ScalarField()))
+	}, "valid")
+
+	assert.Run(func(assert *test.Assert) {
+		// a wrong folded commitment is rejected
+		badAssignment := assignment
+		badAssignment.FoldedExpected = badAssignment.Commitments[0]
+		assert.Error(test.IsSolved(&circuit, &badAssignment, ecc.BN254.ScalarField()))
+	}, "wrong fold")
+}
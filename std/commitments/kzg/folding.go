@@ -0,0 +1,43 @@
+package kzg
+
+import (
+	"fmt"
+
+	"github.com/consensys/gnark/std/math/emulated"
+)
+
+// AssertFoldedWithError verifies the opening of a folded commitment carrying a
+// scaled error term, as produced by Nova/ProtoStar-style accumulation over a
+// KZG commitment. It asserts that the commitment
+//
+//	C - r·E
+//
+// opens at point to proof.ClaimedValue - r·errorProof.ClaimedValue with
+// quotient proof.Quotient - r·errorProof.Quotient, where E is the commitment
+// to the cross/error term. The error contribution enters with a negative
+// sign, so the scaled error proof is subtracted from the accumulated one
+// before the single pairing check.
+func (v *Verifier[FR, G1El, G2El, GTEl]) AssertFoldedWithError(commitment Commitment[G1El], proof OpeningProof[FR, G1El], errorTerm Commitment[G1El], errorProof OpeningProof[FR, G1El], r, point emulated.Element[FR], vk VerifyingKey[G1El, G2El]) error {
+	// C - r·E
+	scaledError := v.curve.ScalarMul(&errorTerm.G1El, &r)
+	scaledError = v.curve.Neg(scaledError)
+	foldedCommitment := v.curve.Add(&commitment.G1El, scaledError)
+
+	// Q - r·Q_E
+	scaledQuotient := v.curve.ScalarMul(&errorProof.Quotient, &r)
+	scaledQuotient = v.curve.Neg(scaledQuotient)
+	foldedQuotient := v.curve.Add(&proof.Quotient, scaledQuotient)
+
+	// v - r·v_E
+	scaledValue := v.scalarApi.Mul(&r, &errorProof.ClaimedValue)
+	foldedValue := v.scalarApi.Sub(&proof.ClaimedValue, scaledValue)
+
+	folded := OpeningProof[FR, G1El]{
+		Quotient:     *foldedQuotient,
+		ClaimedValue: *foldedValue,
+	}
+	if err := v.CheckOpeningProof(Commitment[G1El]{G1El: *foldedCommitment}, folded, point, vk); err != nil {
+		return fmt.Errorf("folded opening: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,93 @@
+package kzg
+
+import (
+	"crypto/rand"
+	"fmt"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	fr_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	kzg_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/kzg"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/algebra"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_bn254"
+	"github.com/consensys/gnark/std/math/emulated"
+	"github.com/consensys/gnark/test"
+)
+
+type FoldedWithErrorCircuit[FR emulated.FieldParams, G1El algebra.G1ElementT, G2El algebra.G2ElementT, GTEl algebra.GtElementT] struct {
+	Vk         VerifyingKey[G1El, G2El]
+	Commitment Commitment[G1El]
+	Proof      OpeningProof[FR, G1El]
+	ErrorTerm  Commitment[G1El]
+	ErrorProof OpeningProof[FR, G1El]
+	R          emulated.Element[FR]
+	Point      emulated.Element[FR]
+}
+
+func (c *FoldedWithErrorCircuit[FR, G1El, G2El, GTEl]) Define(api frontend.API) error {
+	verifier, err := NewVerifier[FR, G1El, G2El, GTEl](api)
+	if err != nil {
+		return fmt.Errorf("new verifier: %w", err)
+	}
+	if err := verifier.AssertFoldedWithError(c.Commitment, c.Proof, c.ErrorTerm, c.ErrorProof, c.R, c.Point, c.Vk); err != nil {
+		return fmt.Errorf("assert folded with error: %w", err)
+	}
+	return nil
+}
+
+func TestFoldedWithError(t *testing.T) {
+	assert := test.NewAssert(t)
+
+	alpha, err := rand.Int(rand.Reader, ecc.BN254.ScalarField())
+	assert.NoError(err)
+	srs, err := kzg_bn254.NewSRS(kzgSize, alpha)
+	assert.NoError(err)
+
+	// accumulated polynomial and a nonzero cross/error term
+	f := make([]fr_bn254.Element, polynomialSize)
+	e := make([]fr_bn254.Element, polynomialSize)
+	for i := range f {
+		f[i].SetRandom()
+		e[i].SetRandom()
+	}
+	com, err := kzg_bn254.Commit(f, srs.Pk)
+	assert.NoError(err)
+	comError, err := kzg_bn254.Commit(e, srs.Pk)
+	assert.NoError(err)
+
+	var point, r fr_bn254.Element
+	point.SetRandom()
+	r.SetRandom()
+	proof, err := kzg_bn254.Open(f, point, srs.Pk)
+	assert.NoError(err)
+	proofError, err := kzg_bn254.Open(e, point, srs.Pk)
+	assert.NoError(err)
+
+	wVk, err := ValueOfVerifyingKey[sw_bn254.G1Affine, sw_bn254.G2Affine](srs.Vk)
+	assert.NoError(err)
+	wCom, err := ValueOfCommitment[sw_bn254.G1Affine](com)
+	assert.NoError(err)
+	wComError, err := ValueOfCommitment[sw_bn254.G1Affine](comError)
+	assert.NoError(err)
+	wProof, err := ValueOfOpeningProof[sw_bn254.ScalarField, sw_bn254.G1Affine](proof)
+	assert.NoError(err)
+	wProofError, err := ValueOfOpeningProof[sw_bn254.ScalarField, sw_bn254.G1Affine](proofError)
+	assert.NoError(err)
+	wR, err := ValueOfScalar[sw_bn254.ScalarField](r)
+	assert.NoError(err)
+	wPoint, err := ValueOfScalar[sw_bn254.ScalarField](point)
+	assert.NoError(err)
+
+	assignment := FoldedWithErrorCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{
+		Vk:         wVk,
+		Commitment: wCom,
+		Proof:      wProof,
+		ErrorTerm:  wComError,
+		ErrorProof: wProofError,
+		R:          wR,
+		Point:      wPoint,
+	}
+	err = test.IsSolved(&FoldedWithErrorCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{}, &assignment, ecc.BN254.ScalarField())
+	assert.NoError(err)
+}
@@ -0,0 +1,40 @@
+package kzg
+
+import (
+	"fmt"
+
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/hash"
+	"github.com/consensys/gnark/std/math/bits"
+)
+
+// AssertProofPointFromPublicInputs verifies the opening proof at the point
+// obtained by hashing the public inputs in-circuit. Non-interactive protocols
+// derive the evaluation point by Fiat-Shamir from the public inputs; by
+// recomputing the hash here the opening is bound to them and the prover
+// cannot pick a favourable point. The native digest is converted to an
+// emulated scalar bit by bit, so the external prover derives the point as
+// hasher(publicInputs) reduced into the native field.
+func (v *Verifier[FR, G1El, G2El, GTEl]) AssertProofPointFromPublicInputs(commitment Commitment[G1El], proof OpeningProof[FR, G1El], publicInputs []frontend.Variable, vk VerifyingKey[G1El, G2El], hasher hash.FieldHasher) error {
+	if len(publicInputs) == 0 {
+		return fmt.Errorf("number of public inputs should be nonzero")
+	}
+	hasher.Reset()
+	hasher.Write(publicInputs...)
+	digest := hasher.Sum()
+
+	var fr FR
+	nbBits := v.api.Compiler().FieldBitLen()
+	if fr.Modulus().BitLen() < nbBits {
+		// the digest may exceed the emulated modulus; reduction happens
+		// implicitly through FromBits only when the scalar field is at least
+		// as large as the native one
+		return fmt.Errorf("emulated scalar field smaller than native field")
+	}
+	bts := bits.ToBinary(v.api, digest, bits.WithNbDigits(nbBits))
+	point := v.scalarApi.FromBits(bts...)
+	if err := v.CheckOpeningProof(commitment, proof, *point, vk); err != nil {
+		return fmt.Errorf("check opening proof: %w", err)
+	}
+	return nil
+}
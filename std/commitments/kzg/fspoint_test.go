@@ -0,0 +1,95 @@
+package kzg
+
+import (
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	fr_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	kzg_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/kzg"
+	crypto_mimc "github.com/consensys/gnark-crypto/hash"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/algebra"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_bn254"
+	"github.com/consensys/gnark/std/hash/mimc"
+	"github.com/consensys/gnark/std/math/emulated"
+	"github.com/consensys/gnark/test"
+)
+
+type FSPointCircuit[FR emulated.FieldParams, G1El algebra.G1ElementT, G2El algebra.G2ElementT, GTEl algebra.GtElementT] struct {
+	VerifyingKey[G1El, G2El]
+	Commitment[G1El]
+	OpeningProof[FR, G1El]
+	PublicInputs [3]frontend.Variable `gnark:",public"`
+}
+
+func (c *FSPointCircuit[FR, G1El, G2El, GTEl]) Define(api frontend.API) error {
+	verifier, err := NewVerifier[FR, G1El, G2El, GTEl](api)
+	if err != nil {
+		return fmt.Errorf("new verifier: %w", err)
+	}
+	hasher, err := mimc.NewMiMC(api)
+	if err != nil {
+		return fmt.Errorf("new mimc: %w", err)
+	}
+	if err := verifier.AssertProofPointFromPublicInputs(c.Commitment, c.OpeningProof, c.PublicInputs[:], c.VerifyingKey, &hasher); err != nil {
+		return fmt.Errorf("assert proof point from public inputs: %w", err)
+	}
+	return nil
+}
+
+func TestAssertProofPointFromPublicInputs(t *testing.T) {
+	assert := test.NewAssert(t)
+
+	alpha, err := rand.Int(rand.Reader, ecc.BN254.ScalarField())
+	assert.NoError(err)
+	srs, err := kzg_bn254.NewSRS(kzgSize, alpha)
+	assert.NoError(err)
+
+	f := make([]fr_bn254.Element, polynomialSize)
+	for i := range f {
+		f[i].SetRandom()
+	}
+	com, err := kzg_bn254.Commit(f, srs.Pk)
+	assert.NoError(err)
+
+	// the evaluation point is the hash of the public inputs
+	publicInputs := [3]fr_bn254.Element{}
+	hasher := crypto_mimc.MIMC_BN254.New()
+	for i := range publicInputs {
+		publicInputs[i].SetRandom()
+		hasher.Write(publicInputs[i].Marshal())
+	}
+	var point fr_bn254.Element
+	point.SetBytes(hasher.Sum(nil))
+	proof, err := kzg_bn254.Open(f, point, srs.Pk)
+	assert.NoError(err)
+
+	wVk, err := ValueOfVerifyingKey[sw_bn254.G1Affine, sw_bn254.G2Affine](srs.Vk)
+	assert.NoError(err)
+	wCom, err := ValueOfCommitment[sw_bn254.G1Affine](com)
+	assert.NoError(err)
+	wProof, err := ValueOfOpeningProof[sw_bn254.ScalarField, sw_bn254.G1Affine](proof)
+	assert.NoError(err)
+
+	circuit := FSPointCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{}
+	assignment := FSPointCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{
+		VerifyingKey: wVk,
+		Commitment:   wCom,
+		OpeningProof: wProof,
+	}
+	for i := range publicInputs {
+		assignment.PublicInputs[i] = publicInputs[i].BigInt(new(big.Int))
+	}
+	assert.NoError(test.IsSolved(&circuit, &assignment, ecc.BN254.ScalarField()))
+
+	// altering a public input changes the derived point and invalidates the
+	// proof
+	badAssignment := assignment
+	var other fr_bn254.Element
+	other.SetRandom()
+	badAssignment.PublicInputs[1] = other.BigInt(new(big.Int))
+	assert.Error(test.IsSolved(&circuit, &badAssignment, ecc.BN254.ScalarField()))
+}
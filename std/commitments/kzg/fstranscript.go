@@ -0,0 +1,132 @@
+package kzg
+
+import (
+	"fmt"
+
+	"github.com/consensys/gnark/std/algebra"
+	fiatshamir "github.com/consensys/gnark/std/fiat-shamir"
+	"github.com/consensys/gnark/std/math/bits"
+	"github.com/consensys/gnark/std/math/emulated"
+	"github.com/consensys/gnark/std/recursion"
+)
+
+// Transcript is a Fiat-Shamir transcript for deriving batching challenges
+// in-circuit. Implementations absorb the elements in the order the Append
+// methods are called and must match the transcript the native prover used:
+// [Verifier.NewTranscript] returns an implementation over the wrapped
+// recursion hash which is compatible with gnark-crypto's fiat-shamir package
+// when the native side uses the hash returned by [recursion.NewShort] and
+// binds the gnark-crypto serialization of the same elements in the same
+// order.
+type Transcript[FR emulated.FieldParams, G1El algebra.G1ElementT] interface {
+	// AppendCommitment absorbs the commitment as its marshalled G1
+	// coordinates X || Y, matching gnark-crypto's Marshal method.
+	AppendCommitment(Commitment[G1El]) error
+
+	// AppendScalar absorbs the scalar as its big-endian canonical bytes,
+	// matching gnark-crypto's Marshal method on scalar field elements.
+	AppendScalar(emulated.Element[FR]) error
+
+	// ChallengeScalar squeezes the challenge bound to all absorbed elements
+	// as an emulated scalar.
+	ChallengeScalar() (*emulated.Element[FR], error)
+}
+
+// NewTranscript returns a [Transcript] deriving the challenge with the given
+// identifier over the wrapped recursion hash (see [recursion.NewHash]). The
+// challenge can be reproduced natively with a gnark-crypto Fiat-Shamir
+// transcript over the hash returned by [recursion.NewShort].
+func (v *Verifier[FR, G1El, G2El, GTEl]) NewTranscript(challengeID string) (Transcript[FR, G1El], error) {
+	var fr FR
+	fs, err := recursion.NewTranscript(v.api, fr.Modulus(), []string{challengeID})
+	if err != nil {
+		return nil, fmt.Errorf("new transcript: %w", err)
+	}
+	return &hashTranscript[FR, G1El, G2El, GTEl]{v: v, fs: fs, challengeID: challengeID}, nil
+}
+
+type hashTranscript[FR emulated.FieldParams, G1El algebra.G1ElementT, G2El algebra.G2ElementT, GTEl algebra.GtElementT] struct {
+	v           *Verifier[FR, G1El, G2El, GTEl]
+	fs          *fiatshamir.Transcript
+	challengeID string
+}
+
+func (t *hashTranscript[FR, G1El, G2El, GTEl]) AppendCommitment(commitment Commitment[G1El]) error {
+	if err := t.fs.Bind(t.challengeID, t.v.curve.MarshalG1(commitment.G1El)); err != nil {
+		return fmt.Errorf("bind commitment: %w", err)
+	}
+	return nil
+}
+
+func (t *hashTranscript[FR, G1El, G2El, GTEl]) AppendScalar(scalar emulated.Element[FR]) error {
+	if err := t.fs.Bind(t.challengeID, t.v.curve.MarshalScalar(scalar)); err != nil {
+		return fmt.Errorf("bind scalar: %w", err)
+	}
+	return nil
+}
+
+func (t *hashTranscript[FR, G1El, G2El, GTEl]) ChallengeScalar() (*emulated.Element[FR], error) {
+	var fr FR
+	challenge, err := t.fs.ComputeChallenge(t.challengeID)
+	if err != nil {
+		return nil, fmt.Errorf("compute challenge: %w", err)
+	}
+	bChallenge := bits.ToBinary(t.v.api, challenge, bits.WithNbDigits(fr.Modulus().BitLen()))
+	return t.v.scalarApi.FromBits(bChallenge...), nil
+}
+
+// BatchVerifySinglePointWithTranscript is as
+// [Verifier.BatchVerifySinglePoint], but derives the folding challenge from
+// the caller-provided transcript instead of an internal one, so the
+// absorption can be shared with an enclosing protocol. The method absorbs, in
+// order, each commitment ([Transcript.AppendCommitment]), each claimed value
+// and finally the point ([Transcript.AppendScalar]), and then squeezes the
+// folding challenge. To batch soundly, the native prover must derive its
+// folding challenge with the same transcript state and absorption order.
+func (v *Verifier[FR, G1El, G2El, GTEl]) BatchVerifySinglePointWithTranscript(digests []Commitment[G1El], batchOpeningProof BatchOpeningProof[FR, G1El], point emulated.Element[FR], vk VerifyingKey[G1El, G2El], transcript Transcript[FR, G1El]) error {
+	if len(digests) != len(batchOpeningProof.ClaimedValues) {
+		return fmt.Errorf("length mismatch for digests and claimed values")
+	}
+	if len(digests) == 0 {
+		return fmt.Errorf("number of digests should be nonzero")
+	}
+	for i := range digests {
+		if err := transcript.AppendCommitment(digests[i]); err != nil {
+			return fmt.Errorf("append %d-th commitment: %w", i, err)
+		}
+	}
+	for i := range batchOpeningProof.ClaimedValues {
+		if err := transcript.AppendScalar(batchOpeningProof.ClaimedValues[i]); err != nil {
+			return fmt.Errorf("append %d-th claimed value: %w", i, err)
+		}
+	}
+	if err := transcript.AppendScalar(point); err != nil {
+		return fmt.Errorf("append point: %w", err)
+	}
+	gamma, err := transcript.ChallengeScalar()
+	if err != nil {
+		return fmt.Errorf("challenge scalar: %w", err)
+	}
+
+	// gammai = [1,γ,γ²,..,γⁿ⁻¹]
+	gammai := make([]*emulated.Element[FR], len(digests))
+	gammai[0] = v.scalarApi.One()
+	if len(digests) > 1 {
+		gammai[1] = gamma
+	}
+	for i := 2; i < len(digests); i++ {
+		gammai[i] = v.scalarApi.Mul(gammai[i-1], gamma)
+	}
+	foldedDigest, foldedEvaluations, err := v.fold(digests, batchOpeningProof.ClaimedValues, gammai)
+	if err != nil {
+		return fmt.Errorf("fold: %w", err)
+	}
+	foldedProof := OpeningProof[FR, G1El]{
+		Quotient:     batchOpeningProof.Quotient,
+		ClaimedValue: *foldedEvaluations,
+	}
+	if err := v.CheckOpeningProof(foldedDigest, foldedProof, point, vk); err != nil {
+		return fmt.Errorf("check opening proof: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,156 @@
+package kzg
+
+import (
+	"crypto/rand"
+	"fmt"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	fr_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	kzg_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/kzg"
+	cryptofs "github.com/consensys/gnark-crypto/fiat-shamir"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/algebra"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_bn254"
+	"github.com/consensys/gnark/std/math/emulated"
+	"github.com/consensys/gnark/std/recursion"
+	"github.com/consensys/gnark/test"
+)
+
+const testNbTranscriptProofs = 3
+
+type TranscriptBatchCircuit[FR emulated.FieldParams, G1El algebra.G1ElementT, G2El algebra.G2ElementT, GTEl algebra.GtElementT] struct {
+	VerifyingKey[G1El, G2El]
+	Digests       [testNbTranscriptProofs]Commitment[G1El]
+	BatchProof    BatchOpeningProof[FR, G1El]
+	Point         emulated.Element[FR]
+	ExpectedGamma emulated.Element[FR]
+}
+
+func (c *TranscriptBatchCircuit[FR, G1El, G2El, GTEl]) Define(api frontend.API) error {
+	verifier, err := NewVerifier[FR, G1El, G2El, GTEl](api)
+	if err != nil {
+		return fmt.Errorf("new verifier: %w", err)
+	}
+	// cross-check the in-circuit challenge against the natively computed one
+	crossCheck, err := verifier.NewTranscript("gamma")
+	if err != nil {
+		return fmt.Errorf("new transcript: %w", err)
+	}
+	for i := range c.Digests {
+		if err := crossCheck.AppendCommitment(c.Digests[i]); err != nil {
+			return fmt.Errorf("append commitment: %w", err)
+		}
+	}
+	for i := range c.BatchProof.ClaimedValues {
+		if err := crossCheck.AppendScalar(c.BatchProof.ClaimedValues[i]); err != nil {
+			return fmt.Errorf("append claimed value: %w", err)
+		}
+	}
+	if err := crossCheck.AppendScalar(c.Point); err != nil {
+		return fmt.Errorf("append point: %w", err)
+	}
+	gamma, err := crossCheck.ChallengeScalar()
+	if err != nil {
+		return fmt.Errorf("challenge scalar: %w", err)
+	}
+	verifier.scalarApi.AssertIsEqual(gamma, &c.ExpectedGamma)
+
+	// and verify the batch with a fresh transcript in the same state
+	transcript, err := verifier.NewTranscript("gamma")
+	if err != nil {
+		return fmt.Errorf("new transcript: %w", err)
+	}
+	return verifier.BatchVerifySinglePointWithTranscript(c.Digests[:], c.BatchProof, c.Point, c.VerifyingKey, transcript)
+}
+
+func TestBatchVerifySinglePointWithTranscript(t *testing.T) {
+	assert := test.NewAssert(t)
+
+	alpha, err := rand.Int(rand.Reader, ecc.BN254.ScalarField())
+	assert.NoError(err)
+	srs, err := kzg_bn254.NewSRS(kzgSize, alpha)
+	assert.NoError(err)
+
+	var point fr_bn254.Element
+	point.SetRandom()
+
+	polys := make([][]fr_bn254.Element, testNbTranscriptProofs)
+	coms := make([]kzg_bn254.Digest, testNbTranscriptProofs)
+	claimedValues := make([]fr_bn254.Element, testNbTranscriptProofs)
+	for i := range polys {
+		polys[i] = make([]fr_bn254.Element, polynomialSize)
+		for j := range polys[i] {
+			polys[i][j].SetRandom()
+		}
+		coms[i], err = kzg_bn254.Commit(polys[i], srs.Pk)
+		assert.NoError(err)
+		claimedValues[i] = evalPolynomial(polys[i], point)
+	}
+
+	// the native transcript binds the same elements in the same order as the
+	// circuit: commitments, claimed values, point
+	h, err := recursion.NewShort(ecc.BN254.ScalarField(), ecc.BN254.ScalarField())
+	assert.NoError(err)
+	fs := cryptofs.NewTranscript(h, "gamma")
+	for i := range coms {
+		assert.NoError(fs.Bind("gamma", coms[i].Marshal()))
+	}
+	for i := range claimedValues {
+		assert.NoError(fs.Bind("gamma", claimedValues[i].Marshal()))
+	}
+	assert.NoError(fs.Bind("gamma", point.Marshal()))
+	gammaBytes, err := fs.ComputeChallenge("gamma")
+	assert.NoError(err)
+	var gamma fr_bn254.Element
+	gamma.SetBytes(gammaBytes)
+
+	// fold the polynomials with the transcript challenge and open the fold
+	foldedPoly := make([]fr_bn254.Element, polynomialSize)
+	var gammaPow, tmp fr_bn254.Element
+	gammaPow.SetOne()
+	for i := range polys {
+		for j := range polys[i] {
+			tmp.Mul(&polys[i][j], &gammaPow)
+			foldedPoly[j].Add(&foldedPoly[j], &tmp)
+		}
+		gammaPow.Mul(&gammaPow, &gamma)
+	}
+	foldedProof, err := kzg_bn254.Open(foldedPoly, point, srs.Pk)
+	assert.NoError(err)
+	batchProof := kzg_bn254.BatchOpeningProof{
+		H:             foldedProof.H,
+		ClaimedValues: claimedValues,
+	}
+
+	assignment := TranscriptBatchCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{
+		Point:         emulated.ValueOf[sw_bn254.ScalarField](point),
+		ExpectedGamma: emulated.ValueOf[sw_bn254.ScalarField](gamma),
+	}
+	assignment.VerifyingKey, err = ValueOfVerifyingKey[sw_bn254.G1Affine, sw_bn254.G2Affine](srs.Vk)
+	assert.NoError(err)
+	for i := range coms {
+		assignment.Digests[i], err = ValueOfCommitment[sw_bn254.G1Affine](coms[i])
+		assert.NoError(err)
+	}
+	assignment.BatchProof, err = ValueOfBatchOpeningProof[sw_bn254.ScalarField, sw_bn254.G1Affine](batchProof)
+	assert.NoError(err)
+
+	circuit := TranscriptBatchCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{
+		BatchProof: BatchOpeningProof[sw_bn254.ScalarField, sw_bn254.G1Affine]{
+			ClaimedValues: make([]emulated.Element[sw_bn254.ScalarField], testNbTranscriptProofs),
+		},
+	}
+
+	assert.Run(func(assert *test.Assert) {
+		assert.NoError(test.IsSolved(&circuit, &assignment, ecc.BN254.ScalarField()))
+	}, "valid")
+
+	assert.Run(func(assert *test.Assert) {
+		// a claimed value not matching the transcript breaks both the
+		// cross-check and the fold
+		badAssignment := assignment
+		badAssignment.ExpectedGamma = emulated.ValueOf[sw_bn254.ScalarField](point)
+		assert.Error(test.IsSolved(&circuit, &badAssignment, ecc.BN254.ScalarField()))
+	}, "wrong challenge")
+}
@@ -0,0 +1,70 @@
+package kzg
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/consensys/gnark/std/math/emulated"
+)
+
+// AssertFullEvaluation asserts that the committed polynomial evaluates to
+// evals over the whole domain, using a single aggregated opening proof. This
+// is the KZG multi-reveal used by data-availability schemes: with I the
+// polynomial interpolating evals over the domain and Z = Xⁿ-1 the vanishing
+// polynomial of the domain, a valid commitment satisfies f - I = Z·q and the
+// proof carries [q(α)]G₁ in its quotient (the claimed value is unused). The
+// check is
+//
+//	e(C - [I(α)]G₁, G₂) == e([q(α)]G₁, [Z(α)]G₂)
+//
+// where [I(α)]G₁ is recomputed in-circuit by interpolating the evaluations
+// and committing with the SRS G1 powers. vanishingG2 is [αⁿ-1]G₂; like the
+// SRS itself it must come from the trusted ceremony transcript.
+//
+// The constraint cost is dominated by the n·log(n) emulated multiplications
+// of the interpolation and the n-point MSM, so as for
+// [Verifier.AssertLowDegree] the check is only feasible for domains up to
+// [maxDomainSize].
+func (v *Verifier[FR, G1El, G2El, GTEl]) AssertFullEvaluation(commitment Commitment[G1El], evals []emulated.Element[FR], proof OpeningProof[FR, G1El], domain *Domain, srs FullSRS[G1El, G2El], vanishingG2 G2El) error {
+	n := len(evals)
+	if uint64(n) != domain.Size {
+		return fmt.Errorf("number of evaluations %d doesn't match domain size %d", n, domain.Size)
+	}
+	if domain.Size > maxDomainSize {
+		return fmt.Errorf("domain size %d exceeds maximum %d", domain.Size, maxDomainSize)
+	}
+	if len(srs.G1Powers) < n {
+		return fmt.Errorf("not enough SRS powers for %d coefficients", n)
+	}
+	var fr FR
+	mod := fr.Modulus()
+
+	// [I(α)]G₁ from the interpolated coefficients
+	ptrs := make([]*emulated.Element[FR], n)
+	for i := range evals {
+		ptrs[i] = &evals[i]
+	}
+	coeffs := v.fft(ptrs, new(big.Int).Set(domain.GeneratorInv), mod)
+	cardInv := emulated.ValueOf[FR](domain.CardinalityInv)
+	for i := range coeffs {
+		coeffs[i] = v.scalarApi.Mul(coeffs[i], &cardInv)
+	}
+	points := make([]*G1El, n)
+	for i := range points {
+		points[i] = &srs.G1Powers[i]
+	}
+	interpolated, err := v.curve.MultiScalarMul(points, coeffs)
+	if err != nil {
+		return fmt.Errorf("commit interpolation: %w", err)
+	}
+
+	// e(C - [I(α)]G₁, G₂)·e(-[q(α)]G₁, [Z(α)]G₂) == 1
+	totalG1 := v.curve.Add(&commitment.G1El, v.curve.Neg(interpolated))
+	if err := v.pairing.PairingCheck(
+		[]*G1El{totalG1, v.curve.Neg(&proof.Quotient)},
+		[]*G2El{&srs.G2[0], &vanishingG2},
+	); err != nil {
+		return fmt.Errorf("pairing check: %w", err)
+	}
+	return nil
+}
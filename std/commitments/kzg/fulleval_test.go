@@ -0,0 +1,132 @@
+package kzg
+
+import (
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bn254"
+	fr_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr/fft"
+	kzg_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/kzg"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/algebra"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_bn254"
+	"github.com/consensys/gnark/std/math/emulated"
+	"github.com/consensys/gnark/test"
+)
+
+type FullEvaluationCircuit[FR emulated.FieldParams, G1El algebra.G1ElementT, G2El algebra.G2ElementT, GTEl algebra.GtElementT] struct {
+	Srs         FullSRS[G1El, G2El]
+	Commitment  Commitment[G1El]
+	Evals       [16]emulated.Element[FR]
+	Proof       OpeningProof[FR, G1El]
+	VanishingG2 G2El
+	domain      *Domain
+}
+
+func (c *FullEvaluationCircuit[FR, G1El, G2El, GTEl]) Define(api frontend.API) error {
+	verifier, err := NewVerifier[FR, G1El, G2El, GTEl](api)
+	if err != nil {
+		return fmt.Errorf("new verifier: %w", err)
+	}
+	if err := verifier.AssertFullEvaluation(c.Commitment, c.Evals[:], c.Proof, c.domain, c.Srs, c.VanishingG2); err != nil {
+		return fmt.Errorf("assert full evaluation: %w", err)
+	}
+	return nil
+}
+
+func TestAssertFullEvaluation(t *testing.T) {
+	assert := test.NewAssert(t)
+	const domainSize = 16
+	// the committed polynomial exceeds the domain degree so the quotient by
+	// the vanishing polynomial is nonzero
+	const degree = 24
+
+	alpha, err := rand.Int(rand.Reader, ecc.BN254.ScalarField())
+	assert.NoError(err)
+	srs, err := kzg_bn254.NewSRS(degree+1, alpha)
+	assert.NoError(err)
+
+	f := make([]fr_bn254.Element, degree+1)
+	for i := range f {
+		f[i].SetRandom()
+	}
+	com, err := kzg_bn254.Commit(f, srs.Pk)
+	assert.NoError(err)
+
+	// evaluate f over the domain
+	domain := fft.NewDomain(domainSize)
+	evals := make([]fr_bn254.Element, domainSize)
+	var omega fr_bn254.Element
+	omega.SetOne()
+	for i := range evals {
+		evals[i] = evalPolynomial(f, omega)
+		omega.Mul(&omega, &domain.Generator)
+	}
+
+	// I interpolates the evaluations; q = (f - I)/(Xⁿ - 1)
+	interp := make([]fr_bn254.Element, domainSize)
+	copy(interp, evals)
+	domain.FFTInverse(interp, fft.DIF)
+	fft.BitReverse(interp)
+	remainder := make([]fr_bn254.Element, degree+1)
+	copy(remainder, f)
+	for i := range interp {
+		remainder[i].Sub(&remainder[i], &interp[i])
+	}
+	q := make([]fr_bn254.Element, degree+1-domainSize)
+	for i := degree; i >= domainSize; i-- {
+		q[i-domainSize].Add(&q[i-domainSize], &remainder[i])
+		remainder[i-domainSize].Add(&remainder[i-domainSize], &remainder[i])
+		remainder[i].SetZero()
+	}
+	for i := 0; i < domainSize; i++ {
+		assert.True(remainder[i].IsZero(), "division by vanishing polynomial not exact")
+	}
+	quotientCom, err := kzg_bn254.Commit(q, srs.Pk)
+	assert.NoError(err)
+
+	// [αⁿ - 1]G₂ from the ceremony secret (known in the test)
+	zAlpha := new(big.Int).Exp(alpha, big.NewInt(domainSize), ecc.BN254.ScalarField())
+	zAlpha.Sub(zAlpha, big.NewInt(1)).Mod(zAlpha, ecc.BN254.ScalarField())
+	var vanishingG2 bn254.G2Affine
+	vanishingG2.ScalarMultiplication(&srs.Vk.G2[0], zAlpha)
+
+	wSrs, err := ValueOfFullSRS[sw_bn254.G1Affine, sw_bn254.G2Affine](srs)
+	assert.NoError(err)
+	wCom, err := ValueOfCommitment[sw_bn254.G1Affine](com)
+	assert.NoError(err)
+	wDomain, err := NewDomain(domainSize, domain.GeneratorInv.BigInt(new(big.Int)), domain.CardinalityInv.BigInt(new(big.Int)))
+	assert.NoError(err)
+
+	circuit := FullEvaluationCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{
+		domain: wDomain,
+	}
+	circuit.Srs.G1Powers = make([]sw_bn254.G1Affine, degree+1)
+	assignment := FullEvaluationCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{
+		Srs:         wSrs,
+		Commitment:  wCom,
+		VanishingG2: sw_bn254.NewG2Affine(vanishingG2),
+	}
+	assignment.Proof.Quotient = sw_bn254.NewG1Affine(quotientCom)
+	// the claimed value is unused by the full-evaluation check
+	assignment.Proof.ClaimedValue = emulated.ValueOf[sw_bn254.ScalarField](0)
+	for i := range evals {
+		assignment.Evals[i], err = ValueOfScalar[sw_bn254.ScalarField](evals[i])
+		assert.NoError(err)
+	}
+	assert.NoError(test.IsSolved(&circuit, &assignment, ecc.BN254.ScalarField()))
+
+	// a tampered evaluation does not verify
+	badAssignment := assignment
+	var one fr_bn254.Element
+	one.SetOne()
+	var badEval fr_bn254.Element
+	badEval.Add(&evals[3], &one)
+	badAssignment.Evals[3], err = ValueOfScalar[sw_bn254.ScalarField](badEval)
+	assert.NoError(err)
+	assert.Error(test.IsSolved(&circuit, &badAssignment, ecc.BN254.ScalarField()))
+}
@@ -0,0 +1,32 @@
+package kzg
+
+import (
+	"fmt"
+
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/math/bits"
+	"github.com/consensys/gnark/std/math/emulated"
+)
+
+// AssertProofWithGKRValue verifies the opening proof with the claimed value
+// constrained equal to gkrValue, a native variable typically exported from a
+// [github.com/consensys/gnark/std/gkr] solution. This composes a GKR-computed
+// evaluation with the KZG opening in one circuit: the GKR sub-protocol proves
+// how the value was computed and the opening proves it is the committed
+// polynomial's evaluation at point. The native value is decomposed to bits and
+// recomposed in the scalar field, which requires the scalar field to be at
+// least as large as the native one.
+func (v *Verifier[FR, G1El, G2El, GTEl]) AssertProofWithGKRValue(commitment Commitment[G1El], proof OpeningProof[FR, G1El], point emulated.Element[FR], gkrValue frontend.Variable, vk VerifyingKey[G1El, G2El]) error {
+	var fr FR
+	nbBits := v.api.Compiler().FieldBitLen()
+	if fr.Modulus().BitLen() < nbBits {
+		return fmt.Errorf("emulated scalar field smaller than native field")
+	}
+	bts := bits.ToBinary(v.api, gkrValue, bits.WithNbDigits(nbBits))
+	value := v.scalarApi.FromBits(bts...)
+	v.scalarApi.AssertIsEqual(value, &proof.ClaimedValue)
+	if err := v.CheckOpeningProof(commitment, proof, point, vk); err != nil {
+		return fmt.Errorf("check opening proof: %w", err)
+	}
+	return nil
+}
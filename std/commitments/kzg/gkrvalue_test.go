@@ -0,0 +1,132 @@
+package kzg
+
+import (
+	"crypto/rand"
+	"fmt"
+	"hash"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	fr_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	mimc_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/fr/mimc"
+	kzg_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/kzg"
+	cs_bn254 "github.com/consensys/gnark/constraint/bn254"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/algebra"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_bn254"
+	stdgkr "github.com/consensys/gnark/std/gkr"
+	stdhash "github.com/consensys/gnark/std/hash"
+	"github.com/consensys/gnark/std/hash/mimc"
+	"github.com/consensys/gnark/std/math/emulated"
+	"github.com/consensys/gnark/test"
+)
+
+const testNbGKRInstances = 2
+
+type GKRValueCircuit[FR emulated.FieldParams, G1El algebra.G1ElementT, G2El algebra.G2ElementT, GTEl algebra.GtElementT] struct {
+	VerifyingKey[G1El, G2El]
+	Commitment[G1El]
+	OpeningProof[FR, G1El]
+	Point emulated.Element[FR]
+	X     [testNbGKRInstances]frontend.Variable
+}
+
+func (c *GKRValueCircuit[FR, G1El, G2El, GTEl]) Define(api frontend.API) error {
+	gkrApi := stdgkr.NewApi()
+	x, err := gkrApi.Import(c.X[:])
+	if err != nil {
+		return fmt.Errorf("import instances: %w", err)
+	}
+	z := gkrApi.Mul(x, x)
+	solution, err := gkrApi.Solve(api)
+	if err != nil {
+		return fmt.Errorf("gkr solve: %w", err)
+	}
+	Z := solution.Export(z)
+
+	verifier, err := NewVerifier[FR, G1El, G2El, GTEl](api)
+	if err != nil {
+		return fmt.Errorf("new verifier: %w", err)
+	}
+	// the first GKR output instance drives the claimed value
+	if err := verifier.AssertProofWithGKRValue(c.Commitment, c.OpeningProof, c.Point, Z[0], c.VerifyingKey); err != nil {
+		return fmt.Errorf("assert proof with gkr value: %w", err)
+	}
+
+	challenge, err := api.(frontend.Committer).Commit(Z...)
+	if err != nil {
+		return fmt.Errorf("commit outputs: %w", err)
+	}
+	return solution.Verify("mimc", challenge)
+}
+
+func TestAssertProofWithGKRValue(t *testing.T) {
+	assert := test.NewAssert(t)
+
+	cs_bn254.RegisterHashBuilder("mimc", func() hash.Hash {
+		return mimc_bn254.NewMiMC()
+	})
+	stdhash.Register("mimc", func(api frontend.API) (stdhash.FieldHasher, error) {
+		m, err := mimc.NewMiMC(api)
+		return &m, err
+	})
+
+	alpha, err := rand.Int(rand.Reader, ecc.BN254.ScalarField())
+	assert.NoError(err)
+	srs, err := kzg_bn254.NewSRS(kzgSize, alpha)
+	assert.NoError(err)
+
+	var xs [testNbGKRInstances]fr_bn254.Element
+	for i := range xs {
+		xs[i].SetRandom()
+	}
+	var claimed fr_bn254.Element
+	claimed.Square(&xs[0])
+
+	f := make([]fr_bn254.Element, polynomialSize)
+	for i := range f {
+		f[i].SetRandom()
+	}
+	var point fr_bn254.Element
+	point.SetRandom()
+	// shift the constant term so that f(point) is the squared GKR input
+	var eval fr_bn254.Element
+	for i := len(f) - 1; i >= 0; i-- {
+		eval.Mul(&eval, &point).Add(&eval, &f[i])
+	}
+	f[0].Sub(&f[0], &eval).Add(&f[0], &claimed)
+
+	com, err := kzg_bn254.Commit(f, srs.Pk)
+	assert.NoError(err)
+	proof, err := kzg_bn254.Open(f, point, srs.Pk)
+	assert.NoError(err)
+	assert.True(proof.ClaimedValue.Equal(&claimed))
+
+	wVk, err := ValueOfVerifyingKey[sw_bn254.G1Affine, sw_bn254.G2Affine](srs.Vk)
+	assert.NoError(err)
+	wCom, err := ValueOfCommitment[sw_bn254.G1Affine](com)
+	assert.NoError(err)
+	wProof, err := ValueOfOpeningProof[sw_bn254.ScalarField, sw_bn254.G1Affine](proof)
+	assert.NoError(err)
+	wPoint, err := ValueOfScalar[sw_bn254.ScalarField](point)
+	assert.NoError(err)
+
+	circuit := GKRValueCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{}
+	assignment := GKRValueCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{
+		VerifyingKey: wVk,
+		Commitment:   wCom,
+		OpeningProof: wProof,
+		Point:        wPoint,
+	}
+	for i := range xs {
+		assignment.X[i] = xs[i]
+	}
+	assert.NoError(test.IsSolved(&circuit, &assignment, ecc.BN254.ScalarField()))
+
+	// a different GKR input squares to a value that doesn't match the opening
+	badAssignment := assignment
+	var badX fr_bn254.Element
+	badX.SetOne().Add(&badX, &xs[0])
+	badAssignment.X[0] = badX
+	assert.Error(test.IsSolved(&circuit, &badAssignment, ecc.BN254.ScalarField()))
+}
@@ -0,0 +1,37 @@
+package kzg
+
+import (
+	"fmt"
+
+	"github.com/consensys/gnark/std/math/emulated"
+	stdgroth16 "github.com/consensys/gnark/std/recursion/groth16"
+)
+
+// AssertProofWithGroth16Value verifies the opening proof and that the claimed
+// value was computed correctly off-circuit, as attested by a recursively
+// verified Groth16 proof. The first public input of the inner witness is
+// constrained to equal the claimed value, so the inner circuit must expose
+// the computed evaluation as its leading public input. This composes the two
+// recursion subsystems: the inner statement vouches for how the value was
+// obtained, the opening proof for its consistency with the commitment.
+//
+// The inner proof must be produced over the curve matching the type
+// parameters and with the recursion-friendly options of
+// [github.com/consensys/gnark/std/recursion/groth16].
+func (v *Verifier[FR, G1El, G2El, GTEl]) AssertProofWithGroth16Value(commitment Commitment[G1El], proof OpeningProof[FR, G1El], point emulated.Element[FR], groth16Proof stdgroth16.Proof[G1El, G2El], groth16VK stdgroth16.VerifyingKey[G1El, G2El, GTEl], groth16Witness stdgroth16.Witness[FR], vk VerifyingKey[G1El, G2El]) error {
+	if len(groth16Witness.Public) == 0 {
+		return fmt.Errorf("inner witness has no public inputs")
+	}
+	g16, err := stdgroth16.NewVerifier[FR, G1El, G2El, GTEl](v.api)
+	if err != nil {
+		return fmt.Errorf("new groth16 verifier: %w", err)
+	}
+	if err := g16.AssertProof(groth16VK, groth16Proof, groth16Witness); err != nil {
+		return fmt.Errorf("assert groth16 proof: %w", err)
+	}
+	v.scalarApi.AssertIsEqual(&groth16Witness.Public[0], &proof.ClaimedValue)
+	if err := v.CheckOpeningProof(commitment, proof, point, vk); err != nil {
+		return fmt.Errorf("check opening proof: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,135 @@
+package kzg
+
+import (
+	"crypto/rand"
+	"fmt"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	fr_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	kzg_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/kzg"
+	"github.com/consensys/gnark/backend/groth16"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/frontend/cs/r1cs"
+	"github.com/consensys/gnark/std/algebra"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_bn254"
+	"github.com/consensys/gnark/std/math/emulated"
+	stdgroth16 "github.com/consensys/gnark/std/recursion/groth16"
+	"github.com/consensys/gnark/test"
+)
+
+// innerEvalCircuit stands in for the off-circuit computation of the claimed
+// value: it exposes the evaluation as its first public input and attests a
+// factorization of it.
+type innerEvalCircuit struct {
+	Value frontend.Variable `gnark:",public"`
+	P, Q  frontend.Variable
+}
+
+func (c *innerEvalCircuit) Define(api frontend.API) error {
+	api.AssertIsEqual(api.Mul(c.P, c.Q), c.Value)
+	return nil
+}
+
+type Groth16ValueCircuit[FR emulated.FieldParams, G1El algebra.G1ElementT, G2El algebra.G2ElementT, GTEl algebra.GtElementT] struct {
+	VerifyingKey[G1El, G2El]
+	Commitment[G1El]
+	OpeningProof[FR, G1El]
+	Point        emulated.Element[FR]
+	InnerProof   stdgroth16.Proof[G1El, G2El]
+	InnerVK      stdgroth16.VerifyingKey[G1El, G2El, GTEl]
+	InnerWitness stdgroth16.Witness[FR]
+}
+
+func (c *Groth16ValueCircuit[FR, G1El, G2El, GTEl]) Define(api frontend.API) error {
+	verifier, err := NewVerifier[FR, G1El, G2El, GTEl](api)
+	if err != nil {
+		return fmt.Errorf("new verifier: %w", err)
+	}
+	return verifier.AssertProofWithGroth16Value(c.Commitment, c.OpeningProof, c.Point, c.InnerProof, c.InnerVK, c.InnerWitness, c.VerifyingKey)
+}
+
+func TestAssertProofWithGroth16Value(t *testing.T) {
+	assert := test.NewAssert(t)
+
+	alpha, err := rand.Int(rand.Reader, ecc.BN254.ScalarField())
+	assert.NoError(err)
+	srs, err := kzg_bn254.NewSRS(kzgSize, alpha)
+	assert.NoError(err)
+	f := make([]fr_bn254.Element, polynomialSize)
+	for i := range f {
+		f[i].SetRandom()
+	}
+	com, err := kzg_bn254.Commit(f, srs.Pk)
+	assert.NoError(err)
+	var point fr_bn254.Element
+	point.SetRandom()
+	proof, err := kzg_bn254.Open(f, point, srs.Pk)
+	assert.NoError(err)
+
+	// inner Groth16 proof attesting the claimed value
+	innerCcs, err := frontend.Compile(ecc.BN254.ScalarField(), r1cs.NewBuilder, &innerEvalCircuit{})
+	assert.NoError(err)
+	innerPK, innerVK, err := groth16.Setup(innerCcs)
+	assert.NoError(err)
+	innerAssignment := &innerEvalCircuit{
+		Value: proof.ClaimedValue,
+		P:     proof.ClaimedValue,
+		Q:     1,
+	}
+	innerWitness, err := frontend.NewWitness(innerAssignment, ecc.BN254.ScalarField())
+	assert.NoError(err)
+	innerProof, err := groth16.Prove(innerCcs, innerPK, innerWitness)
+	assert.NoError(err)
+	innerPubWitness, err := innerWitness.Public()
+	assert.NoError(err)
+
+	circuit := Groth16ValueCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{
+		InnerProof:   stdgroth16.PlaceholderProof[sw_bn254.G1Affine, sw_bn254.G2Affine](innerCcs),
+		InnerVK:      stdgroth16.PlaceholderVerifyingKey[sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl](innerCcs),
+		InnerWitness: stdgroth16.PlaceholderWitness[sw_bn254.ScalarField](innerCcs),
+	}
+	assignment := Groth16ValueCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{}
+	assignment.VerifyingKey, err = ValueOfVerifyingKey[sw_bn254.G1Affine, sw_bn254.G2Affine](srs.Vk)
+	assert.NoError(err)
+	assignment.Commitment, err = ValueOfCommitment[sw_bn254.G1Affine](com)
+	assert.NoError(err)
+	assignment.OpeningProof, err = ValueOfOpeningProof[sw_bn254.ScalarField, sw_bn254.G1Affine](proof)
+	assert.NoError(err)
+	assignment.Point, err = ValueOfScalar[sw_bn254.ScalarField](point)
+	assert.NoError(err)
+	assignment.InnerProof, err = stdgroth16.ValueOfProof[sw_bn254.G1Affine, sw_bn254.G2Affine](innerProof)
+	assert.NoError(err)
+	assignment.InnerVK, err = stdgroth16.ValueOfVerifyingKey[sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl](innerVK)
+	assert.NoError(err)
+	assignment.InnerWitness, err = stdgroth16.ValueOfWitness[sw_bn254.ScalarField](innerPubWitness)
+	assert.NoError(err)
+
+	assert.Run(func(assert *test.Assert) {
+		assert.NoError(test.IsSolved(&circuit, &assignment, ecc.BN254.ScalarField()))
+	}, "valid")
+
+	assert.Run(func(assert *test.Assert) {
+		// an inner proof attesting a different value than the opening is
+		// rejected
+		var otherValue fr_bn254.Element
+		otherValue.SetRandom()
+		otherAssignment := &innerEvalCircuit{
+			Value: otherValue,
+			P:     otherValue,
+			Q:     1,
+		}
+		otherWitness, err := frontend.NewWitness(otherAssignment, ecc.BN254.ScalarField())
+		assert.NoError(err)
+		otherProof, err := groth16.Prove(innerCcs, innerPK, otherWitness)
+		assert.NoError(err)
+		otherPubWitness, err := otherWitness.Public()
+		assert.NoError(err)
+		badAssignment := assignment
+		badAssignment.InnerProof, err = stdgroth16.ValueOfProof[sw_bn254.G1Affine, sw_bn254.G2Affine](otherProof)
+		assert.NoError(err)
+		badAssignment.InnerWitness, err = stdgroth16.ValueOfWitness[sw_bn254.ScalarField](otherPubWitness)
+		assert.NoError(err)
+		assert.Error(test.IsSolved(&circuit, &badAssignment, ecc.BN254.ScalarField()))
+	}, "mismatched value")
+}
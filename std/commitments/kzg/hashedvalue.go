@@ -0,0 +1,36 @@
+package kzg
+
+import (
+	"fmt"
+
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/hash"
+	"github.com/consensys/gnark/std/math/bits"
+	"github.com/consensys/gnark/std/math/emulated"
+)
+
+// AssertProofWithHashedValue verifies the opening proof and asserts that the
+// claimed value hashes to expectedDigest under the given hasher. Protocols
+// binding the evaluation through a hash (e.g. Poseidon for domain
+// separation) can this way consume the digest without exposing the value
+// itself.
+//
+// The claimed value is decomposed canonically and repacked as a single
+// native field element before hashing, so it must fit the native field; the
+// external prover computes the matching digest by hashing the evaluation as
+// one element of the native field.
+func (v *Verifier[FR, G1El, G2El, GTEl]) AssertProofWithHashedValue(commitment Commitment[G1El], proof OpeningProof[FR, G1El], point emulated.Element[FR], expectedDigest frontend.Variable, vk VerifyingKey[G1El, G2El], hasher hash.FieldHasher) error {
+	var fr FR
+	if fr.Modulus().Cmp(v.api.Compiler().Field()) > 0 {
+		return fmt.Errorf("emulated scalar field doesn't fit the native field")
+	}
+	bts := v.scalarApi.ToBitsCanonical(&proof.ClaimedValue)
+	msg := bits.FromBinary(v.api, bts)
+	hasher.Reset()
+	hasher.Write(msg)
+	v.api.AssertIsEqual(hasher.Sum(), expectedDigest)
+	if err := v.CheckOpeningProof(commitment, proof, point, vk); err != nil {
+		return fmt.Errorf("check opening proof: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,112 @@
+package kzg
+
+import (
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	fr_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	poseidonbn254 "github.com/consensys/gnark-crypto/ecc/bn254/fr/poseidon2"
+	kzg_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/kzg"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/algebra"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_bn254"
+	poseidon "github.com/consensys/gnark/std/hash/poseidon2"
+	"github.com/consensys/gnark/std/math/emulated"
+	"github.com/consensys/gnark/test"
+)
+
+// poseidonHasher adapts the poseidon2 permutation to the hash.FieldHasher
+// interface with a width-3 sponge absorbing at most two elements.
+type poseidonHasher struct {
+	api  frontend.API
+	data []frontend.Variable
+}
+
+func (h *poseidonHasher) Write(data ...frontend.Variable) {
+	h.data = append(h.data, data...)
+}
+
+func (h *poseidonHasher) Reset() {
+	h.data = nil
+}
+
+func (h *poseidonHasher) Sum() frontend.Variable {
+	state := []frontend.Variable{0, 0, 0}
+	copy(state, h.data)
+	perm := poseidon.NewHash(3, 5, 8, 56, "seed", ecc.BN254)
+	perm.Permutation(h.api, state)
+	return state[0]
+}
+
+type HashedValueCircuit[FR emulated.FieldParams, G1El algebra.G1ElementT, G2El algebra.G2ElementT, GTEl algebra.GtElementT] struct {
+	VerifyingKey[G1El, G2El]
+	Commitment[G1El]
+	OpeningProof[FR, G1El]
+	Point  emulated.Element[FR]
+	Digest frontend.Variable
+}
+
+func (c *HashedValueCircuit[FR, G1El, G2El, GTEl]) Define(api frontend.API) error {
+	verifier, err := NewVerifier[FR, G1El, G2El, GTEl](api)
+	if err != nil {
+		return fmt.Errorf("new verifier: %w", err)
+	}
+	hasher := &poseidonHasher{api: api}
+	if err := verifier.AssertProofWithHashedValue(c.Commitment, c.OpeningProof, c.Point, c.Digest, c.VerifyingKey, hasher); err != nil {
+		return fmt.Errorf("assert proof with hashed value: %w", err)
+	}
+	return nil
+}
+
+func TestAssertProofWithHashedValue(t *testing.T) {
+	assert := test.NewAssert(t)
+
+	alpha, err := rand.Int(rand.Reader, ecc.BN254.ScalarField())
+	assert.NoError(err)
+	srs, err := kzg_bn254.NewSRS(kzgSize, alpha)
+	assert.NoError(err)
+
+	f := make([]fr_bn254.Element, polynomialSize)
+	for i := range f {
+		f[i].SetRandom()
+	}
+	com, err := kzg_bn254.Commit(f, srs.Pk)
+	assert.NoError(err)
+	var point fr_bn254.Element
+	point.SetRandom()
+	proof, err := kzg_bn254.Open(f, point, srs.Pk)
+	assert.NoError(err)
+
+	// native digest of the claimed value with the matching sponge
+	perm := poseidonbn254.NewHash(3, 8, 56, "seed")
+	var state [3]fr_bn254.Element
+	state[0].Set(&proof.ClaimedValue)
+	assert.NoError(perm.Permutation(state[:]))
+
+	wVk, err := ValueOfVerifyingKey[sw_bn254.G1Affine, sw_bn254.G2Affine](srs.Vk)
+	assert.NoError(err)
+	wCom, err := ValueOfCommitment[sw_bn254.G1Affine](com)
+	assert.NoError(err)
+	wProof, err := ValueOfOpeningProof[sw_bn254.ScalarField, sw_bn254.G1Affine](proof)
+	assert.NoError(err)
+	wPoint, err := ValueOfScalar[sw_bn254.ScalarField](point)
+	assert.NoError(err)
+
+	circuit := HashedValueCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{}
+	assignment := HashedValueCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{
+		VerifyingKey: wVk,
+		Commitment:   wCom,
+		OpeningProof: wProof,
+		Point:        wPoint,
+		Digest:       state[0].BigInt(new(big.Int)),
+	}
+	assert.NoError(test.IsSolved(&circuit, &assignment, ecc.BN254.ScalarField()))
+
+	// a wrong digest does not verify
+	badAssignment := assignment
+	badAssignment.Digest = 1234
+	assert.Error(test.IsSolved(&circuit, &badAssignment, ecc.BN254.ScalarField()))
+}
@@ -0,0 +1,43 @@
+package kzg
+
+import (
+	"fmt"
+
+	"github.com/consensys/gnark/std/math/emulated"
+)
+
+// AssertKZG10HidingProof asserts the validity of an opening of a hiding
+// commitment in the two-generator scheme of the original KZG10 paper
+// (PolyCommit_Ped). The commitment is C = [f(α)]G₁ + [f̂(α)]H₁ for the
+// blinding polynomial f̂ and a second generator H₁ with unknown discrete
+// logarithm relative to G₁; the prover's SRS must contain the powers of α
+// over both generators. An opening at a reveals both evaluations: proof
+// carries the quotient of f over the G₁ powers and its claimed value f(a),
+// blindingProof the quotient of f̂ over the H₁ powers and f̂(a). The combined
+// identity C - [f(a)]G₁ - [f̂(a)]H₁ = (α-a)·(H_f + H_f̂) is checked with the
+// same pairing equation as [Verifier.CheckOpeningProof]. The verifying key is
+// unchanged; only h = H₁ is needed in addition.
+func (v *Verifier[FR, G1El, G2El, GTEl]) AssertKZG10HidingProof(commitment Commitment[G1El], proof, blindingProof OpeningProof[FR, G1El], point emulated.Element[FR], h G1El, vk VerifyingKey[G1El, G2El]) error {
+	// W = H_f + H_f̂, the combined quotient over both generators
+	quotient := v.curve.Add(&proof.Quotient, &blindingProof.Quotient)
+
+	// [f(a)]G₁ + [f̂(a)]H₁ + [-a]W
+	pointNeg := v.scalarApi.Neg(&point)
+	totalG1, err := v.curve.MultiScalarMul(
+		[]*G1El{&vk.G1, &h, quotient},
+		[]*emulated.Element[FR]{&proof.ClaimedValue, &blindingProof.ClaimedValue, pointNeg},
+	)
+	if err != nil {
+		return fmt.Errorf("assert hiding proof: %w", err)
+	}
+	totalG1 = v.curve.Add(totalG1, v.curve.Neg(&commitment.G1El))
+
+	// e([f(a)+f̂(a)·ρ-C(α)-a·W(α)]G₁, G₂)·e(W, [α]G₂) == 1
+	if err := v.pairing.PairingCheck(
+		[]*G1El{totalG1, quotient},
+		[]*G2El{&vk.G2[0], &vk.G2[1]},
+	); err != nil {
+		return fmt.Errorf("pairing check: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,105 @@
+package kzg
+
+import (
+	"crypto/rand"
+	"fmt"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bn254"
+	fr_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	kzg_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/kzg"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/algebra"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_bn254"
+	"github.com/consensys/gnark/std/math/emulated"
+	"github.com/consensys/gnark/test"
+)
+
+type KZG10HidingCircuit[FR emulated.FieldParams, G1El algebra.G1ElementT, G2El algebra.G2ElementT, GTEl algebra.GtElementT] struct {
+	VerifyingKey[G1El, G2El]
+	Commitment[G1El]
+	Proof         OpeningProof[FR, G1El]
+	BlindingProof OpeningProof[FR, G1El]
+	Point         emulated.Element[FR]
+	H             G1El
+}
+
+func (c *KZG10HidingCircuit[FR, G1El, G2El, GTEl]) Define(api frontend.API) error {
+	verifier, err := NewVerifier[FR, G1El, G2El, GTEl](api)
+	if err != nil {
+		return fmt.Errorf("new verifier: %w", err)
+	}
+	if err := verifier.AssertKZG10HidingProof(c.Commitment, c.Proof, c.BlindingProof, c.Point, c.H, c.VerifyingKey); err != nil {
+		return fmt.Errorf("assert hiding proof: %w", err)
+	}
+	return nil
+}
+
+func TestAssertKZG10HidingProof(t *testing.T) {
+	assert := test.NewAssert(t)
+
+	alpha, err := rand.Int(rand.Reader, ecc.BN254.ScalarField())
+	assert.NoError(err)
+	srs, err := kzg_bn254.NewSRS(kzgSize, alpha)
+	assert.NoError(err)
+
+	// second generator H₁ = [ρ]G₁; the test knows ρ so the H₁ powers are
+	// obtained by scaling the G₁ powers
+	rho, err := rand.Int(rand.Reader, ecc.BN254.ScalarField())
+	assert.NoError(err)
+	var h bn254.G1Affine
+	h.ScalarMultiplication(&srs.Pk.G1[0], rho)
+
+	f := make([]fr_bn254.Element, polynomialSize)
+	fHat := make([]fr_bn254.Element, polynomialSize)
+	for i := range f {
+		f[i].SetRandom()
+		fHat[i].SetRandom()
+	}
+
+	// C = [f(α)]G₁ + [f̂(α)]H₁
+	comF, err := kzg_bn254.Commit(f, srs.Pk)
+	assert.NoError(err)
+	comFHat, err := kzg_bn254.Commit(fHat, srs.Pk)
+	assert.NoError(err)
+	var com bn254.G1Affine
+	com.ScalarMultiplication(&comFHat, rho).Add(&com, &comF)
+
+	var point fr_bn254.Element
+	point.SetRandom()
+	proof, err := kzg_bn254.Open(f, point, srs.Pk)
+	assert.NoError(err)
+	// the blinding quotient lives over the H₁ powers
+	blindingProof, err := kzg_bn254.Open(fHat, point, srs.Pk)
+	assert.NoError(err)
+	blindingProof.H.ScalarMultiplication(&blindingProof.H, rho)
+
+	wVk, err := ValueOfVerifyingKey[sw_bn254.G1Affine, sw_bn254.G2Affine](srs.Vk)
+	assert.NoError(err)
+	wCom, err := ValueOfCommitment[sw_bn254.G1Affine](com)
+	assert.NoError(err)
+	wProof, err := ValueOfOpeningProof[sw_bn254.ScalarField, sw_bn254.G1Affine](proof)
+	assert.NoError(err)
+	wBlinding, err := ValueOfOpeningProof[sw_bn254.ScalarField, sw_bn254.G1Affine](blindingProof)
+	assert.NoError(err)
+	wPoint, err := ValueOfScalar[sw_bn254.ScalarField](point)
+	assert.NoError(err)
+
+	circuit := KZG10HidingCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{}
+	assignment := KZG10HidingCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{
+		VerifyingKey:  wVk,
+		Commitment:    wCom,
+		Proof:         wProof,
+		BlindingProof: wBlinding,
+		Point:         wPoint,
+		H:             sw_bn254.NewG1Affine(h),
+	}
+	assert.NoError(test.IsSolved(&circuit, &assignment, ecc.BN254.ScalarField()))
+
+	// the proof for f alone does not verify against the hiding commitment
+	badAssignment := assignment
+	badAssignment.Commitment, err = ValueOfCommitment[sw_bn254.G1Affine](comF)
+	assert.NoError(err)
+	assert.Error(test.IsSolved(&circuit, &badAssignment, ecc.BN254.ScalarField()))
+}
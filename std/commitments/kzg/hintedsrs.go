@@ -0,0 +1,157 @@
+package kzg
+
+import (
+	"fmt"
+	"math/big"
+
+	fp_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/fp"
+	fr_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	kzg_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/kzg"
+	"github.com/consensys/gnark/constraint/solver"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/algebra"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_bn254"
+	"github.com/consensys/gnark/std/math/emulated"
+)
+
+func init() {
+	solver.RegisterHint(SRSExpansionHint)
+}
+
+// FullSRSFromSeed populates a [FullSRS] from a seed through the expansion
+// hint instead of carrying every G1 power as witness, trading witness size
+// for an in-circuit consistency check. The hint receives the seed as a native
+// variable and returns the affine coordinates of the size powers; the method
+// then spot-checks the expansion against the trusted verifying key with a
+// random linear combination pairing (see the soundness discussion on
+// [Verifier.assertPowersConsistent]).
+//
+// The hint is untrusted: the consistency check pins every returned power to
+// [αⁱ]G₁ for the α committed in vk, whatever the solver produced, so a
+// corrupted expansion fails to solve. [SRSExpansionHint] is the canonical
+// expansion deriving the powers from the seed as the setup scalar; callers
+// whose ceremony expands seeds differently supply their own registered hint
+// with the same output layout. The method is only available for the BN254
+// parametrization.
+func (v *Verifier[FR, G1El, G2El, GTEl]) FullSRSFromSeed(seed frontend.Variable, size int, expansion solver.Hint, vk VerifyingKey[G1El, G2El]) (*FullSRS[G1El, G2El], error) {
+	if size < 2 {
+		return nil, fmt.Errorf("SRS size %d below minimum 2", size)
+	}
+	ret := FullSRS[G1El, G2El]{VerifyingKey: vk}
+	switch s := any(&ret).(type) {
+	case *FullSRS[sw_bn254.G1Affine, sw_bn254.G2Affine]:
+		fp, err := emulated.NewField[sw_bn254.BaseField](v.api)
+		if err != nil {
+			return nil, fmt.Errorf("new base field: %w", err)
+		}
+		coords, err := fp.NewHintWithNativeInput(expansion, 2*size, seed)
+		if err != nil {
+			return nil, fmt.Errorf("expansion hint: %w", err)
+		}
+		s.G1Powers = make([]sw_bn254.G1Affine, size)
+		for i := range s.G1Powers {
+			s.G1Powers[i] = sw_bn254.G1Affine{X: *coords[2*i], Y: *coords[2*i+1]}
+		}
+	default:
+		return nil, fmt.Errorf("unsupported type parametrisation %T", ret)
+	}
+	if err := v.assertPowersConsistent(ret.G1Powers, vk); err != nil {
+		return nil, fmt.Errorf("powers consistency: %w", err)
+	}
+	return &ret, nil
+}
+
+// assertPowersConsistent asserts that powers[i] = [αⁱ]G₁ for the setup scalar
+// α committed in vk.G2[1]. Each power is asserted to be in the group and the
+// first power is pinned to the generator; a single pairing check on a random
+// linear combination
+//
+//	e(Σ cⁱ powers[i], [α]G₂) = e(Σ cⁱ powers[i+1], G₂)
+//
+// then enforces the shift relation powers[i+1] = [α]powers[i] for every i at
+// once, with the folding challenge c squeezed from a transcript binding all
+// powers so a corrupted power cannot be chosen after the challenge.
+func (v *Verifier[FR, G1El, G2El, GTEl]) assertPowersConsistent(powers []G1El, vk VerifyingKey[G1El, G2El]) error {
+	for i := range powers {
+		v.pairing.AssertIsOnG1(&powers[i])
+	}
+	v.curve.AssertIsEqual(&powers[0], &vk.G1)
+	fs, err := v.NewTranscript("srs-expansion")
+	if err != nil {
+		return fmt.Errorf("new transcript: %w", err)
+	}
+	for i := range powers {
+		if err := fs.AppendCommitment(Commitment[G1El]{G1El: powers[i]}); err != nil {
+			return fmt.Errorf("append %d-th power: %w", i, err)
+		}
+	}
+	c, err := fs.ChallengeScalar()
+	if err != nil {
+		return fmt.Errorf("challenge scalar: %w", err)
+	}
+
+	// ci = [1,c,c²,..,cⁿ⁻²] folds the powers and their shifts
+	n := len(powers)
+	ci := make([]*emulated.Element[FR], n-1)
+	ci[0] = v.scalarApi.One()
+	if n > 2 {
+		ci[1] = c
+	}
+	for i := 2; i < n-1; i++ {
+		ci[i] = v.scalarApi.Mul(ci[i-1], c)
+	}
+	low := make([]*G1El, n-1)
+	high := make([]*G1El, n-1)
+	for i := 0; i < n-1; i++ {
+		low[i] = &powers[i]
+		high[i] = &powers[i+1]
+	}
+	foldedLow, foldedHigh := low[0], high[0]
+	if n > 2 {
+		foldedLow, err = v.curve.MultiScalarMul(low[1:], ci[1:])
+		if err != nil {
+			return fmt.Errorf("fold powers: %w", err)
+		}
+		foldedLow = v.curve.Add(foldedLow, low[0])
+		foldedHigh, err = v.curve.MultiScalarMul(high[1:], ci[1:])
+		if err != nil {
+			return fmt.Errorf("fold shifted powers: %w", err)
+		}
+		foldedHigh = v.curve.Add(foldedHigh, high[0])
+	}
+	eq := algebra.NewEquation(v.curve, v.pairing)
+	eq.AddPairing(foldedLow, &vk.G2[1])
+	eq.AddPairing(v.curve.Neg(foldedHigh), &vk.G2[0])
+	if err := eq.AssertEqualsOne(); err != nil {
+		return fmt.Errorf("pairing check: %w", err)
+	}
+	return nil
+}
+
+// SRSExpansionHint expands a seed into the G1 powers of a BN254 SRS whose
+// setup scalar is the seed reduced into the scalar field, returning the
+// affine coordinates X, Y of the i-th power at output positions 2i and 2i+1.
+func SRSExpansionHint(mod *big.Int, inputs, outputs []*big.Int) error {
+	return emulated.UnwrapHintWithNativeInput(inputs, outputs, func(field *big.Int, inputs, outputs []*big.Int) error {
+		if len(inputs) != 1 {
+			return fmt.Errorf("expecting one input")
+		}
+		if len(outputs)%2 != 0 || len(outputs) < 4 {
+			return fmt.Errorf("expecting an even number of at least four outputs")
+		}
+		if field.Cmp(fp_bn254.Modulus()) != 0 {
+			return fmt.Errorf("expecting BN254 base field")
+		}
+		var alpha fr_bn254.Element
+		alpha.SetBigInt(inputs[0])
+		srs, err := kzg_bn254.NewSRS(uint64(len(outputs)/2), alpha.BigInt(new(big.Int)))
+		if err != nil {
+			return fmt.Errorf("new SRS: %w", err)
+		}
+		for i := range srs.Pk.G1 {
+			srs.Pk.G1[i].X.BigInt(outputs[2*i])
+			srs.Pk.G1[i].Y.BigInt(outputs[2*i+1])
+		}
+		return nil
+	})
+}
@@ -0,0 +1,111 @@
+package kzg
+
+import (
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bn254"
+	fp_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/fp"
+	fr_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	kzg_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/kzg"
+	"github.com/consensys/gnark/constraint/solver"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/algebra"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_bn254"
+	"github.com/consensys/gnark/std/math/emulated"
+	"github.com/consensys/gnark/test"
+)
+
+const hintedSrsSize = 8
+
+func init() {
+	solver.RegisterHint(corruptedSRSExpansionHint)
+}
+
+// corruptedSRSExpansionHint expands honestly and then replaces the fourth
+// power with a valid group element of the wrong discrete logarithm, so the
+// corruption passes the membership checks and must be caught by the random
+// linear combination pairing.
+func corruptedSRSExpansionHint(mod *big.Int, inputs, outputs []*big.Int) error {
+	return emulated.UnwrapHintWithNativeInput(inputs, outputs, func(field *big.Int, inputs, outputs []*big.Int) error {
+		if len(inputs) != 1 {
+			return fmt.Errorf("expecting one input")
+		}
+		if len(outputs)%2 != 0 || len(outputs) < 8 {
+			return fmt.Errorf("expecting an even number of at least eight outputs")
+		}
+		if field.Cmp(fp_bn254.Modulus()) != 0 {
+			return fmt.Errorf("expecting BN254 base field")
+		}
+		var alpha fr_bn254.Element
+		alpha.SetBigInt(inputs[0])
+		srs, err := kzg_bn254.NewSRS(uint64(len(outputs)/2), alpha.BigInt(new(big.Int)))
+		if err != nil {
+			return fmt.Errorf("new SRS: %w", err)
+		}
+		var bad bn254.G1Affine
+		bad.Double(&srs.Pk.G1[3])
+		srs.Pk.G1[3] = bad
+		for i := range srs.Pk.G1 {
+			srs.Pk.G1[i].X.BigInt(outputs[2*i])
+			srs.Pk.G1[i].Y.BigInt(outputs[2*i+1])
+		}
+		return nil
+	})
+}
+
+type HintedSRSCircuit[FR emulated.FieldParams, G1El algebra.G1ElementT, G2El algebra.G2ElementT, GTEl algebra.GtElementT] struct {
+	VerifyingKey[G1El, G2El]
+	Seed      frontend.Variable
+	LastPower G1El
+
+	corrupt bool
+}
+
+func (c *HintedSRSCircuit[FR, G1El, G2El, GTEl]) Define(api frontend.API) error {
+	verifier, err := NewVerifier[FR, G1El, G2El, GTEl](api)
+	if err != nil {
+		return fmt.Errorf("new verifier: %w", err)
+	}
+	expansion := SRSExpansionHint
+	if c.corrupt {
+		expansion = corruptedSRSExpansionHint
+	}
+	srs, err := verifier.FullSRSFromSeed(c.Seed, hintedSrsSize, expansion, c.VerifyingKey)
+	if err != nil {
+		return fmt.Errorf("full SRS from seed: %w", err)
+	}
+	verifier.curve.AssertIsEqual(&srs.G1Powers[hintedSrsSize-1], &c.LastPower)
+	return nil
+}
+
+func TestFullSRSFromSeed(t *testing.T) {
+	assert := test.NewAssert(t)
+
+	seed, err := rand.Int(rand.Reader, ecc.BN254.ScalarField())
+	assert.NoError(err)
+	srs, err := kzg_bn254.NewSRS(hintedSrsSize, seed)
+	assert.NoError(err)
+
+	assignment := HintedSRSCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{
+		Seed:      seed,
+		LastPower: sw_bn254.NewG1Affine(srs.Pk.G1[hintedSrsSize-1]),
+	}
+	assignment.VerifyingKey, err = ValueOfVerifyingKey[sw_bn254.G1Affine, sw_bn254.G2Affine](srs.Vk)
+	assert.NoError(err)
+
+	assert.Run(func(assert *test.Assert) {
+		circuit := HintedSRSCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{}
+		assert.NoError(test.IsSolved(&circuit, &assignment, ecc.BN254.ScalarField()))
+	}, "valid")
+
+	assert.Run(func(assert *test.Assert) {
+		// a corrupted power survives the membership checks but fails the
+		// random linear combination pairing
+		circuit := HintedSRSCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{corrupt: true}
+		assert.Error(test.IsSolved(&circuit, &assignment, ecc.BN254.ScalarField()))
+	}, "corrupted power")
+}
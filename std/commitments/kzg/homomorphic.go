@@ -0,0 +1,37 @@
+package kzg
+
+import (
+	"fmt"
+
+	"github.com/consensys/gnark/std/math/emulated"
+)
+
+// ScaleCommitment returns the commitment [s]·C. By KZG's additive
+// homomorphism this commits to s·f when C commits to f.
+func (v *Verifier[FR, G1El, G2El, GTEl]) ScaleCommitment(commitment Commitment[G1El], s emulated.Element[FR]) Commitment[G1El] {
+	scaled := v.curve.ScalarMul(&commitment.G1El, &s)
+	return Commitment[G1El]{G1El: *scaled}
+}
+
+// AddCommitments returns the commitment C_a + C_b, committing to f_a + f_b
+// when C_a and C_b commit to f_a and f_b respectively.
+func (v *Verifier[FR, G1El, G2El, GTEl]) AddCommitments(a, b Commitment[G1El]) Commitment[G1El] {
+	sum := v.curve.Add(&a.G1El, &b.G1El)
+	return Commitment[G1El]{G1El: *sum}
+}
+
+// AssertHomomorphicOpening verifies an opening of the homomorphically derived
+// commitment
+//
+//	cA + [λ]·cB
+//
+// without the prover supplying the combined commitment. The combination is
+// formed in-circuit from the two public commitments, so the proof is bound to
+// the published cA and cB and to the public scalar λ.
+func (v *Verifier[FR, G1El, G2El, GTEl]) AssertHomomorphicOpening(cA, cB Commitment[G1El], lambda emulated.Element[FR], proof OpeningProof[FR, G1El], point emulated.Element[FR], vk VerifyingKey[G1El, G2El]) error {
+	combined := v.AddCommitments(cA, v.ScaleCommitment(cB, lambda))
+	if err := v.CheckOpeningProof(combined, proof, point, vk); err != nil {
+		return fmt.Errorf("combined opening: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,94 @@
+package kzg
+
+import (
+	"crypto/rand"
+	"fmt"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	fr_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	kzg_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/kzg"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/algebra"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_bn254"
+	"github.com/consensys/gnark/std/math/emulated"
+	"github.com/consensys/gnark/test"
+)
+
+type HomomorphicOpeningCircuit[FR emulated.FieldParams, G1El algebra.G1ElementT, G2El algebra.G2ElementT, GTEl algebra.GtElementT] struct {
+	VerifyingKey[G1El, G2El]
+	CommitmentA Commitment[G1El]
+	CommitmentB Commitment[G1El]
+	Lambda      emulated.Element[FR]
+	Proof       OpeningProof[FR, G1El]
+	Point       emulated.Element[FR]
+}
+
+func (c *HomomorphicOpeningCircuit[FR, G1El, G2El, GTEl]) Define(api frontend.API) error {
+	verifier, err := NewVerifier[FR, G1El, G2El, GTEl](api)
+	if err != nil {
+		return fmt.Errorf("new verifier: %w", err)
+	}
+	return verifier.AssertHomomorphicOpening(c.CommitmentA, c.CommitmentB, c.Lambda, c.Proof, c.Point, c.VerifyingKey)
+}
+
+func TestAssertHomomorphicOpening(t *testing.T) {
+	assert := test.NewAssert(t)
+
+	alpha, err := rand.Int(rand.Reader, ecc.BN254.ScalarField())
+	assert.NoError(err)
+	srs, err := kzg_bn254.NewSRS(kzgSize, alpha)
+	assert.NoError(err)
+
+	fA := make([]fr_bn254.Element, polynomialSize)
+	fB := make([]fr_bn254.Element, polynomialSize)
+	for i := range fA {
+		fA[i].SetRandom()
+		fB[i].SetRandom()
+	}
+	comA, err := kzg_bn254.Commit(fA, srs.Pk)
+	assert.NoError(err)
+	comB, err := kzg_bn254.Commit(fB, srs.Pk)
+	assert.NoError(err)
+
+	var lambda, point fr_bn254.Element
+	lambda.SetRandom()
+	point.SetRandom()
+
+	// the prover opens fA + λ·fB, never sending the combined commitment
+	combined := make([]fr_bn254.Element, polynomialSize)
+	for i := range combined {
+		combined[i].Mul(&fB[i], &lambda).Add(&combined[i], &fA[i])
+	}
+	proof, err := kzg_bn254.Open(combined, point, srs.Pk)
+	assert.NoError(err)
+
+	wVk, err := ValueOfVerifyingKey[sw_bn254.G1Affine, sw_bn254.G2Affine](srs.Vk)
+	assert.NoError(err)
+	wComA, err := ValueOfCommitment[sw_bn254.G1Affine](comA)
+	assert.NoError(err)
+	wComB, err := ValueOfCommitment[sw_bn254.G1Affine](comB)
+	assert.NoError(err)
+	wProof, err := ValueOfOpeningProof[sw_bn254.ScalarField, sw_bn254.G1Affine](proof)
+	assert.NoError(err)
+	wPoint, err := ValueOfScalar[sw_bn254.ScalarField](point)
+	assert.NoError(err)
+
+	circuit := HomomorphicOpeningCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{}
+	assignment := HomomorphicOpeningCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{
+		VerifyingKey: wVk,
+		CommitmentA:  wComA,
+		CommitmentB:  wComB,
+		Lambda:       emulated.ValueOf[sw_bn254.ScalarField](lambda),
+		Proof:        wProof,
+		Point:        wPoint,
+	}
+	assert.NoError(test.IsSolved(&circuit, &assignment, ecc.BN254.ScalarField()))
+
+	// a proof for a different combination coefficient does not verify
+	badAssignment := assignment
+	var otherLambda fr_bn254.Element
+	otherLambda.SetOne().Add(&otherLambda, &lambda)
+	badAssignment.Lambda = emulated.ValueOf[sw_bn254.ScalarField](otherLambda)
+	assert.Error(test.IsSolved(&circuit, &badAssignment, ecc.BN254.ScalarField()))
+}
@@ -0,0 +1,89 @@
+package kzg
+
+import (
+	"fmt"
+
+	"github.com/consensys/gnark/std/algebra"
+	fiatshamir "github.com/consensys/gnark/std/fiat-shamir"
+	"github.com/consensys/gnark/std/math/bits"
+	"github.com/consensys/gnark/std/math/emulated"
+	"github.com/consensys/gnark/std/recursion"
+)
+
+// InterleavedTranscript is a Fiat-Shamir transcript for multi-round protocols
+// where an opening point depends on commitments sent later: the caller
+// sequences explicit [InterleavedTranscript.Absorb] and
+// [InterleavedTranscript.Squeeze] calls, so commitment A and a later
+// commitment B can both be absorbed before A's opening point is squeezed.
+// Construct it with [Verifier.NewInterleavedTranscript], declaring one
+// challenge identifier per squeeze up front.
+//
+// Absorbed values bind to the next unsqueezed challenge and every challenge
+// absorbs the previously squeezed one, so each squeeze commits to the whole
+// transcript so far. The in-circuit call sequence must match the off-circuit
+// prover exactly: the challenges can be reproduced natively with a
+// gnark-crypto Fiat-Shamir transcript over the hash returned by
+// [recursion.NewShort], binding the gnark-crypto serialization of the same
+// elements in the same order.
+type InterleavedTranscript[FR emulated.FieldParams, G1El algebra.G1ElementT, G2El algebra.G2ElementT, GTEl algebra.GtElementT] struct {
+	v    *Verifier[FR, G1El, G2El, GTEl]
+	fs   *fiatshamir.Transcript
+	ids  []string
+	next int
+}
+
+// NewInterleavedTranscript returns a transcript over the wrapped recursion
+// hash (see [recursion.NewHash]) squeezing one challenge per identifier, in
+// order, with interleaved caller-sequenced absorptions.
+func (v *Verifier[FR, G1El, G2El, GTEl]) NewInterleavedTranscript(challengeIDs []string) (*InterleavedTranscript[FR, G1El, G2El, GTEl], error) {
+	if len(challengeIDs) == 0 {
+		return nil, fmt.Errorf("no challenge identifiers")
+	}
+	var fr FR
+	fs, err := recursion.NewTranscript(v.api, fr.Modulus(), challengeIDs)
+	if err != nil {
+		return nil, fmt.Errorf("new transcript: %w", err)
+	}
+	return &InterleavedTranscript[FR, G1El, G2El, GTEl]{v: v, fs: fs, ids: challengeIDs}, nil
+}
+
+// Absorb binds the commitment to the next unsqueezed challenge, as its
+// marshalled G1 coordinates X || Y.
+func (t *InterleavedTranscript[FR, G1El, G2El, GTEl]) Absorb(commitment Commitment[G1El]) error {
+	if t.next >= len(t.ids) {
+		return fmt.Errorf("all %d challenges squeezed", len(t.ids))
+	}
+	if err := t.fs.Bind(t.ids[t.next], t.v.curve.MarshalG1(commitment.G1El)); err != nil {
+		return fmt.Errorf("bind commitment: %w", err)
+	}
+	return nil
+}
+
+// AbsorbScalar binds the scalar to the next unsqueezed challenge, as its
+// big-endian canonical bytes.
+func (t *InterleavedTranscript[FR, G1El, G2El, GTEl]) AbsorbScalar(scalar emulated.Element[FR]) error {
+	if t.next >= len(t.ids) {
+		return fmt.Errorf("all %d challenges squeezed", len(t.ids))
+	}
+	if err := t.fs.Bind(t.ids[t.next], t.v.curve.MarshalScalar(scalar)); err != nil {
+		return fmt.Errorf("bind scalar: %w", err)
+	}
+	return nil
+}
+
+// Squeeze computes the next challenge, bound to everything absorbed since the
+// previous squeeze and, through the challenge chaining, to the previous
+// challenges, and returns it as an emulated scalar.
+func (t *InterleavedTranscript[FR, G1El, G2El, GTEl]) Squeeze() (*emulated.Element[FR], error) {
+	if t.next >= len(t.ids) {
+		return nil, fmt.Errorf("all %d challenges squeezed", len(t.ids))
+	}
+	var fr FR
+	challenge, err := t.fs.ComputeChallenge(t.ids[t.next])
+	if err != nil {
+		return nil, fmt.Errorf("compute challenge: %w", err)
+	}
+	t.next++
+	bChallenge := bits.ToBinary(t.v.api, challenge, bits.WithNbDigits(fr.Modulus().BitLen()))
+	return t.v.scalarApi.FromBits(bChallenge...), nil
+}
@@ -0,0 +1,130 @@
+package kzg
+
+import (
+	"crypto/rand"
+	"fmt"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	fr_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	kzg_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/kzg"
+	cryptofs "github.com/consensys/gnark-crypto/fiat-shamir"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/algebra"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_bn254"
+	"github.com/consensys/gnark/std/math/emulated"
+	"github.com/consensys/gnark/std/recursion"
+	"github.com/consensys/gnark/test"
+)
+
+type InterleavedTranscriptCircuit[FR emulated.FieldParams, G1El algebra.G1ElementT, G2El algebra.G2ElementT, GTEl algebra.GtElementT] struct {
+	VerifyingKey[G1El, G2El]
+	ComA   Commitment[G1El]
+	ComB   Commitment[G1El]
+	ProofA OpeningProof[FR, G1El]
+	ProofB OpeningProof[FR, G1El]
+}
+
+func (c *InterleavedTranscriptCircuit[FR, G1El, G2El, GTEl]) Define(api frontend.API) error {
+	verifier, err := NewVerifier[FR, G1El, G2El, GTEl](api)
+	if err != nil {
+		return fmt.Errorf("new verifier: %w", err)
+	}
+	transcript, err := verifier.NewInterleavedTranscript([]string{"pointA", "pointB"})
+	if err != nil {
+		return fmt.Errorf("new interleaved transcript: %w", err)
+	}
+	// A's opening point depends on the later commitment B
+	if err := transcript.Absorb(c.ComA); err != nil {
+		return fmt.Errorf("absorb A: %w", err)
+	}
+	if err := transcript.Absorb(c.ComB); err != nil {
+		return fmt.Errorf("absorb B: %w", err)
+	}
+	pointA, err := transcript.Squeeze()
+	if err != nil {
+		return fmt.Errorf("squeeze A's point: %w", err)
+	}
+	if err := verifier.CheckOpeningProof(c.ComA, c.ProofA, *pointA, c.VerifyingKey); err != nil {
+		return fmt.Errorf("check A's opening: %w", err)
+	}
+	// B's opening point additionally depends on A's revealed value
+	if err := transcript.AbsorbScalar(c.ProofA.ClaimedValue); err != nil {
+		return fmt.Errorf("absorb A's claimed value: %w", err)
+	}
+	pointB, err := transcript.Squeeze()
+	if err != nil {
+		return fmt.Errorf("squeeze B's point: %w", err)
+	}
+	if err := verifier.CheckOpeningProof(c.ComB, c.ProofB, *pointB, c.VerifyingKey); err != nil {
+		return fmt.Errorf("check B's opening: %w", err)
+	}
+	return nil
+}
+
+func TestInterleavedTranscript(t *testing.T) {
+	assert := test.NewAssert(t)
+
+	alpha, err := rand.Int(rand.Reader, ecc.BN254.ScalarField())
+	assert.NoError(err)
+	srs, err := kzg_bn254.NewSRS(kzgSize, alpha)
+	assert.NoError(err)
+
+	fA := make([]fr_bn254.Element, polynomialSize)
+	fB := make([]fr_bn254.Element, polynomialSize)
+	for i := range fA {
+		fA[i].SetRandom()
+		fB[i].SetRandom()
+	}
+	comA, err := kzg_bn254.Commit(fA, srs.Pk)
+	assert.NoError(err)
+	comB, err := kzg_bn254.Commit(fB, srs.Pk)
+	assert.NoError(err)
+
+	// native interleaved transcript: absorb A, absorb B, squeeze A's point,
+	// absorb A's revealed value, squeeze B's point
+	h, err := recursion.NewShort(ecc.BN254.ScalarField(), ecc.BN254.ScalarField())
+	assert.NoError(err)
+	fs := cryptofs.NewTranscript(h, "pointA", "pointB")
+	assert.NoError(fs.Bind("pointA", comA.Marshal()))
+	assert.NoError(fs.Bind("pointA", comB.Marshal()))
+	cA, err := fs.ComputeChallenge("pointA")
+	assert.NoError(err)
+	var pointA fr_bn254.Element
+	pointA.SetBytes(cA)
+	proofA, err := kzg_bn254.Open(fA, pointA, srs.Pk)
+	assert.NoError(err)
+	assert.NoError(fs.Bind("pointB", proofA.ClaimedValue.Marshal()))
+	cB, err := fs.ComputeChallenge("pointB")
+	assert.NoError(err)
+	var pointB fr_bn254.Element
+	pointB.SetBytes(cB)
+	proofB, err := kzg_bn254.Open(fB, pointB, srs.Pk)
+	assert.NoError(err)
+
+	assignment := InterleavedTranscriptCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{}
+	assignment.VerifyingKey, err = ValueOfVerifyingKey[sw_bn254.G1Affine, sw_bn254.G2Affine](srs.Vk)
+	assert.NoError(err)
+	assignment.ComA, err = ValueOfCommitment[sw_bn254.G1Affine](comA)
+	assert.NoError(err)
+	assignment.ComB, err = ValueOfCommitment[sw_bn254.G1Affine](comB)
+	assert.NoError(err)
+	assignment.ProofA, err = ValueOfOpeningProof[sw_bn254.ScalarField, sw_bn254.G1Affine](proofA)
+	assert.NoError(err)
+	assignment.ProofB, err = ValueOfOpeningProof[sw_bn254.ScalarField, sw_bn254.G1Affine](proofB)
+	assert.NoError(err)
+
+	circuit := InterleavedTranscriptCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{}
+
+	assert.Run(func(assert *test.Assert) {
+		assert.NoError(test.IsSolved(&circuit, &assignment, ecc.BN254.ScalarField()))
+	}, "valid")
+
+	assert.Run(func(assert *test.Assert) {
+		// swapping the commitments changes both squeezed points so neither
+		// opening verifies
+		badAssignment := assignment
+		badAssignment.ComA, badAssignment.ComB = badAssignment.ComB, badAssignment.ComA
+		assert.Error(test.IsSolved(&circuit, &badAssignment, ecc.BN254.ScalarField()))
+	}, "swapped commitments")
+}
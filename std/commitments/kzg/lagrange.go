@@ -0,0 +1,88 @@
+package kzg
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/consensys/gnark/std/math/emulated"
+)
+
+// AssertLagrangeOpening verifies an opening proof for a commitment made in
+// the Lagrange basis over the multiplicative subgroup generated by
+// domainGenerator of size domainSize. Instead of taking a claimed value, the
+// method derives it in-circuit from the given evaluations with the
+// barycentric formula
+//
+//	f(z) = (zⁿ-1)/n · Σᵢ evaluations[i]·ωⁱ/(z-ωⁱ),
+//
+// and then performs the usual single pairing check against the derived value.
+// The claimed value carried by the proof witness is ignored.
+// The domain generator and size are circuit constants; the method returns an
+// error if the generator is not a primitive root of unity of order domainSize
+// or if the number of evaluations differs from the domain size.
+//
+// The barycentric formula is undefined when the point is a domain element. In
+// that case the evaluation is one of the inputs and needs no proof, so the
+// circuit explicitly constrains zⁿ-1 to be nonzero, rejecting in-domain
+// points.
+func (v *Verifier[FR, G1El, G2El, GTEl]) AssertLagrangeOpening(commitment Commitment[G1El], proof OpeningProof[FR, G1El], evaluations []emulated.Element[FR], point emulated.Element[FR], domainGenerator *big.Int, domainSize int, vk VerifyingKey[G1El, G2El]) error {
+	if domainSize <= 0 {
+		return fmt.Errorf("domain size should be positive")
+	}
+	if len(evaluations) != domainSize {
+		return fmt.Errorf("number of evaluations doesn't match domain size")
+	}
+	var fr FR
+	rMod := fr.Modulus()
+	// check natively that the generator has exact order domainSize
+	pow := new(big.Int).Exp(domainGenerator, big.NewInt(int64(domainSize)), rMod)
+	if pow.Cmp(big.NewInt(1)) != 0 {
+		return fmt.Errorf("domain generator is not a root of unity of order %d", domainSize)
+	}
+	for d := 2; d <= domainSize/2; d++ {
+		if domainSize%d != 0 {
+			continue
+		}
+		pow.Exp(domainGenerator, big.NewInt(int64(domainSize/d)), rMod)
+		if pow.Cmp(big.NewInt(1)) == 0 {
+			return fmt.Errorf("domain generator is not a primitive root of unity of order %d", domainSize)
+		}
+	}
+
+	// zⁿ with a square-and-multiply ladder over the constant exponent
+	zn := v.scalarApi.One()
+	sq := &point
+	for n := domainSize; n > 0; n >>= 1 {
+		if n&1 == 1 {
+			zn = v.scalarApi.Mul(zn, sq)
+		}
+		sq = v.scalarApi.Mul(sq, sq)
+	}
+	znMinusOne := v.scalarApi.Sub(zn, v.scalarApi.One())
+	// reject in-domain points: zⁿ-1 = ∏ᵢ (z-ωⁱ) must be nonzero, which also
+	// makes the divisions below safe
+	v.api.AssertIsEqual(v.scalarApi.IsZero(znMinusOne), 0)
+
+	// Σᵢ evaluations[i]·ωⁱ/(z-ωⁱ)
+	omegaPow := big.NewInt(1)
+	terms := make([]*emulated.Element[FR], domainSize)
+	for i := 0; i < domainSize; i++ {
+		omega := v.scalarApi.NewElement(new(big.Int).Set(omegaPow))
+		num := v.scalarApi.Mul(&evaluations[i], omega)
+		den := v.scalarApi.Sub(&point, omega)
+		terms[i] = v.scalarApi.Div(num, den)
+		omegaPow.Mul(omegaPow, domainGenerator).Mod(omegaPow, rMod)
+	}
+	sum := v.scalarApi.Sum(terms...)
+
+	// (zⁿ-1)/n
+	nInv := new(big.Int).ModInverse(big.NewInt(int64(domainSize)), rMod)
+	factor := v.scalarApi.Mul(znMinusOne, v.scalarApi.NewElement(nInv))
+	derived := v.scalarApi.Mul(factor, sum)
+
+	proof.ClaimedValue = *derived
+	if err := v.CheckOpeningProof(commitment, proof, point, vk); err != nil {
+		return fmt.Errorf("check opening proof: %w", err)
+	}
+	return nil
+}
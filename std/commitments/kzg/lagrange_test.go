@@ -0,0 +1,108 @@
+package kzg
+
+import (
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	fr_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr/fft"
+	kzg_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/kzg"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/algebra"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_bn254"
+	"github.com/consensys/gnark/std/math/emulated"
+	"github.com/consensys/gnark/test"
+)
+
+const testDomainSize = 8
+
+type LagrangeOpeningCircuit[FR emulated.FieldParams, G1El algebra.G1ElementT, G2El algebra.G2ElementT, GTEl algebra.GtElementT] struct {
+	VerifyingKey[G1El, G2El]
+	Commitment[G1El]
+	OpeningProof[FR, G1El]
+	Evaluations [testDomainSize]emulated.Element[FR]
+	Point       emulated.Element[FR]
+
+	domainGenerator *big.Int
+}
+
+func (c *LagrangeOpeningCircuit[FR, G1El, G2El, GTEl]) Define(api frontend.API) error {
+	verifier, err := NewVerifier[FR, G1El, G2El, GTEl](api)
+	if err != nil {
+		return fmt.Errorf("new verifier: %w", err)
+	}
+	return verifier.AssertLagrangeOpening(c.Commitment, c.OpeningProof, c.Evaluations[:], c.Point, c.domainGenerator, testDomainSize, c.VerifyingKey)
+}
+
+func TestAssertLagrangeOpening(t *testing.T) {
+	assert := test.NewAssert(t)
+
+	alpha, err := rand.Int(rand.Reader, ecc.BN254.ScalarField())
+	assert.NoError(err)
+	srs, err := kzg_bn254.NewSRS(kzgSize, alpha)
+	assert.NoError(err)
+
+	domain := fft.NewDomain(testDomainSize)
+	generator := domain.Generator.BigInt(new(big.Int))
+
+	// random evaluations over the domain, interpolated to coefficient form for
+	// the native commitment and opening
+	evals := make([]fr_bn254.Element, testDomainSize)
+	for i := range evals {
+		evals[i].SetRandom()
+	}
+	coeffs := make([]fr_bn254.Element, testDomainSize)
+	copy(coeffs, evals)
+	domain.FFTInverse(coeffs, fft.DIF)
+	fft.BitReverse(coeffs)
+
+	com, err := kzg_bn254.Commit(coeffs, srs.Pk)
+	assert.NoError(err)
+	var point fr_bn254.Element
+	point.SetRandom()
+	proof, err := kzg_bn254.Open(coeffs, point, srs.Pk)
+	assert.NoError(err)
+
+	assignment := LagrangeOpeningCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{
+		Point:           emulated.ValueOf[sw_bn254.ScalarField](point),
+		domainGenerator: generator,
+	}
+	for i := range evals {
+		assignment.Evaluations[i] = emulated.ValueOf[sw_bn254.ScalarField](evals[i])
+	}
+	assignment.VerifyingKey, err = ValueOfVerifyingKey[sw_bn254.G1Affine, sw_bn254.G2Affine](srs.Vk)
+	assert.NoError(err)
+	assignment.Commitment, err = ValueOfCommitment[sw_bn254.G1Affine](com)
+	assert.NoError(err)
+	assignment.OpeningProof, err = ValueOfOpeningProof[sw_bn254.ScalarField, sw_bn254.G1Affine](proof)
+	assert.NoError(err)
+
+	circuit := assignment
+
+	assert.Run(func(assert *test.Assert) {
+		assert.NoError(test.IsSolved(&circuit, &assignment, ecc.BN254.ScalarField()))
+	}, "valid")
+
+	assert.Run(func(assert *test.Assert) {
+		// a tampered evaluation changes the barycentric value
+		badAssignment := assignment
+		badAssignment.Evaluations[1] = emulated.ValueOf[sw_bn254.ScalarField](evals[0])
+		assert.Error(test.IsSolved(&circuit, &badAssignment, ecc.BN254.ScalarField()))
+	}, "wrong evaluation")
+
+	assert.Run(func(assert *test.Assert) {
+		// an in-domain point is rejected explicitly
+		var inDomain fr_bn254.Element
+		inDomain.Exp(domain.Generator, big.NewInt(2))
+		inProof, err := kzg_bn254.Open(coeffs, inDomain, srs.Pk)
+		assert.NoError(err)
+		badAssignment := assignment
+		badAssignment.Point = emulated.ValueOf[sw_bn254.ScalarField](inDomain)
+		badAssignment.OpeningProof, err = ValueOfOpeningProof[sw_bn254.ScalarField, sw_bn254.G1Affine](inProof)
+		assert.NoError(err)
+		assert.Error(test.IsSolved(&circuit, &badAssignment, ecc.BN254.ScalarField()))
+	}, "in-domain point")
+}
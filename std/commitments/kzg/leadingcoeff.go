@@ -0,0 +1,47 @@
+package kzg
+
+import (
+	"fmt"
+
+	"github.com/consensys/gnark/std/algebra"
+	"github.com/consensys/gnark/std/math/emulated"
+)
+
+// AssertLeadingCoefficient verifies that the polynomial committed in
+// commitment has degree at most degree with coefficient leadingCoeff at
+// X^degree. This is the "opening at infinity": as z grows, f(z)/z^degree
+// tends to the leading coefficient, and the limit is captured algebraically
+// by the remainder r(X) = f(X) - leadingCoeff·X^degree having degree at most
+// degree-1.
+//
+// The prover supplies shiftedRemainder = [α^s·r(α)]G₁ + G₁ with
+// s = D - degree + 1, where D is the SRS degree bound. The generator offset
+// encodes the degenerate case r = 0 (f is the pure monomial), which would
+// otherwise require the point at infinity. The circuit forms
+// R = C - [leadingCoeff]·[α^degree]G₁ from the SRS powers and checks
+//
+//	e(R, [α^s]G₂) · e(-shiftedRemainder, G₂) · e(G₁, G₂) == 1
+//
+// i.e. α^s·r(α) = (α^s·r(α) + 1) - 1 in the exponent. Since the shifted
+// remainder lives in the SRS group, X^s·r has degree at most D in the
+// algebraic group model, forcing deg r ≤ degree-1. srsShift = [α^s]G₂ must
+// come from the ceremony transcript, like the SRS itself.
+func (v *Verifier[FR, G1El, G2El, GTEl]) AssertLeadingCoefficient(commitment Commitment[G1El], leadingCoeff emulated.Element[FR], degree int, shiftedRemainder G1El, srs FullSRS[G1El, G2El], srsShift G2El) error {
+	maxDegree := len(srs.G1Powers) - 1
+	if degree < 1 || degree > maxDegree {
+		return fmt.Errorf("degree %d out of range [1, %d]", degree, maxDegree)
+	}
+
+	// R = C - [leadingCoeff]·[α^degree]G₁ commits to f - leadingCoeff·X^degree
+	monomial := v.curve.ScalarMul(&srs.G1Powers[degree], &leadingCoeff)
+	remainder := v.curve.Add(&commitment.G1El, v.curve.Neg(monomial))
+
+	eq := algebra.NewEquation(v.curve, v.pairing)
+	eq.AddPairing(remainder, &srsShift)
+	eq.AddPairing(v.curve.Neg(&shiftedRemainder), &srs.G2[0])
+	eq.AddPairing(&srs.G1, &srs.G2[0])
+	if err := eq.AssertEqualsOne(); err != nil {
+		return fmt.Errorf("pairing check: %w", err)
+	}
+	return nil
+}
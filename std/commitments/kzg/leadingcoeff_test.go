@@ -0,0 +1,97 @@
+package kzg
+
+import (
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	bn254 "github.com/consensys/gnark-crypto/ecc/bn254"
+	fr_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	kzg_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/kzg"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/algebra"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_bn254"
+	"github.com/consensys/gnark/std/math/emulated"
+	"github.com/consensys/gnark/test"
+)
+
+const (
+	leadingCoeffSRSSize = 32
+	leadingCoeffDegree  = 10
+)
+
+type LeadingCoeffCircuit[FR emulated.FieldParams, G1El algebra.G1ElementT, G2El algebra.G2ElementT, GTEl algebra.GtElementT] struct {
+	Srs              FullSRS[G1El, G2El]
+	Commitment       Commitment[G1El]
+	LeadingCoeff     emulated.Element[FR]
+	ShiftedRemainder G1El
+	SrsShift         G2El
+}
+
+func (c *LeadingCoeffCircuit[FR, G1El, G2El, GTEl]) Define(api frontend.API) error {
+	verifier, err := NewVerifier[FR, G1El, G2El, GTEl](api)
+	if err != nil {
+		return fmt.Errorf("new verifier: %w", err)
+	}
+	return verifier.AssertLeadingCoefficient(c.Commitment, c.LeadingCoeff, leadingCoeffDegree, c.ShiftedRemainder, c.Srs, c.SrsShift)
+}
+
+func TestAssertLeadingCoefficient(t *testing.T) {
+	assert := test.NewAssert(t)
+
+	alpha, err := rand.Int(rand.Reader, ecc.BN254.ScalarField())
+	assert.NoError(err)
+	srs, err := kzg_bn254.NewSRS(leadingCoeffSRSSize, alpha)
+	assert.NoError(err)
+
+	// a known polynomial of exact degree leadingCoeffDegree
+	f := make([]fr_bn254.Element, leadingCoeffDegree+1)
+	for i := range f {
+		f[i].SetRandom()
+	}
+	com, err := kzg_bn254.Commit(f, srs.Pk)
+	assert.NoError(err)
+
+	// X^s·r with r = f - leadingCoeff·X^degree, offset by the generator
+	maxDegree := leadingCoeffSRSSize - 1
+	shift := maxDegree - leadingCoeffDegree + 1
+	shifted := make([]fr_bn254.Element, shift+leadingCoeffDegree)
+	copy(shifted[shift:], f[:leadingCoeffDegree])
+	shiftedCommit, err := kzg_bn254.Commit(shifted, srs.Pk)
+	assert.NoError(err)
+	var shiftedRemainder bn254.G1Affine
+	shiftedRemainder.Add(&shiftedCommit, &srs.Vk.G1)
+
+	// [α^s]G₂ from the ceremony secret (known in the test)
+	sAlpha := new(big.Int).Exp(alpha, big.NewInt(int64(shift)), ecc.BN254.ScalarField())
+	var srsShift bn254.G2Affine
+	srsShift.ScalarMultiplication(&srs.Vk.G2[0], sAlpha)
+
+	wSrs, err := ValueOfFullSRS[sw_bn254.G1Affine, sw_bn254.G2Affine](srs)
+	assert.NoError(err)
+	wCom, err := ValueOfCommitment[sw_bn254.G1Affine](com)
+	assert.NoError(err)
+
+	circuit := LeadingCoeffCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{
+		Srs: FullSRS[sw_bn254.G1Affine, sw_bn254.G2Affine]{
+			G1Powers: make([]sw_bn254.G1Affine, leadingCoeffSRSSize),
+		},
+	}
+	assignment := LeadingCoeffCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{
+		Srs:              wSrs,
+		Commitment:       wCom,
+		LeadingCoeff:     emulated.ValueOf[sw_bn254.ScalarField](f[leadingCoeffDegree]),
+		ShiftedRemainder: sw_bn254.NewG1Affine(shiftedRemainder),
+		SrsShift:         sw_bn254.NewG2Affine(srsShift),
+	}
+	assert.NoError(test.IsSolved(&circuit, &assignment, ecc.BN254.ScalarField()))
+
+	// a wrong leading coefficient fails the pairing check
+	badAssignment := assignment
+	var otherCoeff fr_bn254.Element
+	otherCoeff.SetOne().Add(&otherCoeff, &f[leadingCoeffDegree])
+	badAssignment.LeadingCoeff = emulated.ValueOf[sw_bn254.ScalarField](otherCoeff)
+	assert.Error(test.IsSolved(&circuit, &badAssignment, ecc.BN254.ScalarField()))
+}
@@ -0,0 +1,90 @@
+package kzg
+
+import (
+	"fmt"
+	"math/big"
+
+	bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381"
+	fp_bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381/fp"
+	"github.com/consensys/gnark-crypto/ecc/bn254"
+	fp_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/fp"
+	bw6761 "github.com/consensys/gnark-crypto/ecc/bw6-761"
+	fp_bw6761 "github.com/consensys/gnark-crypto/ecc/bw6-761/fp"
+	"github.com/consensys/gnark/std/algebra"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_bls12381"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_bn254"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_bw6761"
+)
+
+// recomposeLimbs validates the limbs against the base field modulus and
+// recomposes them into an integer. The limbs are the little-endian base-2^nbBits
+// digits of the canonical (Montgomery-reduced) coordinate.
+func recomposeLimbs(limbs []*big.Int, nbBits int, mod *big.Int) (*big.Int, error) {
+	if nbBits <= 0 {
+		return nil, fmt.Errorf("limb width %d must be positive", nbBits)
+	}
+	expected := (mod.BitLen() + nbBits - 1) / nbBits
+	if len(limbs) != expected {
+		return nil, fmt.Errorf("expected %d limbs of %d bits, got %d", expected, nbBits, len(limbs))
+	}
+	bound := new(big.Int).Lsh(big.NewInt(1), uint(nbBits))
+	res := new(big.Int)
+	for i := len(limbs) - 1; i >= 0; i-- {
+		if limbs[i] == nil || limbs[i].Sign() < 0 || limbs[i].Cmp(bound) >= 0 {
+			return nil, fmt.Errorf("limb %d out of range", i)
+		}
+		res.Lsh(res, uint(nbBits)).Add(res, limbs[i])
+	}
+	if res.Cmp(mod) >= 0 {
+		return nil, fmt.Errorf("coordinate exceeds the base field modulus")
+	}
+	return res, nil
+}
+
+// ValueOfCommitmentFromLimbs initializes a KZG commitment witness from the
+// coordinates of the G1 point given as explicit limbs: the little-endian
+// base-2^nbBits digits of the canonical (Montgomery-reduced) coordinates. It
+// validates the limb count and ranges and that each coordinate is reduced
+// modulo the base field. This is a serialization-interop constructor for
+// external provers emitting limb layouts differing from gnark's internal
+// representation; it is only available for the emulated parametrizations.
+func ValueOfCommitmentFromLimbs[G1El algebra.G1ElementT](xLimbs, yLimbs []*big.Int, nbBits int) (Commitment[G1El], error) {
+	var ret Commitment[G1El]
+	var mod *big.Int
+	switch any(&ret).(type) {
+	case *Commitment[sw_bn254.G1Affine]:
+		mod = fp_bn254.Modulus()
+	case *Commitment[sw_bls12381.G1Affine]:
+		mod = fp_bls12381.Modulus()
+	case *Commitment[sw_bw6761.G1Affine]:
+		mod = fp_bw6761.Modulus()
+	default:
+		return ret, fmt.Errorf("limb construction is only supported for emulated parametrizations")
+	}
+	x, err := recomposeLimbs(xLimbs, nbBits, mod)
+	if err != nil {
+		return ret, fmt.Errorf("x coordinate: %w", err)
+	}
+	y, err := recomposeLimbs(yLimbs, nbBits, mod)
+	if err != nil {
+		return ret, fmt.Errorf("y coordinate: %w", err)
+	}
+	switch s := any(&ret).(type) {
+	case *Commitment[sw_bn254.G1Affine]:
+		var p bn254.G1Affine
+		p.X.SetBigInt(x)
+		p.Y.SetBigInt(y)
+		s.G1El = sw_bn254.NewG1Affine(p)
+	case *Commitment[sw_bls12381.G1Affine]:
+		var p bls12381.G1Affine
+		p.X.SetBigInt(x)
+		p.Y.SetBigInt(y)
+		s.G1El = sw_bls12381.NewG1Affine(p)
+	case *Commitment[sw_bw6761.G1Affine]:
+		var p bw6761.G1Affine
+		p.X.SetBigInt(x)
+		p.Y.SetBigInt(y)
+		s.G1El = sw_bw6761.NewG1Affine(p)
+	}
+	return ret, nil
+}
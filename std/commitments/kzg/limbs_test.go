@@ -0,0 +1,81 @@
+package kzg
+
+import (
+	"crypto/rand"
+	"math/big"
+	"reflect"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	fp_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/fp"
+	fr_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	kzg_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/kzg"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_bn254"
+	"github.com/consensys/gnark/test"
+)
+
+// limbsOf decomposes the canonical value of x into little-endian base-2^nbBits
+// digits.
+func limbsOf(x *fp_bn254.Element, nbBits int) []*big.Int {
+	v := x.BigInt(new(big.Int))
+	nbLimbs := (fp_bn254.Modulus().BitLen() + nbBits - 1) / nbBits
+	mask := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), uint(nbBits)), big.NewInt(1))
+	limbs := make([]*big.Int, nbLimbs)
+	for i := range limbs {
+		limbs[i] = new(big.Int).And(v, mask)
+		v = new(big.Int).Rsh(v, uint(nbBits))
+	}
+	return limbs
+}
+
+func TestValueOfCommitmentFromLimbs(t *testing.T) {
+	assert := test.NewAssert(t)
+
+	alpha, err := rand.Int(rand.Reader, ecc.BN254.ScalarField())
+	assert.NoError(err)
+	srs, err := kzg_bn254.NewSRS(kzgSize, alpha)
+	assert.NoError(err)
+	f := make([]fr_bn254.Element, polynomialSize)
+	for i := range f {
+		f[i].SetRandom()
+	}
+	com, err := kzg_bn254.Commit(f, srs.Pk)
+	assert.NoError(err)
+
+	expected, err := ValueOfCommitment[sw_bn254.G1Affine](com)
+	assert.NoError(err)
+
+	for _, nbBits := range []int{32, 64} {
+		xLimbs := limbsOf(&com.X, nbBits)
+		yLimbs := limbsOf(&com.Y, nbBits)
+		got, err := ValueOfCommitmentFromLimbs[sw_bn254.G1Affine](xLimbs, yLimbs, nbBits)
+		assert.NoError(err)
+		assert.True(reflect.DeepEqual(expected, got), "round trip mismatch for %d-bit limbs", nbBits)
+	}
+
+	// wrong limb count is rejected
+	xLimbs := limbsOf(&com.X, 64)
+	_, err = ValueOfCommitmentFromLimbs[sw_bn254.G1Affine](xLimbs[:3], limbsOf(&com.Y, 64), 64)
+	assert.Error(err)
+
+	// an out-of-range limb is rejected
+	badLimbs := limbsOf(&com.X, 64)
+	badLimbs[0] = new(big.Int).Lsh(big.NewInt(1), 64)
+	_, err = ValueOfCommitmentFromLimbs[sw_bn254.G1Affine](badLimbs, limbsOf(&com.Y, 64), 64)
+	assert.Error(err)
+
+	// a non-reduced coordinate is rejected
+	overflow := new(big.Int).Add(com.X.BigInt(new(big.Int)), fp_bn254.Modulus())
+	overLimbs := make([]*big.Int, len(xLimbs))
+	mask := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 64), big.NewInt(1))
+	for i := range overLimbs {
+		overLimbs[i] = new(big.Int).And(overflow, mask)
+		overflow = new(big.Int).Rsh(overflow, 64)
+	}
+	_, err = ValueOfCommitmentFromLimbs[sw_bn254.G1Affine](overLimbs, limbsOf(&com.Y, 64), 64)
+	assert.Error(err)
+
+	// native parametrizations are unsupported
+	_, err = ValueOfCommitmentFromLimbs[[2]uint64](xLimbs, limbsOf(&com.Y, 64), 64)
+	assert.Error(err)
+}
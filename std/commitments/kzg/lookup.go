@@ -0,0 +1,28 @@
+package kzg
+
+import (
+	"fmt"
+
+	"github.com/consensys/gnark/std/math/emulated"
+)
+
+// AssertTableMembership asserts that value is a member of the committed
+// lookup table. The table {t₁, ..., tₙ} is committed as its vanishing
+// polynomial
+//
+//	Z(X) = (X - t₁)·(X - t₂)···(X - tₙ),
+//
+// so membership of value is equivalent to Z(value) = 0. The witness proof is
+// a KZG opening proof of the table commitment at the point value; its claimed
+// value is ignored and replaced by the constant zero, so the proof only
+// verifies if value is a root of Z, i.e. a table entry.
+func (v *Verifier[FR, G1El, G2El, GTEl]) AssertTableMembership(tableCommitment Commitment[G1El], value emulated.Element[FR], witnessProof OpeningProof[FR, G1El], vk VerifyingKey[G1El, G2El]) error {
+	proof := OpeningProof[FR, G1El]{
+		Quotient:     witnessProof.Quotient,
+		ClaimedValue: *v.scalarApi.Zero(),
+	}
+	if err := v.CheckOpeningProof(tableCommitment, proof, value, vk); err != nil {
+		return fmt.Errorf("membership opening: %w", err)
+	}
+	return nil
+}
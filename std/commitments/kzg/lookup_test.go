@@ -0,0 +1,108 @@
+package kzg
+
+import (
+	"crypto/rand"
+	"fmt"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	fr_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	kzg_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/kzg"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/algebra"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_bn254"
+	"github.com/consensys/gnark/std/math/emulated"
+	"github.com/consensys/gnark/test"
+)
+
+type TableMembershipCircuit[FR emulated.FieldParams, G1El algebra.G1ElementT, G2El algebra.G2ElementT, GTEl algebra.GtElementT] struct {
+	Vk              VerifyingKey[G1El, G2El]
+	TableCommitment Commitment[G1El]
+	Value           emulated.Element[FR]
+	WitnessProof    OpeningProof[FR, G1El]
+}
+
+func (c *TableMembershipCircuit[FR, G1El, G2El, GTEl]) Define(api frontend.API) error {
+	verifier, err := NewVerifier[FR, G1El, G2El, GTEl](api)
+	if err != nil {
+		return fmt.Errorf("new verifier: %w", err)
+	}
+	if err := verifier.AssertTableMembership(c.TableCommitment, c.Value, c.WitnessProof, c.Vk); err != nil {
+		return fmt.Errorf("assert table membership: %w", err)
+	}
+	return nil
+}
+
+// vanishingPolynomial returns the coefficients of Π (X - rᵢ).
+func vanishingPolynomial(roots []fr_bn254.Element) []fr_bn254.Element {
+	z := make([]fr_bn254.Element, 1, len(roots)+1)
+	z[0].SetOne()
+	for _, r := range roots {
+		next := make([]fr_bn254.Element, len(z)+1)
+		var tmp fr_bn254.Element
+		for i := range z {
+			next[i+1].Add(&next[i+1], &z[i])
+			tmp.Mul(&z[i], &r)
+			next[i].Sub(&next[i], &tmp)
+		}
+		z = next
+	}
+	return z
+}
+
+func TestTableMembership(t *testing.T) {
+	assert := test.NewAssert(t)
+
+	alpha, err := rand.Int(rand.Reader, ecc.BN254.ScalarField())
+	assert.NoError(err)
+	srs, err := kzg_bn254.NewSRS(kzgSize, alpha)
+	assert.NoError(err)
+
+	// the table is committed as its vanishing polynomial
+	table := make([]fr_bn254.Element, 8)
+	for i := range table {
+		table[i].SetRandom()
+	}
+	z := vanishingPolynomial(table)
+	com, err := kzg_bn254.Commit(z, srs.Pk)
+	assert.NoError(err)
+
+	wVk, err := ValueOfVerifyingKey[sw_bn254.G1Affine, sw_bn254.G2Affine](srs.Vk)
+	assert.NoError(err)
+	wCom, err := ValueOfCommitment[sw_bn254.G1Affine](com)
+	assert.NoError(err)
+
+	circuit := TableMembershipCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{}
+
+	// an in-table value opens to zero
+	proof, err := kzg_bn254.Open(z, table[3], srs.Pk)
+	assert.NoError(err)
+	wProof, err := ValueOfOpeningProof[sw_bn254.ScalarField, sw_bn254.G1Affine](proof)
+	assert.NoError(err)
+	wValue, err := ValueOfScalar[sw_bn254.ScalarField](table[3])
+	assert.NoError(err)
+	assignment := TableMembershipCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{
+		Vk:              wVk,
+		TableCommitment: wCom,
+		Value:           wValue,
+		WitnessProof:    wProof,
+	}
+	assert.NoError(test.IsSolved(&circuit, &assignment, ecc.BN254.ScalarField()))
+
+	// an out-of-table value does not
+	var outside fr_bn254.Element
+	outside.SetRandom()
+	badProof, err := kzg_bn254.Open(z, outside, srs.Pk)
+	assert.NoError(err)
+	wBadProof, err := ValueOfOpeningProof[sw_bn254.ScalarField, sw_bn254.G1Affine](badProof)
+	assert.NoError(err)
+	wOutside, err := ValueOfScalar[sw_bn254.ScalarField](outside)
+	assert.NoError(err)
+	badAssignment := TableMembershipCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{
+		Vk:              wVk,
+		TableCommitment: wCom,
+		Value:           wOutside,
+		WitnessProof:    wBadProof,
+	}
+	assert.Error(test.IsSolved(&circuit, &badAssignment, ecc.BN254.ScalarField()))
+}
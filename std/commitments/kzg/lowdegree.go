@@ -0,0 +1,107 @@
+package kzg
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/consensys/gnark/std/math/emulated"
+)
+
+// maxDomainSize bounds the domain size accepted by
+// [Verifier.AssertLowDegree]. The in-circuit IFFT performs n·log(n) emulated
+// multiplications and the recomputed commitment an n-point MSM, so the check
+// is only feasible for small domains.
+const maxDomainSize = 256
+
+// Domain is a multiplicative subgroup of the scalar field over which
+// evaluations are interpolated. The parameters are compile-time constants,
+// typically taken from the matching gnark-crypto fft.Domain.
+type Domain struct {
+	// Size is the cardinality of the subgroup. It must be a power of two.
+	Size uint64
+	// GeneratorInv is the inverse of the subgroup generator.
+	GeneratorInv *big.Int
+	// CardinalityInv is the inverse of the cardinality in the scalar field.
+	CardinalityInv *big.Int
+}
+
+// NewDomain returns a domain of the given size.
+func NewDomain(size uint64, generatorInv, cardinalityInv *big.Int) (*Domain, error) {
+	if size == 0 || size&(size-1) != 0 {
+		return nil, fmt.Errorf("domain size %d is not a power of two", size)
+	}
+	return &Domain{Size: size, GeneratorInv: generatorInv, CardinalityInv: cardinalityInv}, nil
+}
+
+// AssertLowDegree asserts that the commitment opens to the polynomial of
+// degree less than domain.Size interpolating the given evaluations over the
+// domain. The monomial-basis coefficients are recomputed with an in-circuit
+// IFFT and committed with an MSM over the SRS powers srsG1 (see
+// [FullSRS.G1Powers]), and the result is asserted equal to the commitment.
+// The check is only feasible for small domains, up to [maxDomainSize].
+func (v *Verifier[FR, G1El, G2El, GTEl]) AssertLowDegree(commitment Commitment[G1El], evals []emulated.Element[FR], domain *Domain, srsG1 []G1El) error {
+	n := len(evals)
+	if uint64(n) != domain.Size {
+		return fmt.Errorf("number of evaluations %d doesn't match domain size %d", n, domain.Size)
+	}
+	if domain.Size > maxDomainSize {
+		return fmt.Errorf("domain size %d exceeds maximum %d", domain.Size, maxDomainSize)
+	}
+	if len(srsG1) < n {
+		return fmt.Errorf("not enough SRS powers for %d coefficients", n)
+	}
+	var fr FR
+	mod := fr.Modulus()
+
+	// coefficients = 1/n · FFT(evals, ω⁻¹)
+	ptrs := make([]*emulated.Element[FR], n)
+	for i := range evals {
+		ptrs[i] = &evals[i]
+	}
+	coeffs := v.fft(ptrs, new(big.Int).Set(domain.GeneratorInv), mod)
+	cardInv := emulated.ValueOf[FR](domain.CardinalityInv)
+	for i := range coeffs {
+		coeffs[i] = v.scalarApi.Mul(coeffs[i], &cardInv)
+	}
+
+	points := make([]*G1El, n)
+	for i := range points {
+		points[i] = &srsG1[i]
+	}
+	computed, err := v.curve.MultiScalarMul(points, coeffs)
+	if err != nil {
+		return fmt.Errorf("recompute commitment: %w", err)
+	}
+	v.curve.AssertIsEqual(computed, &commitment.G1El)
+	return nil
+}
+
+// fft computes the radix-2 FFT of a over the subgroup generated by the
+// compile-time constant w.
+func (v *Verifier[FR, G1El, G2El, GTEl]) fft(a []*emulated.Element[FR], w, mod *big.Int) []*emulated.Element[FR] {
+	n := len(a)
+	if n == 1 {
+		return a
+	}
+	even := make([]*emulated.Element[FR], n/2)
+	odd := make([]*emulated.Element[FR], n/2)
+	for i := 0; i < n/2; i++ {
+		even[i] = a[2*i]
+		odd[i] = a[2*i+1]
+	}
+	w2 := new(big.Int).Mul(w, w)
+	w2.Mod(w2, mod)
+	e := v.fft(even, w2, mod)
+	o := v.fft(odd, w2, mod)
+
+	out := make([]*emulated.Element[FR], n)
+	wk := big.NewInt(1)
+	for k := 0; k < n/2; k++ {
+		wkEl := emulated.ValueOf[FR](wk)
+		t := v.scalarApi.Mul(o[k], &wkEl)
+		out[k] = v.scalarApi.Add(e[k], t)
+		out[k+n/2] = v.scalarApi.Sub(e[k], t)
+		wk.Mul(wk, w).Mod(wk, mod)
+	}
+	return out
+}
@@ -0,0 +1,81 @@
+package kzg
+
+import (
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	fr_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr/fft"
+	kzg_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/kzg"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/algebra"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_bn254"
+	"github.com/consensys/gnark/std/math/emulated"
+	"github.com/consensys/gnark/test"
+)
+
+type LowDegreeCircuit[FR emulated.FieldParams, G1El algebra.G1ElementT, G2El algebra.G2ElementT, GTEl algebra.GtElementT] struct {
+	Srs        FullSRS[G1El, G2El]
+	Commitment Commitment[G1El]
+	Evals      [8]emulated.Element[FR]
+	domain     *Domain
+}
+
+func (c *LowDegreeCircuit[FR, G1El, G2El, GTEl]) Define(api frontend.API) error {
+	verifier, err := NewVerifier[FR, G1El, G2El, GTEl](api)
+	if err != nil {
+		return fmt.Errorf("new verifier: %w", err)
+	}
+	if err := verifier.AssertLowDegree(c.Commitment, c.Evals[:], c.domain, c.Srs.G1Powers); err != nil {
+		return fmt.Errorf("assert low degree: %w", err)
+	}
+	return nil
+}
+
+func TestAssertLowDegree(t *testing.T) {
+	assert := test.NewAssert(t)
+	const domainSize = 8
+
+	alpha, err := rand.Int(rand.Reader, ecc.BN254.ScalarField())
+	assert.NoError(err)
+	srs, err := kzg_bn254.NewSRS(domainSize, alpha)
+	assert.NoError(err)
+
+	domain := fft.NewDomain(domainSize)
+	evals := make([]fr_bn254.Element, domainSize)
+	for i := range evals {
+		evals[i].SetRandom()
+	}
+	// interpolate natively and commit to the coefficients
+	coeffs := make([]fr_bn254.Element, domainSize)
+	copy(coeffs, evals)
+	domain.FFTInverse(coeffs, fft.DIF)
+	fft.BitReverse(coeffs)
+	com, err := kzg_bn254.Commit(coeffs, srs.Pk)
+	assert.NoError(err)
+
+	wSrs, err := ValueOfFullSRS[sw_bn254.G1Affine, sw_bn254.G2Affine](srs)
+	assert.NoError(err)
+	wCom, err := ValueOfCommitment[sw_bn254.G1Affine](com)
+	assert.NoError(err)
+
+	wDomain, err := NewDomain(domainSize, domain.GeneratorInv.BigInt(new(big.Int)), domain.CardinalityInv.BigInt(new(big.Int)))
+	assert.NoError(err)
+
+	circuit := LowDegreeCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{
+		domain: wDomain,
+	}
+	circuit.Srs.G1Powers = make([]sw_bn254.G1Affine, domainSize)
+	assignment := LowDegreeCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{
+		Srs:        wSrs,
+		Commitment: wCom,
+	}
+	for i := range evals {
+		assignment.Evals[i], err = ValueOfScalar[sw_bn254.ScalarField](evals[i])
+		assert.NoError(err)
+	}
+	assert.NoError(test.IsSolved(&circuit, &assignment, ecc.BN254.ScalarField()))
+}
@@ -0,0 +1,226 @@
+package kzg
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math/big"
+	"reflect"
+
+	"github.com/consensys/gnark/internal/utils"
+)
+
+// witnessEncodingVersion tags the serialization format of the KZG witness
+// types. Bump it when the encoding changes incompatibly.
+const witnessEncodingVersion = 1
+
+var witnessEncodingMagic = [3]byte{'k', 'z', 'g'}
+
+// MarshalBinary serializes the commitment witness. The encoding starts with a
+// version and a tag of the concrete type parameters, so a blob written for
+// one curve cannot be silently decoded for another. The witness must be an
+// assignment, i.e. all variables must hold constant values as produced by
+// [ValueOfCommitment].
+func (c *Commitment[G1El]) MarshalBinary() ([]byte, error) {
+	return marshalWitness(c)
+}
+
+// UnmarshalBinary deserializes the commitment witness serialized with
+// [Commitment.MarshalBinary]. It returns an error if the blob was written
+// for different type parameters.
+func (c *Commitment[G1El]) UnmarshalBinary(data []byte) error {
+	return unmarshalWitness(c, data)
+}
+
+// MarshalBinary serializes the opening proof witness. See
+// [Commitment.MarshalBinary] for the encoding properties.
+func (p *OpeningProof[FR, G1El]) MarshalBinary() ([]byte, error) {
+	return marshalWitness(p)
+}
+
+// UnmarshalBinary deserializes the opening proof witness serialized with
+// [OpeningProof.MarshalBinary].
+func (p *OpeningProof[FR, G1El]) UnmarshalBinary(data []byte) error {
+	return unmarshalWitness(p, data)
+}
+
+// MarshalBinary serializes the verifying key witness. See
+// [Commitment.MarshalBinary] for the encoding properties.
+func (vk *VerifyingKey[G1El, G2El]) MarshalBinary() ([]byte, error) {
+	return marshalWitness(vk)
+}
+
+// UnmarshalBinary deserializes the verifying key witness serialized with
+// [VerifyingKey.MarshalBinary].
+func (vk *VerifyingKey[G1El, G2El]) UnmarshalBinary(data []byte) error {
+	return unmarshalWitness(vk, data)
+}
+
+// MarshalBinary serializes the full SRS witness. See
+// [Commitment.MarshalBinary] for the encoding properties.
+func (s *FullSRS[G1El, G2El]) MarshalBinary() ([]byte, error) {
+	return marshalWitness(s)
+}
+
+// UnmarshalBinary deserializes the full SRS witness serialized with
+// [FullSRS.MarshalBinary].
+func (s *FullSRS[G1El, G2El]) UnmarshalBinary(data []byte) error {
+	return unmarshalWitness(s, data)
+}
+
+// marshalWitness encodes the constant assignment behind the pointer v. The
+// layout is the magic, the version, the length-prefixed concrete type string
+// and then the leaves of the struct in field order: every variable as a
+// length-prefixed big-endian integer, every slice prefixed with its length
+// and every pointer with a presence byte. The type string pins both the
+// gadget type and its type parameters, which is what distinguishes the
+// curves.
+func marshalWitness(v any) ([]byte, error) {
+	buf := append([]byte{}, witnessEncodingMagic[:]...)
+	buf = append(buf, witnessEncodingVersion)
+	buf = appendBytes(buf, []byte(reflect.TypeOf(v).Elem().String()))
+	return marshalValue(buf, reflect.ValueOf(v).Elem())
+}
+
+// unmarshalWitness decodes into the pointed-to value data serialized with
+// [marshalWitness], checking the magic, the version and the concrete type
+// tag first.
+func unmarshalWitness(v any, data []byte) error {
+	if len(data) < len(witnessEncodingMagic)+1 {
+		return fmt.Errorf("blob too short")
+	}
+	if [3]byte(data[:3]) != witnessEncodingMagic {
+		return fmt.Errorf("invalid magic")
+	}
+	if data[3] != witnessEncodingVersion {
+		return fmt.Errorf("unsupported encoding version %d", data[3])
+	}
+	tag, rest, err := readBytes(data[4:])
+	if err != nil {
+		return fmt.Errorf("read type tag: %w", err)
+	}
+	if expected := reflect.TypeOf(v).Elem().String(); string(tag) != expected {
+		return fmt.Errorf("type mismatch: blob encodes %s, decoding into %s", tag, expected)
+	}
+	rest, err = unmarshalValue(rest, reflect.ValueOf(v).Elem())
+	if err != nil {
+		return err
+	}
+	if len(rest) != 0 {
+		return fmt.Errorf("%d trailing bytes", len(rest))
+	}
+	return nil
+}
+
+func marshalValue(buf []byte, v reflect.Value) ([]byte, error) {
+	var err error
+	switch v.Kind() {
+	case reflect.Interface:
+		// a frontend.Variable leaf; it must hold a constant assignment
+		if v.IsNil() {
+			return nil, fmt.Errorf("unassigned variable")
+		}
+		b := utils.FromInterface(v.Interface())
+		return appendBytes(buf, b.Bytes()), nil
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			if !v.Type().Field(i).IsExported() {
+				continue
+			}
+			if buf, err = marshalValue(buf, v.Field(i)); err != nil {
+				return nil, err
+			}
+		}
+		return buf, nil
+	case reflect.Slice:
+		buf = binary.BigEndian.AppendUint32(buf, uint32(v.Len()))
+		fallthrough
+	case reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			if buf, err = marshalValue(buf, v.Index(i)); err != nil {
+				return nil, err
+			}
+		}
+		return buf, nil
+	case reflect.Pointer:
+		if v.IsNil() {
+			return append(buf, 0), nil
+		}
+		return marshalValue(append(buf, 1), v.Elem())
+	default:
+		return nil, fmt.Errorf("unsupported kind %s", v.Kind())
+	}
+}
+
+func unmarshalValue(data []byte, v reflect.Value) ([]byte, error) {
+	var err error
+	switch v.Kind() {
+	case reflect.Interface:
+		b, rest, err := readBytes(data)
+		if err != nil {
+			return nil, fmt.Errorf("read variable: %w", err)
+		}
+		v.Set(reflect.ValueOf(new(big.Int).SetBytes(b)))
+		return rest, nil
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			if !v.Type().Field(i).IsExported() {
+				continue
+			}
+			if data, err = unmarshalValue(data, v.Field(i)); err != nil {
+				return nil, err
+			}
+		}
+		return data, nil
+	case reflect.Slice:
+		if len(data) < 4 {
+			return nil, fmt.Errorf("truncated slice length")
+		}
+		n := int(binary.BigEndian.Uint32(data))
+		data = data[4:]
+		v.Set(reflect.MakeSlice(v.Type(), n, n))
+		for i := 0; i < n; i++ {
+			if data, err = unmarshalValue(data, v.Index(i)); err != nil {
+				return nil, err
+			}
+		}
+		return data, nil
+	case reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			if data, err = unmarshalValue(data, v.Index(i)); err != nil {
+				return nil, err
+			}
+		}
+		return data, nil
+	case reflect.Pointer:
+		if len(data) < 1 {
+			return nil, fmt.Errorf("truncated pointer tag")
+		}
+		present := data[0] == 1
+		data = data[1:]
+		if !present {
+			v.Set(reflect.Zero(v.Type()))
+			return data, nil
+		}
+		v.Set(reflect.New(v.Type().Elem()))
+		return unmarshalValue(data, v.Elem())
+	default:
+		return nil, fmt.Errorf("unsupported kind %s", v.Kind())
+	}
+}
+
+func appendBytes(buf, b []byte) []byte {
+	buf = binary.BigEndian.AppendUint32(buf, uint32(len(b)))
+	return append(buf, b...)
+}
+
+func readBytes(data []byte) ([]byte, []byte, error) {
+	if len(data) < 4 {
+		return nil, nil, fmt.Errorf("truncated length prefix")
+	}
+	n := int(binary.BigEndian.Uint32(data))
+	data = data[4:]
+	if len(data) < n {
+		return nil, nil, fmt.Errorf("truncated payload")
+	}
+	return data[:n], data[n:], nil
+}
@@ -0,0 +1,96 @@
+package kzg
+
+import (
+	"crypto/rand"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	fr_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	kzg_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/kzg"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_bn254"
+	"github.com/consensys/gnark/std/algebra/native/sw_bls12377"
+	"github.com/consensys/gnark/test"
+)
+
+func TestWitnessBinaryRoundTrip(t *testing.T) {
+	assert := test.NewAssert(t)
+
+	alpha, err := rand.Int(rand.Reader, ecc.BN254.ScalarField())
+	assert.NoError(err)
+	srs, err := kzg_bn254.NewSRS(kzgSize, alpha)
+	assert.NoError(err)
+	f := make([]fr_bn254.Element, polynomialSize)
+	for i := range f {
+		f[i].SetRandom()
+	}
+	com, err := kzg_bn254.Commit(f, srs.Pk)
+	assert.NoError(err)
+	var point fr_bn254.Element
+	point.SetRandom()
+	proof, err := kzg_bn254.Open(f, point, srs.Pk)
+	assert.NoError(err)
+
+	wCmt, err := ValueOfCommitment[sw_bn254.G1Affine](com)
+	assert.NoError(err)
+	wProof, err := ValueOfOpeningProof[sw_bn254.ScalarField, sw_bn254.G1Affine](proof)
+	assert.NoError(err)
+	wVk, err := ValueOfVerifyingKey[sw_bn254.G1Affine, sw_bn254.G2Affine](srs.Vk)
+	assert.NoError(err)
+	wPoint, err := ValueOfScalar[sw_bn254.ScalarField](point)
+	assert.NoError(err)
+
+	var decCmt Commitment[sw_bn254.G1Affine]
+	var decProof OpeningProof[sw_bn254.ScalarField, sw_bn254.G1Affine]
+	var decVk VerifyingKey[sw_bn254.G1Affine, sw_bn254.G2Affine]
+
+	assert.Run(func(assert *test.Assert) {
+		blob, err := wCmt.MarshalBinary()
+		assert.NoError(err)
+		assert.NoError(decCmt.UnmarshalBinary(blob))
+		blob, err = wProof.MarshalBinary()
+		assert.NoError(err)
+		assert.NoError(decProof.UnmarshalBinary(blob))
+		blob, err = wVk.MarshalBinary()
+		assert.NoError(err)
+		assert.NoError(decVk.UnmarshalBinary(blob))
+	}, "roundtrip")
+
+	assert.Run(func(assert *test.Assert) {
+		// the decoded witness verifies as the original would
+		circuit := KZGVerificationCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{}
+		assignment := KZGVerificationCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{
+			VerifyingKey: decVk,
+			Commitment:   decCmt,
+			OpeningProof: decProof,
+			Point:        wPoint,
+		}
+		assert.NoError(test.IsSolved(&circuit, &assignment, ecc.BN254.ScalarField()))
+	}, "decoded witness verifies")
+
+	assert.Run(func(assert *test.Assert) {
+		// a blob written for one curve cannot be decoded for another
+		blob, err := wCmt.MarshalBinary()
+		assert.NoError(err)
+		var other Commitment[sw_bls12377.G1Affine]
+		assert.Error(other.UnmarshalBinary(blob))
+	}, "curve mismatch")
+
+	assert.Run(func(assert *test.Assert) {
+		blob, err := wProof.MarshalBinary()
+		assert.NoError(err)
+		assert.Error(decProof.UnmarshalBinary(blob[:len(blob)-1]))
+		blob[3] = witnessEncodingVersion + 1
+		assert.Error(decProof.UnmarshalBinary(blob))
+	}, "malformed blob")
+
+	assert.Run(func(assert *test.Assert) {
+		// the full SRS witness round-trips including the G1 powers
+		wSrs, err := ValueOfFullSRS[sw_bn254.G1Affine, sw_bn254.G2Affine](srs)
+		assert.NoError(err)
+		blob, err := wSrs.MarshalBinary()
+		assert.NoError(err)
+		var decSrs FullSRS[sw_bn254.G1Affine, sw_bn254.G2Affine]
+		assert.NoError(decSrs.UnmarshalBinary(blob))
+		assert.Equal(len(wSrs.G1Powers), len(decSrs.G1Powers))
+	}, "full SRS")
+}
@@ -0,0 +1,130 @@
+package kzg
+
+import (
+	"fmt"
+
+	bls12377 "github.com/consensys/gnark-crypto/ecc/bls12-377"
+	bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381"
+	bls24315 "github.com/consensys/gnark-crypto/ecc/bls24-315"
+	"github.com/consensys/gnark-crypto/ecc/bn254"
+	bw6761 "github.com/consensys/gnark-crypto/ecc/bw6-761"
+	"github.com/consensys/gnark/std/algebra"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_bls12381"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_bn254"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_bw6761"
+	"github.com/consensys/gnark/std/algebra/native/sw_bls12377"
+	"github.com/consensys/gnark/std/algebra/native/sw_bls24315"
+	"github.com/consensys/gnark/std/math/emulated"
+)
+
+// MillerProduct computes the product of Miller loops over the pairing inputs
+// of the opening check, without performing the final exponentiation. The
+// opening proof is valid if and only if the final exponentiation of the result
+// is one in GT.
+//
+// The method allows splitting heavy verification across circuit boundaries:
+// the result is a GT element whose emulated limbs can be declared as public
+// inputs and passed to a subsequent circuit which completes the check with
+// [Verifier.AssertFinalExponentiationIsOne]. Use [ValueOfGt] to assign the
+// natively computed Miller product to the second circuit's witness. See also
+// [Verifier.ProofPairingInputs] for amortizing several checks inside a single
+// circuit instead.
+func (v *Verifier[FR, G1El, G2El, GTEl]) MillerProduct(commitment Commitment[G1El], proof OpeningProof[FR, G1El], point emulated.Element[FR], vk VerifyingKey[G1El, G2El]) (*GTEl, error) {
+	g1Points, g2Points, err := v.ProofPairingInputs(commitment, proof, point, vk)
+	if err != nil {
+		return nil, fmt.Errorf("proof pairing inputs: %w", err)
+	}
+	res, err := v.pairing.MillerLoop(g1Points, g2Points)
+	if err != nil {
+		return nil, fmt.Errorf("miller loop: %w", err)
+	}
+	return res, nil
+}
+
+// AssertFinalExponentiationIsOne asserts that the final exponentiation of the
+// given Miller loop result is one in GT, completing an opening check whose
+// Miller product was computed by [Verifier.MillerProduct], possibly in a
+// different circuit. The input must be a Miller loop output; the assertion is
+// not complete for arbitrary GT elements.
+func (v *Verifier[FR, G1El, G2El, GTEl]) AssertFinalExponentiationIsOne(millerProduct *GTEl) error {
+	one, err := oneGt[GTEl]()
+	if err != nil {
+		return fmt.Errorf("one in GT: %w", err)
+	}
+	res := v.pairing.FinalExponentiation(millerProduct)
+	v.pairing.AssertIsEqual(res, one)
+	return nil
+}
+
+// ValueOfGt initializes a GT element in a witness from a native GT element,
+// e.g. a Miller loop result computed by the native prover. It returns an error
+// if there is a conflict between the type parameter and the provided native
+// element type.
+func ValueOfGt[GTEl algebra.GtElementT](gt any) (GTEl, error) {
+	var ret GTEl
+	switch s := any(&ret).(type) {
+	case *sw_bn254.GTEl:
+		tGt, ok := gt.(bn254.GT)
+		if !ok {
+			return ret, fmt.Errorf("mismatching types %T %T", ret, gt)
+		}
+		*s = sw_bn254.NewGTEl(tGt)
+	case *sw_bls12377.GT:
+		tGt, ok := gt.(bls12377.GT)
+		if !ok {
+			return ret, fmt.Errorf("mismatching types %T %T", ret, gt)
+		}
+		*s = sw_bls12377.NewGTEl(tGt)
+	case *sw_bls12381.GTEl:
+		tGt, ok := gt.(bls12381.GT)
+		if !ok {
+			return ret, fmt.Errorf("mismatching types %T %T", ret, gt)
+		}
+		*s = sw_bls12381.NewGTEl(tGt)
+	case *sw_bw6761.GTEl:
+		tGt, ok := gt.(bw6761.GT)
+		if !ok {
+			return ret, fmt.Errorf("mismatching types %T %T", ret, gt)
+		}
+		*s = sw_bw6761.NewGTEl(tGt)
+	case *sw_bls24315.GT:
+		tGt, ok := gt.(bls24315.GT)
+		if !ok {
+			return ret, fmt.Errorf("mismatching types %T %T", ret, gt)
+		}
+		*s = sw_bls24315.NewGTEl(tGt)
+	default:
+		return ret, fmt.Errorf("unknown type parametrization")
+	}
+	return ret, nil
+}
+
+// oneGt returns the multiplicative identity of GT as circuit constants.
+func oneGt[GTEl algebra.GtElementT]() (*GTEl, error) {
+	var ret GTEl
+	switch s := any(&ret).(type) {
+	case *sw_bn254.GTEl:
+		var one bn254.GT
+		one.SetOne()
+		*s = sw_bn254.NewGTEl(one)
+	case *sw_bls12377.GT:
+		var one bls12377.GT
+		one.SetOne()
+		*s = sw_bls12377.NewGTEl(one)
+	case *sw_bls12381.GTEl:
+		var one bls12381.GT
+		one.SetOne()
+		*s = sw_bls12381.NewGTEl(one)
+	case *sw_bw6761.GTEl:
+		var one bw6761.GT
+		one.SetOne()
+		*s = sw_bw6761.NewGTEl(one)
+	case *sw_bls24315.GT:
+		var one bls24315.GT
+		one.SetOne()
+		*s = sw_bls24315.NewGTEl(one)
+	default:
+		return nil, fmt.Errorf("unknown type parametrization")
+	}
+	return &ret, nil
+}
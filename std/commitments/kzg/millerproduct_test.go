@@ -0,0 +1,133 @@
+package kzg
+
+import (
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bn254"
+	fr_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	kzg_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/kzg"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/algebra"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_bn254"
+	"github.com/consensys/gnark/std/math/emulated"
+	"github.com/consensys/gnark/test"
+)
+
+// MillerProductCircuit is the first stage of a split verification: it computes
+// the Miller product of the opening check and binds it to a public GT element.
+type MillerProductCircuit[FR emulated.FieldParams, G1El algebra.G1ElementT, G2El algebra.G2ElementT, GTEl algebra.GtElementT] struct {
+	VerifyingKey[G1El, G2El]
+	Commitment    Commitment[G1El]
+	Proof         OpeningProof[FR, G1El]
+	Point         emulated.Element[FR]
+	MillerProduct GTEl `gnark:",public"`
+}
+
+func (c *MillerProductCircuit[FR, G1El, G2El, GTEl]) Define(api frontend.API) error {
+	verifier, err := NewVerifier[FR, G1El, G2El, GTEl](api)
+	if err != nil {
+		return fmt.Errorf("new verifier: %w", err)
+	}
+	res, err := verifier.MillerProduct(c.Commitment, c.Proof, c.Point, c.VerifyingKey)
+	if err != nil {
+		return fmt.Errorf("miller product: %w", err)
+	}
+	verifier.pairing.AssertIsEqual(res, &c.MillerProduct)
+	return nil
+}
+
+// DeferredFinalExpCircuit is the second stage: it receives the Miller product
+// as a public input and completes the opening check.
+type DeferredFinalExpCircuit[FR emulated.FieldParams, G1El algebra.G1ElementT, G2El algebra.G2ElementT, GTEl algebra.GtElementT] struct {
+	MillerProduct GTEl `gnark:",public"`
+}
+
+func (c *DeferredFinalExpCircuit[FR, G1El, G2El, GTEl]) Define(api frontend.API) error {
+	verifier, err := NewVerifier[FR, G1El, G2El, GTEl](api)
+	if err != nil {
+		return fmt.Errorf("new verifier: %w", err)
+	}
+	return verifier.AssertFinalExponentiationIsOne(&c.MillerProduct)
+}
+
+func TestMillerProductTwoStage(t *testing.T) {
+	assert := test.NewAssert(t)
+
+	alpha, err := rand.Int(rand.Reader, ecc.BN254.ScalarField())
+	assert.NoError(err)
+	srs, err := kzg_bn254.NewSRS(kzgSize, alpha)
+	assert.NoError(err)
+
+	f := make([]fr_bn254.Element, polynomialSize)
+	for i := range f {
+		f[i].SetRandom()
+	}
+	com, err := kzg_bn254.Commit(f, srs.Pk)
+	assert.NoError(err)
+	var point fr_bn254.Element
+	point.SetRandom()
+	proof, err := kzg_bn254.Open(f, point, srs.Pk)
+	assert.NoError(err)
+
+	// replicate the pairing inputs of the opening check natively:
+	// e([f(a)-f(α)-a*H(α)]G₁, G₂)·e([H(α)]G₁, [α]G₂) == 1
+	millerProduct := func(claimedValue fr_bn254.Element) bn254.GT {
+		var fG1, aH, totalG1 bn254.G1Affine
+		fG1.ScalarMultiplication(&srs.Vk.G1, claimedValue.BigInt(new(big.Int)))
+		aH.ScalarMultiplication(&proof.H, point.BigInt(new(big.Int)))
+		totalG1.Sub(&fG1, &aH)
+		totalG1.Sub(&totalG1, &com)
+		lines0 := bn254.PrecomputeLines(srs.Vk.G2[0])
+		lines1 := bn254.PrecomputeLines(srs.Vk.G2[1])
+		res, err := bn254.MillerLoopFixedQ(
+			[]bn254.G1Affine{totalG1, proof.H},
+			[][2][len(bn254.LoopCounter)]bn254.LineEvaluationAff{lines0, lines1},
+		)
+		assert.NoError(err)
+		return res
+	}
+	validProduct := millerProduct(proof.ClaimedValue)
+
+	stageOneAssignment := MillerProductCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{
+		Point: emulated.ValueOf[sw_bn254.ScalarField](point),
+	}
+	stageOneAssignment.VerifyingKey, err = ValueOfVerifyingKey[sw_bn254.G1Affine, sw_bn254.G2Affine](srs.Vk)
+	assert.NoError(err)
+	stageOneAssignment.Commitment, err = ValueOfCommitment[sw_bn254.G1Affine](com)
+	assert.NoError(err)
+	stageOneAssignment.Proof, err = ValueOfOpeningProof[sw_bn254.ScalarField, sw_bn254.G1Affine](proof)
+	assert.NoError(err)
+	stageOneAssignment.MillerProduct, err = ValueOfGt[sw_bn254.GTEl](validProduct)
+	assert.NoError(err)
+
+	stageOneCircuit := MillerProductCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{}
+
+	assert.Run(func(assert *test.Assert) {
+		assert.NoError(test.IsSolved(&stageOneCircuit, &stageOneAssignment, ecc.BN254.ScalarField()))
+	}, "stage one")
+
+	stageTwoAssignment := DeferredFinalExpCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{}
+	stageTwoAssignment.MillerProduct, err = ValueOfGt[sw_bn254.GTEl](validProduct)
+	assert.NoError(err)
+
+	stageTwoCircuit := DeferredFinalExpCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{}
+
+	assert.Run(func(assert *test.Assert) {
+		assert.NoError(test.IsSolved(&stageTwoCircuit, &stageTwoAssignment, ecc.BN254.ScalarField()))
+	}, "stage two")
+
+	assert.Run(func(assert *test.Assert) {
+		// the Miller product of a tampered opening does not final-exponentiate
+		// to one
+		var otherValue fr_bn254.Element
+		otherValue.SetRandom()
+		badAssignment := stageTwoAssignment
+		badAssignment.MillerProduct, err = ValueOfGt[sw_bn254.GTEl](millerProduct(otherValue))
+		assert.NoError(err)
+		assert.Error(test.IsSolved(&stageTwoCircuit, &badAssignment, ecc.BN254.ScalarField()))
+	}, "stage two tampered")
+}
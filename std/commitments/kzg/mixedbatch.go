@@ -0,0 +1,181 @@
+package kzg
+
+import (
+	"fmt"
+
+	"github.com/consensys/gnark/std/algebra"
+	"github.com/consensys/gnark/std/math/emulated"
+)
+
+// SchemeProof is one item of a heterogeneous opening batch, implemented by
+// [KZGSchemeProof] and [ShplonkSchemeProof]. Each scheme reduces its
+// verification equation to a pair of G1 terms (P₀ᵢ, P₁ᵢ) such that the item
+// is valid iff
+//
+//	e(P₀ᵢ, G₂)·e(P₁ᵢ, [α]G₂) == 1,
+//
+// which lets [Verifier.AssertMixedSchemeBatch] accumulate all items into
+// shared Miller-loop terms before a single final exponentiation.
+type SchemeProof[FR emulated.FieldParams, G1El algebra.G1ElementT, G2El algebra.G2ElementT, GTEl algebra.GtElementT] interface {
+	pairingTerms(v *Verifier[FR, G1El, G2El, GTEl], vk VerifyingKey[G1El, G2El]) (*G1El, *G1El, error)
+}
+
+// KZGSchemeProof is a standard single-point KZG opening item of a
+// heterogeneous batch.
+type KZGSchemeProof[FR emulated.FieldParams, G1El algebra.G1ElementT, G2El algebra.G2ElementT, GTEl algebra.GtElementT] struct {
+	Commitment Commitment[G1El]
+	Proof      OpeningProof[FR, G1El]
+	Point      emulated.Element[FR]
+}
+
+func (p *KZGSchemeProof[FR, G1El, G2El, GTEl]) pairingTerms(v *Verifier[FR, G1El, G2El, GTEl], vk VerifyingKey[G1El, G2El]) (*G1El, *G1El, error) {
+	g1Points, _, err := v.ProofPairingInputs(p.Commitment, p.Proof, p.Point, vk)
+	if err != nil {
+		return nil, nil, fmt.Errorf("proof pairing inputs: %w", err)
+	}
+	return g1Points[0], g1Points[1], nil
+}
+
+// ShplonkSchemeProof is a BDFG20 aggregated opening item of a heterogeneous
+// batch: each commitment is opened at its own point to the claimed value, W
+// commits to the aggregated quotient and WPrime opens the linearized
+// combination at the evaluation challenge Z. Gamma and Z are the folding and
+// evaluation challenges; for soundness the caller must derive Gamma by
+// Fiat-Shamir after absorbing the commitments, points and values, and Z after
+// additionally absorbing W.
+type ShplonkSchemeProof[FR emulated.FieldParams, G1El algebra.G1ElementT, G2El algebra.G2ElementT, GTEl algebra.GtElementT] struct {
+	Commitments   []Commitment[G1El]
+	Points        []emulated.Element[FR]
+	ClaimedValues []emulated.Element[FR]
+	W, WPrime     G1El
+	Gamma, Z      emulated.Element[FR]
+}
+
+func (p *ShplonkSchemeProof[FR, G1El, G2El, GTEl]) pairingTerms(v *Verifier[FR, G1El, G2El, GTEl], vk VerifyingKey[G1El, G2El]) (*G1El, *G1El, error) {
+	if len(p.Commitments) != len(p.Points) {
+		return nil, nil, fmt.Errorf("number of commitments doesn't match number of points")
+	}
+	if len(p.Commitments) != len(p.ClaimedValues) {
+		return nil, nil, fmt.Errorf("number of commitments doesn't match number of claimed values")
+	}
+	if len(p.Commitments) == 0 {
+		return nil, nil, fmt.Errorf("number of commitments should be nonzero")
+	}
+	n := len(p.Commitments)
+
+	// γⁱ·Zᵢ(z) with Zᵢ(z) = Π_{j≠i}(z - zⱼ), and Z(z) = Π_j(z - zⱼ)
+	coeffs := make([]*emulated.Element[FR], n)
+	gammaPow := v.scalarApi.One()
+	zS := v.scalarApi.One()
+	for i := 0; i < n; i++ {
+		zi := v.scalarApi.One()
+		for j := 0; j < n; j++ {
+			diff := v.scalarApi.Sub(&p.Z, &p.Points[j])
+			if j != i {
+				zi = v.scalarApi.Mul(zi, diff)
+			}
+			if i == 0 {
+				zS = v.scalarApi.Mul(zS, diff)
+			}
+		}
+		coeffs[i] = v.scalarApi.Mul(gammaPow, zi)
+		gammaPow = v.scalarApi.Mul(gammaPow, &p.Gamma)
+	}
+	// -Σᵢ γⁱZᵢ(z)·sᵢ
+	folded := v.scalarApi.Zero()
+	for i := 0; i < n; i++ {
+		folded = v.scalarApi.Add(folded, v.scalarApi.Mul(coeffs[i], &p.ClaimedValues[i]))
+	}
+	folded = v.scalarApi.Neg(folded)
+
+	// Σᵢ γⁱZᵢ(z)·Cᵢ - [Σᵢ γⁱZᵢ(z)·sᵢ]G₁ - Z(z)·W + z·W'
+	msmPoints := make([]*G1El, n+3)
+	msmScalars := make([]*emulated.Element[FR], n+3)
+	for i := range p.Commitments {
+		msmPoints[i] = &p.Commitments[i].G1El
+		msmScalars[i] = coeffs[i]
+	}
+	msmPoints[n] = &vk.G1
+	msmScalars[n] = folded
+	msmPoints[n+1] = &p.W
+	msmScalars[n+1] = v.scalarApi.Neg(zS)
+	msmPoints[n+2] = &p.WPrime
+	msmScalars[n+2] = &p.Z
+	total, err := v.curve.MultiScalarMul(msmPoints, msmScalars)
+	if err != nil {
+		return nil, nil, fmt.Errorf("fold commitments: %w", err)
+	}
+	return total, v.curve.Neg(&p.WPrime), nil
+}
+
+// AssertMixedSchemeBatch batches opening proofs of heterogeneous schemes into
+// one combined pairing check. Each item reduces to the G1 term pair of its
+// scheme's verification equation (see [SchemeProof]); the terms are folded
+// with powers of a challenge ρ squeezed from a transcript binding all of
+// them, and the single equation
+//
+//	e(Σᵢ ρⁱP₀ᵢ, G₂)·e(Σᵢ ρⁱP₁ᵢ, [α]G₂) == 1
+//
+// is asserted. By bilinearity the left-hand side is the ρ-power combination
+// of the per-item pairing products, so with ρ bound to all terms a single
+// invalid item makes the combined product differ from one with overwhelming
+// probability. All items must be proven against the same trusted setup vk.
+func (v *Verifier[FR, G1El, G2El, GTEl]) AssertMixedSchemeBatch(items []SchemeProof[FR, G1El, G2El, GTEl], vk VerifyingKey[G1El, G2El]) error {
+	if len(items) == 0 {
+		return fmt.Errorf("number of items should be nonzero")
+	}
+	terms0 := make([]*G1El, len(items))
+	terms1 := make([]*G1El, len(items))
+	for i := range items {
+		var err error
+		terms0[i], terms1[i], err = items[i].pairingTerms(v, vk)
+		if err != nil {
+			return fmt.Errorf("item %d: %w", i, err)
+		}
+	}
+
+	transcript, err := v.NewTranscript("rho")
+	if err != nil {
+		return fmt.Errorf("new transcript: %w", err)
+	}
+	for i := range items {
+		if err := transcript.AppendCommitment(Commitment[G1El]{G1El: *terms0[i]}); err != nil {
+			return fmt.Errorf("append %d-th first term: %w", i, err)
+		}
+		if err := transcript.AppendCommitment(Commitment[G1El]{G1El: *terms1[i]}); err != nil {
+			return fmt.Errorf("append %d-th second term: %w", i, err)
+		}
+	}
+	rho, err := transcript.ChallengeScalar()
+	if err != nil {
+		return fmt.Errorf("challenge scalar: %w", err)
+	}
+
+	foldedP0, foldedP1 := terms0[0], terms1[0]
+	if len(items) > 1 {
+		// ρⁱ for i = 1..n-1
+		rhoi := make([]*emulated.Element[FR], len(items)-1)
+		rhoi[0] = rho
+		for i := 1; i < len(rhoi); i++ {
+			rhoi[i] = v.scalarApi.Mul(rhoi[i-1], rho)
+		}
+		foldedP0, err = v.curve.MultiScalarMul(terms0[1:], rhoi)
+		if err != nil {
+			return fmt.Errorf("fold first terms: %w", err)
+		}
+		foldedP0 = v.curve.Add(foldedP0, terms0[0])
+		foldedP1, err = v.curve.MultiScalarMul(terms1[1:], rhoi)
+		if err != nil {
+			return fmt.Errorf("fold second terms: %w", err)
+		}
+		foldedP1 = v.curve.Add(foldedP1, terms1[0])
+	}
+
+	eq := algebra.NewEquation(v.curve, v.pairing)
+	eq.AddPairing(foldedP0, &vk.G2[0])
+	eq.AddPairing(foldedP1, &vk.G2[1])
+	if err := eq.AssertEqualsOne(); err != nil {
+		return fmt.Errorf("pairing check: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,213 @@
+package kzg
+
+import (
+	"crypto/rand"
+	"fmt"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bn254"
+	fr_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	kzg_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/kzg"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/algebra"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_bn254"
+	"github.com/consensys/gnark/std/math/emulated"
+	"github.com/consensys/gnark/test"
+)
+
+const (
+	mixedShplonkNbPolys = 2
+	mixedShplonkDegree  = 10
+)
+
+// divLinear returns the quotient of f - f(a) by X - a.
+func divLinear(f []fr_bn254.Element, a fr_bn254.Element) []fr_bn254.Element {
+	q := make([]fr_bn254.Element, len(f)-1)
+	var acc fr_bn254.Element
+	for i := len(f) - 1; i > 0; i-- {
+		acc.Mul(&acc, &a).Add(&acc, &f[i])
+		q[i-1].Set(&acc)
+	}
+	return q
+}
+
+type MixedSchemeBatchCircuit[FR emulated.FieldParams, G1El algebra.G1ElementT, G2El algebra.G2ElementT, GTEl algebra.GtElementT] struct {
+	VerifyingKey[G1El, G2El]
+
+	// standard single-point KZG item
+	KZGCommitment Commitment[G1El]
+	KZGProof      OpeningProof[FR, G1El]
+	KZGPoint      emulated.Element[FR]
+
+	// Shplonk item
+	ShplonkCommitments   [mixedShplonkNbPolys]Commitment[G1El]
+	ShplonkPoints        [mixedShplonkNbPolys]emulated.Element[FR]
+	ShplonkClaimedValues [mixedShplonkNbPolys]emulated.Element[FR]
+	W, WPrime            G1El
+	Gamma, Z             emulated.Element[FR]
+}
+
+func (c *MixedSchemeBatchCircuit[FR, G1El, G2El, GTEl]) Define(api frontend.API) error {
+	verifier, err := NewVerifier[FR, G1El, G2El, GTEl](api)
+	if err != nil {
+		return fmt.Errorf("new verifier: %w", err)
+	}
+	items := []SchemeProof[FR, G1El, G2El, GTEl]{
+		&KZGSchemeProof[FR, G1El, G2El, GTEl]{
+			Commitment: c.KZGCommitment,
+			Proof:      c.KZGProof,
+			Point:      c.KZGPoint,
+		},
+		&ShplonkSchemeProof[FR, G1El, G2El, GTEl]{
+			Commitments:   c.ShplonkCommitments[:],
+			Points:        c.ShplonkPoints[:],
+			ClaimedValues: c.ShplonkClaimedValues[:],
+			W:             c.W,
+			WPrime:        c.WPrime,
+			Gamma:         c.Gamma,
+			Z:             c.Z,
+		},
+	}
+	return verifier.AssertMixedSchemeBatch(items, c.VerifyingKey)
+}
+
+func TestAssertMixedSchemeBatch(t *testing.T) {
+	assert := test.NewAssert(t)
+
+	alpha, err := rand.Int(rand.Reader, ecc.BN254.ScalarField())
+	assert.NoError(err)
+	srs, err := kzg_bn254.NewSRS(kzgSize, alpha)
+	assert.NoError(err)
+
+	// standard single-point KZG proof
+	f := make([]fr_bn254.Element, polynomialSize)
+	for i := range f {
+		f[i].SetRandom()
+	}
+	com, err := kzg_bn254.Commit(f, srs.Pk)
+	assert.NoError(err)
+	var kzgPoint fr_bn254.Element
+	kzgPoint.SetRandom()
+	kzgProof, err := kzg_bn254.Open(f, kzgPoint, srs.Pk)
+	assert.NoError(err)
+
+	// Shplonk proof: each polynomial opened at its own point, aggregated with
+	// caller-supplied challenges
+	polys := make([][]fr_bn254.Element, mixedShplonkNbPolys)
+	points := make([]fr_bn254.Element, mixedShplonkNbPolys)
+	values := make([]fr_bn254.Element, mixedShplonkNbPolys)
+	commitments := make([]bn254.G1Affine, mixedShplonkNbPolys)
+	for i := range polys {
+		polys[i] = make([]fr_bn254.Element, mixedShplonkDegree+1)
+		for j := range polys[i] {
+			polys[i][j].SetRandom()
+		}
+		commitments[i], err = kzg_bn254.Commit(polys[i], srs.Pk)
+		assert.NoError(err)
+		points[i].SetRandom()
+		values[i] = evalPolynomial(polys[i], points[i])
+	}
+	var gamma, z fr_bn254.Element
+	gamma.SetRandom()
+	z.SetRandom()
+
+	// h = Σᵢ γⁱ(fᵢ - sᵢ)/(X - zᵢ)
+	h := make([]fr_bn254.Element, mixedShplonkDegree)
+	gammaPow := fr_bn254.One()
+	for i := range polys {
+		qi := divLinear(polys[i], points[i])
+		for j := range qi {
+			var tmp fr_bn254.Element
+			tmp.Mul(&qi[j], &gammaPow)
+			h[j].Add(&h[j], &tmp)
+		}
+		gammaPow.Mul(&gammaPow, &gamma)
+	}
+	w, err := kzg_bn254.Commit(h, srs.Pk)
+	assert.NoError(err)
+
+	// L = Σᵢ γⁱZᵢ(z)(fᵢ - sᵢ) - Z(z)·h, opened at z by W'
+	l := make([]fr_bn254.Element, mixedShplonkDegree+1)
+	gammaPow.SetOne()
+	var zS fr_bn254.Element
+	zS.SetOne()
+	for i := range polys {
+		var zi fr_bn254.Element
+		zi.SetOne()
+		for j := range points {
+			var diff fr_bn254.Element
+			diff.Sub(&z, &points[j])
+			if j != i {
+				zi.Mul(&zi, &diff)
+			}
+			if i == 0 {
+				zS.Mul(&zS, &diff)
+			}
+		}
+		var coeff fr_bn254.Element
+		coeff.Mul(&gammaPow, &zi)
+		for j := range polys[i] {
+			var tmp fr_bn254.Element
+			tmp.Mul(&polys[i][j], &coeff)
+			l[j].Add(&l[j], &tmp)
+		}
+		var cv fr_bn254.Element
+		cv.Mul(&coeff, &values[i])
+		l[0].Sub(&l[0], &cv)
+		gammaPow.Mul(&gammaPow, &gamma)
+	}
+	for j := range h {
+		var tmp fr_bn254.Element
+		tmp.Mul(&h[j], &zS)
+		l[j].Sub(&l[j], &tmp)
+	}
+	lz := evalPolynomial(l, z)
+	assert.True(lz.IsZero(), "linearized combination doesn't vanish at z")
+	wPrime, err := kzg_bn254.Commit(divLinear(l, z), srs.Pk)
+	assert.NoError(err)
+
+	assignment := MixedSchemeBatchCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{
+		KZGPoint: emulated.ValueOf[sw_bn254.ScalarField](kzgPoint),
+		W:        sw_bn254.NewG1Affine(w),
+		WPrime:   sw_bn254.NewG1Affine(wPrime),
+		Gamma:    emulated.ValueOf[sw_bn254.ScalarField](gamma),
+		Z:        emulated.ValueOf[sw_bn254.ScalarField](z),
+	}
+	assignment.VerifyingKey, err = ValueOfVerifyingKey[sw_bn254.G1Affine, sw_bn254.G2Affine](srs.Vk)
+	assert.NoError(err)
+	assignment.KZGCommitment, err = ValueOfCommitment[sw_bn254.G1Affine](com)
+	assert.NoError(err)
+	assignment.KZGProof, err = ValueOfOpeningProof[sw_bn254.ScalarField, sw_bn254.G1Affine](kzgProof)
+	assert.NoError(err)
+	for i := range commitments {
+		assignment.ShplonkCommitments[i], err = ValueOfCommitment[sw_bn254.G1Affine](commitments[i])
+		assert.NoError(err)
+		assignment.ShplonkPoints[i] = emulated.ValueOf[sw_bn254.ScalarField](points[i])
+		assignment.ShplonkClaimedValues[i] = emulated.ValueOf[sw_bn254.ScalarField](values[i])
+	}
+
+	circuit := MixedSchemeBatchCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{}
+
+	assert.Run(func(assert *test.Assert) {
+		assert.NoError(test.IsSolved(&circuit, &assignment, ecc.BN254.ScalarField()))
+	}, "valid")
+
+	assert.Run(func(assert *test.Assert) {
+		// a tampered claimed value in the KZG item fails the combined check
+		badAssignment := assignment
+		var other fr_bn254.Element
+		other.SetRandom()
+		badAssignment.KZGProof.ClaimedValue = emulated.ValueOf[sw_bn254.ScalarField](other)
+		assert.Error(test.IsSolved(&circuit, &badAssignment, ecc.BN254.ScalarField()))
+	}, "invalid kzg item")
+
+	assert.Run(func(assert *test.Assert) {
+		// a tampered claimed value in the Shplonk item fails the combined check
+		badAssignment := assignment
+		var badValue fr_bn254.Element
+		badValue.SetOne().Add(&badValue, &values[0])
+		badAssignment.ShplonkClaimedValues[0] = emulated.ValueOf[sw_bn254.ScalarField](badValue)
+		assert.Error(test.IsSolved(&circuit, &badAssignment, ecc.BN254.ScalarField()))
+	}, "invalid shplonk item")
+}
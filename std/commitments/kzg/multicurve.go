@@ -0,0 +1,83 @@
+package kzg
+
+import (
+	"fmt"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_bn254"
+	"github.com/consensys/gnark/std/algebra/native/sw_bls12377"
+	"github.com/consensys/gnark/std/math/emulated"
+)
+
+// MultiCurveError is the unified error type returned by the
+// [MultiCurveVerifier] methods. It records which curve sub-verifier failed and
+// wraps the underlying error.
+type MultiCurveError struct {
+	Curve ecc.ID
+	Err   error
+}
+
+func (e *MultiCurveError) Error() string {
+	return fmt.Sprintf("kzg verifier for curve %s: %v", e.Curve, e.Err)
+}
+
+func (e *MultiCurveError) Unwrap() error {
+	return e.Err
+}
+
+// MultiCurveVerifier is a façade over per-curve [Verifier] instances for
+// circuits verifying openings on several curves at once, e.g. heterogeneous
+// aggregation circuits. The sub-verifiers are initialized lazily with the
+// correct algebra instances on first use and all methods report failures with
+// the unified [MultiCurveError] type.
+//
+// Note that the BLS12-377 sub-verifier uses the native 2-chain implementation
+// and thus requires the outer curve to be BW6-761, whereas the BN254
+// sub-verifier uses field emulation and works over any outer curve. Verifying
+// both in a single circuit therefore requires compiling over BW6-761.
+type MultiCurveVerifier struct {
+	api frontend.API
+
+	bn254    *Verifier[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]
+	bls12377 *Verifier[sw_bls12377.ScalarField, sw_bls12377.G1Affine, sw_bls12377.G2Affine, sw_bls12377.GT]
+}
+
+// NewMultiCurveVerifier returns a new [MultiCurveVerifier] for the given
+// compiled constraint system.
+func NewMultiCurveVerifier(api frontend.API) *MultiCurveVerifier {
+	return &MultiCurveVerifier{api: api}
+}
+
+// AssertBN254 verifies a BN254 opening proof through the emulated BN254
+// verifier, initializing it on first use.
+func (v *MultiCurveVerifier) AssertBN254(commitment Commitment[sw_bn254.G1Affine], proof OpeningProof[sw_bn254.ScalarField, sw_bn254.G1Affine], point emulated.Element[sw_bn254.ScalarField], vk VerifyingKey[sw_bn254.G1Affine, sw_bn254.G2Affine]) error {
+	if v.bn254 == nil {
+		inner, err := NewVerifier[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl](v.api)
+		if err != nil {
+			return &MultiCurveError{Curve: ecc.BN254, Err: fmt.Errorf("new verifier: %w", err)}
+		}
+		v.bn254 = inner
+	}
+	if err := v.bn254.CheckOpeningProof(commitment, proof, point, vk); err != nil {
+		return &MultiCurveError{Curve: ecc.BN254, Err: fmt.Errorf("check opening proof: %w", err)}
+	}
+	return nil
+}
+
+// AssertBLS12377 verifies a BLS12-377 opening proof through the native
+// 2-chain verifier, initializing it on first use. The outer curve must be
+// BW6-761.
+func (v *MultiCurveVerifier) AssertBLS12377(commitment Commitment[sw_bls12377.G1Affine], proof OpeningProof[sw_bls12377.ScalarField, sw_bls12377.G1Affine], point emulated.Element[sw_bls12377.ScalarField], vk VerifyingKey[sw_bls12377.G1Affine, sw_bls12377.G2Affine]) error {
+	if v.bls12377 == nil {
+		inner, err := NewVerifier[sw_bls12377.ScalarField, sw_bls12377.G1Affine, sw_bls12377.G2Affine, sw_bls12377.GT](v.api)
+		if err != nil {
+			return &MultiCurveError{Curve: ecc.BLS12_377, Err: fmt.Errorf("new verifier: %w", err)}
+		}
+		v.bls12377 = inner
+	}
+	if err := v.bls12377.CheckOpeningProof(commitment, proof, point, vk); err != nil {
+		return &MultiCurveError{Curve: ecc.BLS12_377, Err: fmt.Errorf("check opening proof: %w", err)}
+	}
+	return nil
+}
@@ -0,0 +1,103 @@
+package kzg
+
+import (
+	"crypto/rand"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	fr_bls12377 "github.com/consensys/gnark-crypto/ecc/bls12-377/fr"
+	kzg_bls12377 "github.com/consensys/gnark-crypto/ecc/bls12-377/kzg"
+	fr_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	kzg_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/kzg"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_bn254"
+	"github.com/consensys/gnark/std/algebra/native/sw_bls12377"
+	"github.com/consensys/gnark/std/math/emulated"
+	"github.com/consensys/gnark/test"
+)
+
+type MultiCurveCircuit struct {
+	BN254VK     VerifyingKey[sw_bn254.G1Affine, sw_bn254.G2Affine]
+	BN254Cmt    Commitment[sw_bn254.G1Affine]
+	BN254Proof  OpeningProof[sw_bn254.ScalarField, sw_bn254.G1Affine]
+	BN254Point  emulated.Element[sw_bn254.ScalarField]
+	BLS377VK    VerifyingKey[sw_bls12377.G1Affine, sw_bls12377.G2Affine]
+	BLS377Cmt   Commitment[sw_bls12377.G1Affine]
+	BLS377Proof OpeningProof[sw_bls12377.ScalarField, sw_bls12377.G1Affine]
+	BLS377Point emulated.Element[sw_bls12377.ScalarField]
+}
+
+func (c *MultiCurveCircuit) Define(api frontend.API) error {
+	verifier := NewMultiCurveVerifier(api)
+	if err := verifier.AssertBN254(c.BN254Cmt, c.BN254Proof, c.BN254Point, c.BN254VK); err != nil {
+		return err
+	}
+	return verifier.AssertBLS12377(c.BLS377Cmt, c.BLS377Proof, c.BLS377Point, c.BLS377VK)
+}
+
+func TestMultiCurveVerifier(t *testing.T) {
+	assert := test.NewAssert(t)
+
+	// BN254 opening
+	alphaBN, err := rand.Int(rand.Reader, ecc.BN254.ScalarField())
+	assert.NoError(err)
+	srsBN, err := kzg_bn254.NewSRS(kzgSize, alphaBN)
+	assert.NoError(err)
+	fBN := make([]fr_bn254.Element, polynomialSize)
+	for i := range fBN {
+		fBN[i].SetRandom()
+	}
+	comBN, err := kzg_bn254.Commit(fBN, srsBN.Pk)
+	assert.NoError(err)
+	var pointBN fr_bn254.Element
+	pointBN.SetRandom()
+	proofBN, err := kzg_bn254.Open(fBN, pointBN, srsBN.Pk)
+	assert.NoError(err)
+
+	// BLS12-377 opening
+	alpha377, err := rand.Int(rand.Reader, ecc.BLS12_377.ScalarField())
+	assert.NoError(err)
+	srs377, err := kzg_bls12377.NewSRS(kzgSize, alpha377)
+	assert.NoError(err)
+	f377 := make([]fr_bls12377.Element, polynomialSize)
+	for i := range f377 {
+		f377[i].SetRandom()
+	}
+	com377, err := kzg_bls12377.Commit(f377, srs377.Pk)
+	assert.NoError(err)
+	var point377 fr_bls12377.Element
+	point377.SetRandom()
+	proof377, err := kzg_bls12377.Open(f377, point377, srs377.Pk)
+	assert.NoError(err)
+
+	var assignment MultiCurveCircuit
+	assignment.BN254VK, err = ValueOfVerifyingKey[sw_bn254.G1Affine, sw_bn254.G2Affine](srsBN.Vk)
+	assert.NoError(err)
+	assignment.BN254Cmt, err = ValueOfCommitment[sw_bn254.G1Affine](comBN)
+	assert.NoError(err)
+	assignment.BN254Proof, err = ValueOfOpeningProof[sw_bn254.ScalarField, sw_bn254.G1Affine](proofBN)
+	assert.NoError(err)
+	assignment.BN254Point, err = ValueOfScalar[sw_bn254.ScalarField](pointBN)
+	assert.NoError(err)
+	assignment.BLS377VK, err = ValueOfVerifyingKey[sw_bls12377.G1Affine, sw_bls12377.G2Affine](srs377.Vk)
+	assert.NoError(err)
+	assignment.BLS377Cmt, err = ValueOfCommitment[sw_bls12377.G1Affine](com377)
+	assert.NoError(err)
+	assignment.BLS377Proof, err = ValueOfOpeningProof[sw_bls12377.ScalarField, sw_bls12377.G1Affine](proof377)
+	assert.NoError(err)
+	assignment.BLS377Point, err = ValueOfScalar[sw_bls12377.ScalarField](point377)
+	assert.NoError(err)
+
+	var circuit MultiCurveCircuit
+
+	assert.Run(func(assert *test.Assert) {
+		assert.NoError(test.IsSolved(&circuit, &assignment, ecc.BW6_761.ScalarField()))
+	}, "valid")
+
+	assert.Run(func(assert *test.Assert) {
+		// swapped commitments fail on both sub-verifiers' curves
+		badAssignment := assignment
+		badAssignment.BN254Cmt, _ = ValueOfCommitment[sw_bn254.G1Affine](srsBN.Vk.G1)
+		assert.Error(test.IsSolved(&circuit, &badAssignment, ecc.BW6_761.ScalarField()))
+	}, "wrong commitment")
+}
@@ -0,0 +1,44 @@
+package kzg
+
+import (
+	"fmt"
+
+	"github.com/consensys/gnark/std/math/emulated"
+)
+
+// MultiSRSBatch groups openings performed under a single SRS. All the
+// commitments, proofs and points in a batch share the same verifying key.
+type MultiSRSBatch[FR emulated.FieldParams, G1El, G2El any] struct {
+	VK      VerifyingKey[G1El, G2El]
+	Digests []Commitment[G1El]
+	Proofs  []OpeningProof[FR, G1El]
+	Points  []emulated.Element[FR]
+}
+
+// AssertMultiSRSBatch verifies batches of opening proofs where every batch
+// was committed under its own independent SRS. Folding proofs only makes
+// sense against a single toxic point α, so openings under different setups
+// can never be folded together; instead each batch is folded internally as in
+// [Verifier.BatchVerifyMultiPoints] and contributes one pair of Miller loop
+// inputs per SRS to a single combined pairing check. The final exponentiation
+// is still shared across all the batches, so verifying k setups costs one
+// multi-pairing of 2k pairs rather than k full pairing checks.
+func (v *Verifier[FR, G1El, G2El, GTEl]) AssertMultiSRSBatch(batches []MultiSRSBatch[FR, G1El, G2El]) error {
+	if len(batches) == 0 {
+		return fmt.Errorf("number of batches should be nonzero")
+	}
+	pairingG1 := make([]*G1El, 0, 2*len(batches))
+	pairingG2 := make([]*G2El, 0, 2*len(batches))
+	for i := range batches {
+		foldedDigest, foldedQuotients, err := v.FoldProofsMultiPoint(batches[i].Digests, batches[i].Proofs, batches[i].Points, batches[i].VK)
+		if err != nil {
+			return fmt.Errorf("fold batch %d: %w", i, err)
+		}
+		pairingG1 = append(pairingG1, foldedDigest, foldedQuotients)
+		pairingG2 = append(pairingG2, &batches[i].VK.G2[0], &batches[i].VK.G2[1])
+	}
+	if err := v.pairing.PairingCheck(pairingG1, pairingG2); err != nil {
+		return fmt.Errorf("pairing check: %w", err)
+	}
+	return nil
+}
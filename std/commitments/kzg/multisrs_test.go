@@ -0,0 +1,100 @@
+package kzg
+
+import (
+	"crypto/rand"
+	"fmt"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	fr_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	kzg_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/kzg"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/algebra"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_bn254"
+	"github.com/consensys/gnark/std/math/emulated"
+	"github.com/consensys/gnark/test"
+)
+
+type MultiSRSCircuit[FR emulated.FieldParams, G1El algebra.G1ElementT, G2El algebra.G2ElementT, GTEl algebra.GtElementT] struct {
+	Batches [2]MultiSRSBatch[FR, G1El, G2El]
+}
+
+func (c *MultiSRSCircuit[FR, G1El, G2El, GTEl]) Define(api frontend.API) error {
+	verifier, err := NewVerifier[FR, G1El, G2El, GTEl](api)
+	if err != nil {
+		return fmt.Errorf("new verifier: %w", err)
+	}
+	if err := verifier.AssertMultiSRSBatch(c.Batches[:]); err != nil {
+		return fmt.Errorf("assert multi SRS batch: %w", err)
+	}
+	return nil
+}
+
+// openingsUnderSRS commits to nbPolynomials random polynomials under a fresh
+// SRS and opens each at its own random point.
+func openingsUnderSRS(t *testing.T, nbPolynomials int) MultiSRSBatch[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine] {
+	t.Helper()
+	alpha, err := rand.Int(rand.Reader, ecc.BN254.ScalarField())
+	if err != nil {
+		t.Fatal(err)
+	}
+	srs, err := kzg_bn254.NewSRS(kzgSize, alpha)
+	if err != nil {
+		t.Fatal(err)
+	}
+	batch := MultiSRSBatch[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine]{
+		Digests: make([]Commitment[sw_bn254.G1Affine], nbPolynomials),
+		Proofs:  make([]OpeningProof[sw_bn254.ScalarField, sw_bn254.G1Affine], nbPolynomials),
+		Points:  make([]emulated.Element[sw_bn254.ScalarField], nbPolynomials),
+	}
+	batch.VK, err = ValueOfVerifyingKey[sw_bn254.G1Affine, sw_bn254.G2Affine](srs.Vk)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < nbPolynomials; i++ {
+		f := make([]fr_bn254.Element, polynomialSize)
+		for j := range f {
+			f[j].SetRandom()
+		}
+		com, err := kzg_bn254.Commit(f, srs.Pk)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var point fr_bn254.Element
+		point.SetRandom()
+		proof, err := kzg_bn254.Open(f, point, srs.Pk)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if batch.Digests[i], err = ValueOfCommitment[sw_bn254.G1Affine](com); err != nil {
+			t.Fatal(err)
+		}
+		if batch.Proofs[i], err = ValueOfOpeningProof[sw_bn254.ScalarField, sw_bn254.G1Affine](proof); err != nil {
+			t.Fatal(err)
+		}
+		if batch.Points[i], err = ValueOfScalar[sw_bn254.ScalarField](point); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return batch
+}
+
+func TestAssertMultiSRSBatch(t *testing.T) {
+	assert := test.NewAssert(t)
+	const nbPolynomials = 2
+
+	var circuit, assignment MultiSRSCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]
+	for i := range assignment.Batches {
+		// each batch has its own independent trusted setup
+		assignment.Batches[i] = openingsUnderSRS(t, nbPolynomials)
+		circuit.Batches[i].Digests = make([]Commitment[sw_bn254.G1Affine], nbPolynomials)
+		circuit.Batches[i].Proofs = make([]OpeningProof[sw_bn254.ScalarField, sw_bn254.G1Affine], nbPolynomials)
+		circuit.Batches[i].Points = make([]emulated.Element[sw_bn254.ScalarField], nbPolynomials)
+	}
+	assert.NoError(test.IsSolved(&circuit, &assignment, ecc.BN254.ScalarField()))
+
+	// an opening verified against the other setup's key must fail
+	badAssignment := assignment
+	badAssignment.Batches[0].VK = assignment.Batches[1].VK
+	assert.Error(test.IsSolved(&circuit, &badAssignment, ecc.BN254.ScalarField()))
+}
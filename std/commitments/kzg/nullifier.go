@@ -0,0 +1,39 @@
+package kzg
+
+import (
+	"fmt"
+
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/hash"
+	"github.com/consensys/gnark/std/math/bits"
+	"github.com/consensys/gnark/std/math/emulated"
+)
+
+// AssertProofWithNullifier verifies the opening proof and derives a nullifier
+//
+//	nullifier = hasher(point, claimedValue, secret)
+//
+// binding the opening to the prover's secret. The returned variable is
+// deterministic in the opening and the secret, so exposing it as a public
+// output lets a protocol detect double-use of a one-time proof by tracking
+// spent nullifiers, while the secret keeps the nullifier unlinkable to the
+// opening for anyone not holding it.
+//
+// The point and the claimed value are decomposed canonically and repacked as
+// native field elements before hashing, so the emulated scalar field must fit
+// the native field; the external party computes the matching nullifier by
+// hashing the two scalars and the secret as native field elements.
+func (v *Verifier[FR, G1El, G2El, GTEl]) AssertProofWithNullifier(commitment Commitment[G1El], proof OpeningProof[FR, G1El], point emulated.Element[FR], secret frontend.Variable, vk VerifyingKey[G1El, G2El], hasher hash.FieldHasher) (frontend.Variable, error) {
+	var fr FR
+	if fr.Modulus().Cmp(v.api.Compiler().Field()) > 0 {
+		return nil, fmt.Errorf("emulated scalar field doesn't fit the native field")
+	}
+	if err := v.CheckOpeningProof(commitment, proof, point, vk); err != nil {
+		return nil, fmt.Errorf("check opening proof: %w", err)
+	}
+	pointVar := bits.FromBinary(v.api, v.scalarApi.ToBitsCanonical(&point))
+	valueVar := bits.FromBinary(v.api, v.scalarApi.ToBitsCanonical(&proof.ClaimedValue))
+	hasher.Reset()
+	hasher.Write(pointVar, valueVar, secret)
+	return hasher.Sum(), nil
+}
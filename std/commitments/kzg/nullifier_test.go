@@ -0,0 +1,147 @@
+package kzg
+
+import (
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	fr_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	poseidonbn254 "github.com/consensys/gnark-crypto/ecc/bn254/fr/poseidon2"
+	kzg_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/kzg"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/algebra"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_bn254"
+	poseidon "github.com/consensys/gnark/std/hash/poseidon2"
+	"github.com/consensys/gnark/std/math/emulated"
+	"github.com/consensys/gnark/test"
+)
+
+// nullifierHasher adapts the poseidon2 permutation to the hash.FieldHasher
+// interface, chaining a width-3 sponge over pairs of absorbed elements.
+type nullifierHasher struct {
+	api  frontend.API
+	data []frontend.Variable
+}
+
+func (h *nullifierHasher) Write(data ...frontend.Variable) {
+	h.data = append(h.data, data...)
+}
+
+func (h *nullifierHasher) Reset() {
+	h.data = nil
+}
+
+func (h *nullifierHasher) Sum() frontend.Variable {
+	perm := poseidon.NewHash(3, 5, 8, 56, "seed", ecc.BN254)
+	acc := frontend.Variable(0)
+	for i := 0; i < len(h.data); i += 2 {
+		state := []frontend.Variable{acc, h.data[i], 0}
+		if i+1 < len(h.data) {
+			state[2] = h.data[i+1]
+		}
+		perm.Permutation(h.api, state)
+		acc = state[0]
+	}
+	return acc
+}
+
+type NullifierCircuit[FR emulated.FieldParams, G1El algebra.G1ElementT, G2El algebra.G2ElementT, GTEl algebra.GtElementT] struct {
+	VerifyingKey[G1El, G2El]
+	Commitment[G1El]
+	OpeningProof[FR, G1El]
+	Point     emulated.Element[FR]
+	Secret    frontend.Variable
+	Nullifier frontend.Variable `gnark:",public"`
+}
+
+func (c *NullifierCircuit[FR, G1El, G2El, GTEl]) Define(api frontend.API) error {
+	verifier, err := NewVerifier[FR, G1El, G2El, GTEl](api)
+	if err != nil {
+		return fmt.Errorf("new verifier: %w", err)
+	}
+	hasher := &nullifierHasher{api: api}
+	nullifier, err := verifier.AssertProofWithNullifier(c.Commitment, c.OpeningProof, c.Point, c.Secret, c.VerifyingKey, hasher)
+	if err != nil {
+		return fmt.Errorf("assert proof with nullifier: %w", err)
+	}
+	api.AssertIsEqual(nullifier, c.Nullifier)
+	return nil
+}
+
+func TestAssertProofWithNullifier(t *testing.T) {
+	assert := test.NewAssert(t)
+
+	alpha, err := rand.Int(rand.Reader, ecc.BN254.ScalarField())
+	assert.NoError(err)
+	srs, err := kzg_bn254.NewSRS(kzgSize, alpha)
+	assert.NoError(err)
+
+	f := make([]fr_bn254.Element, polynomialSize)
+	for i := range f {
+		f[i].SetRandom()
+	}
+	com, err := kzg_bn254.Commit(f, srs.Pk)
+	assert.NoError(err)
+	var point fr_bn254.Element
+	point.SetRandom()
+	proof, err := kzg_bn254.Open(f, point, srs.Pk)
+	assert.NoError(err)
+
+	// native nullifier with the matching sponge
+	nativeNullifier := func(secret fr_bn254.Element) fr_bn254.Element {
+		perm := poseidonbn254.NewHash(3, 8, 56, "seed")
+		var state [3]fr_bn254.Element
+		state[1].Set(&point)
+		state[2].Set(&proof.ClaimedValue)
+		assert.NoError(perm.Permutation(state[:]))
+		state[1].Set(&secret)
+		state[2].SetZero()
+		assert.NoError(perm.Permutation(state[:]))
+		return state[0]
+	}
+	var secret fr_bn254.Element
+	secret.SetRandom()
+	nullifier := nativeNullifier(secret)
+
+	// the nullifier is deterministic in the opening and the secret
+	again := nativeNullifier(secret)
+	assert.True(nullifier.Equal(&again))
+	var otherSecret fr_bn254.Element
+	otherSecret.SetRandom()
+	otherNullifier := nativeNullifier(otherSecret)
+	assert.False(nullifier.Equal(&otherNullifier))
+
+	wVk, err := ValueOfVerifyingKey[sw_bn254.G1Affine, sw_bn254.G2Affine](srs.Vk)
+	assert.NoError(err)
+	wCom, err := ValueOfCommitment[sw_bn254.G1Affine](com)
+	assert.NoError(err)
+	wProof, err := ValueOfOpeningProof[sw_bn254.ScalarField, sw_bn254.G1Affine](proof)
+	assert.NoError(err)
+	wPoint, err := ValueOfScalar[sw_bn254.ScalarField](point)
+	assert.NoError(err)
+
+	circuit := NullifierCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{}
+	assignment := NullifierCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{
+		VerifyingKey: wVk,
+		Commitment:   wCom,
+		OpeningProof: wProof,
+		Point:        wPoint,
+		Secret:       secret.BigInt(new(big.Int)),
+		Nullifier:    nullifier.BigInt(new(big.Int)),
+	}
+
+	assert.Run(func(assert *test.Assert) {
+		assert.NoError(test.IsSolved(&circuit, &assignment, ecc.BN254.ScalarField()))
+	}, "valid")
+
+	assert.Run(func(assert *test.Assert) {
+		// a different secret yields a different nullifier
+		badAssignment := assignment
+		badAssignment.Secret = otherSecret.BigInt(new(big.Int))
+		assert.Error(test.IsSolved(&circuit, &badAssignment, ecc.BN254.ScalarField()))
+		badAssignment.Nullifier = otherNullifier.BigInt(new(big.Int))
+		assert.NoError(test.IsSolved(&circuit, &badAssignment, ecc.BN254.ScalarField()))
+	}, "secret changes nullifier")
+}
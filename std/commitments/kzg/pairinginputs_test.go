@@ -0,0 +1,89 @@
+package kzg
+
+import (
+	"crypto/rand"
+	"fmt"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	fr_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	kzg_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/kzg"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/algebra"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_bn254"
+	"github.com/consensys/gnark/std/math/emulated"
+	"github.com/consensys/gnark/test"
+)
+
+type PairingInputsCircuit[FR emulated.FieldParams, G1El algebra.G1ElementT, G2El algebra.G2ElementT, GTEl algebra.GtElementT] struct {
+	VerifyingKey[G1El, G2El]
+	Commitments [2]Commitment[G1El]
+	Proofs      [2]OpeningProof[FR, G1El]
+	Points      [2]emulated.Element[FR]
+}
+
+func (c *PairingInputsCircuit[FR, G1El, G2El, GTEl]) Define(api frontend.API) error {
+	verifier, err := NewVerifier[FR, G1El, G2El, GTEl](api)
+	if err != nil {
+		return fmt.Errorf("new verifier: %w", err)
+	}
+	// accumulate the pairing inputs of both opening checks and assert them
+	// with a single pairing check, deferring the final exponentiation
+	eq := algebra.NewEquation(verifier.curve, verifier.pairing)
+	for i := range c.Commitments {
+		g1Points, g2Points, err := verifier.ProofPairingInputs(c.Commitments[i], c.Proofs[i], c.Points[i], c.VerifyingKey)
+		if err != nil {
+			return fmt.Errorf("proof pairing inputs %d: %w", i, err)
+		}
+		for j := range g1Points {
+			eq.AddPairing(g1Points[j], g2Points[j])
+		}
+	}
+	return eq.AssertEqualsOne()
+}
+
+func TestProofPairingInputs(t *testing.T) {
+	assert := test.NewAssert(t)
+
+	alpha, err := rand.Int(rand.Reader, ecc.BN254.ScalarField())
+	assert.NoError(err)
+	srs, err := kzg_bn254.NewSRS(kzgSize, alpha)
+	assert.NoError(err)
+
+	circuit := PairingInputsCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{}
+	assignment := PairingInputsCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{}
+	assignment.VerifyingKey, err = ValueOfVerifyingKey[sw_bn254.G1Affine, sw_bn254.G2Affine](srs.Vk)
+	assert.NoError(err)
+
+	for i := 0; i < 2; i++ {
+		f := make([]fr_bn254.Element, polynomialSize)
+		for j := range f {
+			f[j].SetRandom()
+		}
+		var point fr_bn254.Element
+		point.SetRandom()
+		com, err := kzg_bn254.Commit(f, srs.Pk)
+		assert.NoError(err)
+		proof, err := kzg_bn254.Open(f, point, srs.Pk)
+		assert.NoError(err)
+		assignment.Commitments[i], err = ValueOfCommitment[sw_bn254.G1Affine](com)
+		assert.NoError(err)
+		assignment.Proofs[i], err = ValueOfOpeningProof[sw_bn254.ScalarField, sw_bn254.G1Affine](proof)
+		assert.NoError(err)
+		assignment.Points[i], err = ValueOfScalar[sw_bn254.ScalarField](point)
+		assert.NoError(err)
+	}
+
+	assert.Run(func(assert *test.Assert) {
+		assert.NoError(test.IsSolved(&circuit, &assignment, ecc.BN254.ScalarField()))
+	}, "valid")
+
+	assert.Run(func(assert *test.Assert) {
+		// tampering with one opening breaks the combined pairing check
+		badAssignment := assignment
+		var otherValue fr_bn254.Element
+		otherValue.SetRandom()
+		badAssignment.Proofs[0].ClaimedValue = emulated.ValueOf[sw_bn254.ScalarField](otherValue)
+		assert.Error(test.IsSolved(&circuit, &badAssignment, ecc.BN254.ScalarField()))
+	}, "tampered")
+}
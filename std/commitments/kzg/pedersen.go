@@ -0,0 +1,43 @@
+package kzg
+
+import (
+	"fmt"
+
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/algebra/native/twistededwards"
+	"github.com/consensys/gnark/std/math/bits"
+	"github.com/consensys/gnark/std/math/emulated"
+)
+
+// AssertPedersenKZGConsistency verifies the KZG opening proof and that the
+// Pedersen commitment
+//
+//	pedersen = [v]·g + [blinding]·h
+//
+// opens to the same value v = proof.ClaimedValue. This links the two
+// commitment subsystems in hybrid protocols where a value committed on the
+// twisted Edwards curve must match an evaluation of a KZG-committed
+// polynomial.
+//
+// The generators g and h must be points of the prime-order subgroup with no
+// known discrete-logarithm relation between them (e.g. derived by hashing to
+// the curve), otherwise the Pedersen binding breaks. The blinding scalar is
+// the prover's opening randomness. The claimed value is decomposed
+// canonically and repacked as the native scalar multiplying g, so the
+// emulated scalar field must fit the native one; the external committer
+// computes [v]·g with v the evaluation as an integer.
+func (v *Verifier[FR, G1El, G2El, GTEl]) AssertPedersenKZGConsistency(pedersen twistededwards.Point, commitment Commitment[G1El], proof OpeningProof[FR, G1El], point emulated.Element[FR], vk VerifyingKey[G1El, G2El], curve twistededwards.Curve, g, h twistededwards.Point, blinding frontend.Variable) error {
+	var fr FR
+	if fr.Modulus().Cmp(v.api.Compiler().Field()) > 0 {
+		return fmt.Errorf("emulated scalar field doesn't fit the native field")
+	}
+	bts := v.scalarApi.ToBitsCanonical(&proof.ClaimedValue)
+	value := bits.FromBinary(v.api, bts)
+	recomputed := curve.DoubleBaseScalarMul(g, h, value, blinding)
+	v.api.AssertIsEqual(recomputed.X, pedersen.X)
+	v.api.AssertIsEqual(recomputed.Y, pedersen.Y)
+	if err := v.CheckOpeningProof(commitment, proof, point, vk); err != nil {
+		return fmt.Errorf("check opening proof: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,109 @@
+package kzg
+
+import (
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	fr_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	kzg_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/kzg"
+	ted_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/twistededwards"
+	tedwards "github.com/consensys/gnark-crypto/ecc/twistededwards"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/algebra"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_bn254"
+	"github.com/consensys/gnark/std/algebra/native/twistededwards"
+	"github.com/consensys/gnark/std/math/emulated"
+	"github.com/consensys/gnark/test"
+)
+
+type PedersenKZGCircuit[FR emulated.FieldParams, G1El algebra.G1ElementT, G2El algebra.G2ElementT, GTEl algebra.GtElementT] struct {
+	VerifyingKey[G1El, G2El]
+	Commitment[G1El]
+	OpeningProof[FR, G1El]
+	Point    emulated.Element[FR]
+	Pedersen twistededwards.Point
+	G        twistededwards.Point
+	H        twistededwards.Point
+	Blinding frontend.Variable
+}
+
+func (c *PedersenKZGCircuit[FR, G1El, G2El, GTEl]) Define(api frontend.API) error {
+	verifier, err := NewVerifier[FR, G1El, G2El, GTEl](api)
+	if err != nil {
+		return fmt.Errorf("new verifier: %w", err)
+	}
+	curve, err := twistededwards.NewEdCurve(api, tedwards.BN254)
+	if err != nil {
+		return fmt.Errorf("new twisted edwards curve: %w", err)
+	}
+	return verifier.AssertPedersenKZGConsistency(c.Pedersen, c.Commitment, c.OpeningProof, c.Point, c.VerifyingKey, curve, c.G, c.H, c.Blinding)
+}
+
+func TestAssertPedersenKZGConsistency(t *testing.T) {
+	assert := test.NewAssert(t)
+
+	alpha, err := rand.Int(rand.Reader, ecc.BN254.ScalarField())
+	assert.NoError(err)
+	srs, err := kzg_bn254.NewSRS(kzgSize, alpha)
+	assert.NoError(err)
+
+	f := make([]fr_bn254.Element, polynomialSize)
+	for i := range f {
+		f[i].SetRandom()
+	}
+	com, err := kzg_bn254.Commit(f, srs.Pk)
+	assert.NoError(err)
+	var point fr_bn254.Element
+	point.SetRandom()
+	proof, err := kzg_bn254.Open(f, point, srs.Pk)
+	assert.NoError(err)
+
+	// Pedersen commitment to the claimed value on the twisted Edwards curve
+	ed := ted_bn254.GetEdwardsCurve()
+	g := ed.Base
+	hScalar, err := rand.Int(rand.Reader, &ed.Order)
+	assert.NoError(err)
+	var h ted_bn254.PointAffine
+	h.ScalarMultiplication(&g, hScalar)
+	blinding, err := rand.Int(rand.Reader, &ed.Order)
+	assert.NoError(err)
+	var valueTerm, blindingTerm, pedersen ted_bn254.PointAffine
+	valueTerm.ScalarMultiplication(&g, proof.ClaimedValue.BigInt(new(big.Int)))
+	blindingTerm.ScalarMultiplication(&h, blinding)
+	pedersen.Add(&valueTerm, &blindingTerm)
+
+	wVk, err := ValueOfVerifyingKey[sw_bn254.G1Affine, sw_bn254.G2Affine](srs.Vk)
+	assert.NoError(err)
+	wCom, err := ValueOfCommitment[sw_bn254.G1Affine](com)
+	assert.NoError(err)
+	wProof, err := ValueOfOpeningProof[sw_bn254.ScalarField, sw_bn254.G1Affine](proof)
+	assert.NoError(err)
+	wPoint, err := ValueOfScalar[sw_bn254.ScalarField](point)
+	assert.NoError(err)
+
+	circuit := PedersenKZGCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{}
+	assignment := PedersenKZGCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{
+		VerifyingKey: wVk,
+		Commitment:   wCom,
+		OpeningProof: wProof,
+		Point:        wPoint,
+		Pedersen:     twistededwards.Point{X: pedersen.X, Y: pedersen.Y},
+		G:            twistededwards.Point{X: g.X, Y: g.Y},
+		H:            twistededwards.Point{X: h.X, Y: h.Y},
+		Blinding:     blinding,
+	}
+	assert.NoError(test.IsSolved(&circuit, &assignment, ecc.BN254.ScalarField()))
+
+	// a Pedersen commitment to a different value is rejected
+	var otherValue fr_bn254.Element
+	otherValue.SetOne().Add(&otherValue, &proof.ClaimedValue)
+	valueTerm.ScalarMultiplication(&g, otherValue.BigInt(new(big.Int)))
+	var badPedersen ted_bn254.PointAffine
+	badPedersen.Add(&valueTerm, &blindingTerm)
+	badAssignment := assignment
+	badAssignment.Pedersen = twistededwards.Point{X: badPedersen.X, Y: badPedersen.Y}
+	assert.Error(test.IsSolved(&circuit, &badAssignment, ecc.BN254.ScalarField()))
+}
@@ -0,0 +1,67 @@
+package kzg
+
+import (
+	"fmt"
+
+	"github.com/consensys/gnark/std/math/emulated"
+)
+
+// AssertPermutationOpening asserts the PLONK permutation argument at the
+// evaluation point ζ: the grand-product polynomial Z accumulates the ratio of
+// the two sides of the copy-constraint multiset equality between f and g
+// under the permutation polynomial σ, satisfying over the domain H
+//
+//	Z(ωX)·(g(X) + β·σ(X) + γ) - Z(X)·(f(X) + β·X + γ) = q(X)·Zₕ(X)
+//
+// for the quotient polynomial q and the vanishing polynomial Zₕ of H. The
+// claimed evaluations are taken from the opening proofs: fProof, gProof,
+// sigmaProof, zProof and quotientProof open the corresponding commitments at
+// ζ and zShiftedProof opens zCommitment at the shifted point ωζ; all six
+// openings are verified. β and γ are the permutation challenges and must be
+// derived by Fiat-Shamir after the f, g, σ and Z commitments are fixed, and ζ
+// after the quotient commitment, for the single-point check to carry the
+// polynomial identity.
+//
+// The method checks the running-product relation only; the accumulator
+// boundary condition Z(1) = 1 is a separate constraint that the enclosing
+// verifier asserts, typically folded into the full PLONK quotient together
+// with the gate identity ([Verifier.AssertQuotientRelation]).
+func (v *Verifier[FR, G1El, G2El, GTEl]) AssertPermutationOpening(fCommitment, gCommitment, sigmaCommitment, zCommitment, quotientCommitment Commitment[G1El], fProof, gProof, sigmaProof, zProof, zShiftedProof, quotientProof OpeningProof[FR, G1El], beta, gamma, zeta, omega emulated.Element[FR], domainSize uint64, vk VerifyingKey[G1El, G2El]) error {
+	// Z(ωζ)·(g(ζ) + β·σ(ζ) + γ)
+	lhs := v.scalarApi.Add(&gProof.ClaimedValue, v.scalarApi.Mul(&beta, &sigmaProof.ClaimedValue))
+	lhs = v.scalarApi.Add(lhs, &gamma)
+	lhs = v.scalarApi.Mul(&zShiftedProof.ClaimedValue, lhs)
+
+	// Z(ζ)·(f(ζ) + β·ζ + γ)
+	rhs := v.scalarApi.Add(&fProof.ClaimedValue, v.scalarApi.Mul(&beta, &zeta))
+	rhs = v.scalarApi.Add(rhs, &gamma)
+	rhs = v.scalarApi.Mul(&zProof.ClaimedValue, rhs)
+
+	// the difference is the quotient scaled by the vanishing polynomial
+	zh, err := v.EvalVanishing(zeta, domainSize)
+	if err != nil {
+		return fmt.Errorf("eval vanishing: %w", err)
+	}
+	v.scalarApi.AssertIsEqual(v.scalarApi.Sub(lhs, rhs), v.scalarApi.Mul(&quotientProof.ClaimedValue, zh))
+
+	if err := v.CheckOpeningProof(fCommitment, fProof, zeta, vk); err != nil {
+		return fmt.Errorf("f opening: %w", err)
+	}
+	if err := v.CheckOpeningProof(gCommitment, gProof, zeta, vk); err != nil {
+		return fmt.Errorf("g opening: %w", err)
+	}
+	if err := v.CheckOpeningProof(sigmaCommitment, sigmaProof, zeta, vk); err != nil {
+		return fmt.Errorf("sigma opening: %w", err)
+	}
+	if err := v.CheckOpeningProof(zCommitment, zProof, zeta, vk); err != nil {
+		return fmt.Errorf("z opening: %w", err)
+	}
+	if err := v.CheckOpeningProof(quotientCommitment, quotientProof, zeta, vk); err != nil {
+		return fmt.Errorf("quotient opening: %w", err)
+	}
+	zetaShifted := v.scalarApi.Mul(&omega, &zeta)
+	if err := v.CheckOpeningProof(zCommitment, zShiftedProof, *zetaShifted, vk); err != nil {
+		return fmt.Errorf("shifted z opening: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,198 @@
+package kzg
+
+import (
+	"crypto/rand"
+	"fmt"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	fr_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr/fft"
+	kzg_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/kzg"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/algebra"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_bn254"
+	"github.com/consensys/gnark/std/math/emulated"
+	"github.com/consensys/gnark/test"
+)
+
+const permutationDomainSize = 8
+
+type PermutationOpeningCircuit[FR emulated.FieldParams, G1El algebra.G1ElementT, G2El algebra.G2ElementT, GTEl algebra.GtElementT] struct {
+	VerifyingKey[G1El, G2El]
+	ComF, ComG, ComSigma, ComZ, ComQ                          Commitment[G1El]
+	ProofF, ProofG, ProofSigma, ProofZ, ProofZShifted, ProofQ OpeningProof[FR, G1El]
+	Beta, Gamma, Zeta, Omega                                  emulated.Element[FR]
+}
+
+func (c *PermutationOpeningCircuit[FR, G1El, G2El, GTEl]) Define(api frontend.API) error {
+	verifier, err := NewVerifier[FR, G1El, G2El, GTEl](api)
+	if err != nil {
+		return fmt.Errorf("new verifier: %w", err)
+	}
+	return verifier.AssertPermutationOpening(c.ComF, c.ComG, c.ComSigma, c.ComZ, c.ComQ, c.ProofF, c.ProofG, c.ProofSigma, c.ProofZ, c.ProofZShifted, c.ProofQ, c.Beta, c.Gamma, c.Zeta, c.Omega, permutationDomainSize, c.VerifyingKey)
+}
+
+func TestAssertPermutationOpening(t *testing.T) {
+	assert := test.NewAssert(t)
+
+	alpha, err := rand.Int(rand.Reader, ecc.BN254.ScalarField())
+	assert.NoError(err)
+	srs, err := kzg_bn254.NewSRS(kzgSize, alpha)
+	assert.NoError(err)
+
+	domain := fft.NewDomain(permutationDomainSize)
+	omega := domain.Generator
+
+	// interpolate turns evaluations over the domain into coefficients
+	interpolate := func(evals []fr_bn254.Element) []fr_bn254.Element {
+		coeffs := make([]fr_bn254.Element, len(evals))
+		copy(coeffs, evals)
+		domain.FFTInverse(coeffs, fft.DIF)
+		fft.BitReverse(coeffs)
+		return coeffs
+	}
+	// mul is the schoolbook product of two coefficient-form polynomials
+	mul := func(a, b []fr_bn254.Element) []fr_bn254.Element {
+		res := make([]fr_bn254.Element, len(a)+len(b)-1)
+		var t fr_bn254.Element
+		for i := range a {
+			for j := range b {
+				t.Mul(&a[i], &b[j])
+				res[i+j].Add(&res[i+j], &t)
+			}
+		}
+		return res
+	}
+
+	// buildAssignment runs the full permutation-argument pipeline: g is f
+	// permuted by an index reversal unless corrupted, σ encodes the reversal,
+	// Z is the grand product built by the native recurrence and q the quotient
+	// of the running-product identity by the vanishing polynomial
+	buildAssignment := func(corrupt bool) PermutationOpeningCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl] {
+		n := permutationDomainSize
+		xs := make([]fr_bn254.Element, n)
+		xs[0].SetOne()
+		for i := 1; i < n; i++ {
+			xs[i].Mul(&xs[i-1], &omega)
+		}
+		fe := make([]fr_bn254.Element, n)
+		for i := range fe {
+			fe[i].SetRandom()
+		}
+		ge := make([]fr_bn254.Element, n)
+		se := make([]fr_bn254.Element, n)
+		for i := 0; i < n; i++ {
+			pi := n - 1 - i
+			ge[i] = fe[pi]
+			se[i] = xs[pi]
+		}
+		if corrupt {
+			// g is no longer a permutation of f
+			ge[0].SetRandom()
+		}
+
+		var beta, gamma, zeta fr_bn254.Element
+		beta.SetRandom()
+		gamma.SetRandom()
+		zeta.SetRandom()
+
+		// Z(ω⁰)=1, Z(ωⁱ⁺¹) = Z(ωⁱ)·(f(ωⁱ)+β·ωⁱ+γ)/(g(ωⁱ)+β·σ(ωⁱ)+γ)
+		ze := make([]fr_bn254.Element, n)
+		ze[0].SetOne()
+		for i := 0; i < n-1; i++ {
+			var num, den fr_bn254.Element
+			num.Mul(&beta, &xs[i]).Add(&num, &fe[i]).Add(&num, &gamma)
+			den.Mul(&beta, &se[i]).Add(&den, &ge[i]).Add(&den, &gamma)
+			den.Inverse(&den)
+			ze[i+1].Mul(&ze[i], &num).Mul(&ze[i+1], &den)
+		}
+
+		f := interpolate(fe)
+		g := interpolate(ge)
+		sigma := interpolate(se)
+		z := interpolate(ze)
+
+		// numerator Z(ωX)·(g(X)+β·σ(X)+γ) - Z(X)·(f(X)+β·X+γ)
+		zOmega := make([]fr_bn254.Element, n)
+		pow := xs[0]
+		for i := 0; i < n; i++ {
+			zOmega[i].Mul(&z[i], &pow)
+			pow.Mul(&pow, &omega)
+		}
+		gSide := make([]fr_bn254.Element, n)
+		fSide := make([]fr_bn254.Element, n)
+		var t fr_bn254.Element
+		for i := 0; i < n; i++ {
+			gSide[i].Mul(&beta, &sigma[i]).Add(&gSide[i], &g[i])
+			fSide[i] = f[i]
+		}
+		gSide[0].Add(&gSide[0], &gamma)
+		fSide[0].Add(&fSide[0], &gamma)
+		fSide[1].Add(&fSide[1], &beta)
+		num := mul(zOmega, gSide)
+		sub := mul(z, fSide)
+		for i := range num {
+			num[i].Sub(&num[i], &sub[i])
+		}
+
+		// exact division by Xⁿ-1; a nonzero remainder (the corrupted case) is
+		// dropped, leaving a quotient that fails the identity at a random ζ
+		q := make([]fr_bn254.Element, n)
+		for d := len(num) - 1; d >= n; d-- {
+			t = num[d]
+			q[d-n].Add(&q[d-n], &t)
+			num[d-n].Add(&num[d-n], &t)
+			num[d].SetZero()
+		}
+		if !corrupt {
+			for i := 0; i < n; i++ {
+				assert.True(num[i].IsZero())
+			}
+		}
+
+		var zetaShifted fr_bn254.Element
+		zetaShifted.Mul(&zeta, &omega)
+
+		assignment := PermutationOpeningCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{
+			Beta:  emulated.ValueOf[sw_bn254.ScalarField](beta),
+			Gamma: emulated.ValueOf[sw_bn254.ScalarField](gamma),
+			Zeta:  emulated.ValueOf[sw_bn254.ScalarField](zeta),
+			Omega: emulated.ValueOf[sw_bn254.ScalarField](omega),
+		}
+		assignment.VerifyingKey, err = ValueOfVerifyingKey[sw_bn254.G1Affine, sw_bn254.G2Affine](srs.Vk)
+		assert.NoError(err)
+		commitOpen := func(poly []fr_bn254.Element, at fr_bn254.Element) (Commitment[sw_bn254.G1Affine], OpeningProof[sw_bn254.ScalarField, sw_bn254.G1Affine]) {
+			com, err := kzg_bn254.Commit(poly, srs.Pk)
+			assert.NoError(err)
+			proof, err := kzg_bn254.Open(poly, at, srs.Pk)
+			assert.NoError(err)
+			wCom, err := ValueOfCommitment[sw_bn254.G1Affine](com)
+			assert.NoError(err)
+			wProof, err := ValueOfOpeningProof[sw_bn254.ScalarField, sw_bn254.G1Affine](proof)
+			assert.NoError(err)
+			return wCom, wProof
+		}
+		assignment.ComF, assignment.ProofF = commitOpen(f, zeta)
+		assignment.ComG, assignment.ProofG = commitOpen(g, zeta)
+		assignment.ComSigma, assignment.ProofSigma = commitOpen(sigma, zeta)
+		assignment.ComZ, assignment.ProofZ = commitOpen(z, zeta)
+		assignment.ComQ, assignment.ProofQ = commitOpen(q, zeta)
+		_, assignment.ProofZShifted = commitOpen(z, zetaShifted)
+		return assignment
+	}
+
+	circuit := PermutationOpeningCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{}
+
+	assert.Run(func(assert *test.Assert) {
+		assignment := buildAssignment(false)
+		assert.NoError(test.IsSolved(&circuit, &assignment, ecc.BN254.ScalarField()))
+	}, "valid")
+
+	assert.Run(func(assert *test.Assert) {
+		// when g is not a permutation of f the identity does not divide by
+		// the vanishing polynomial, so the check fails at a random ζ
+		assignment := buildAssignment(true)
+		assert.Error(test.IsSolved(&circuit, &assignment, ecc.BN254.ScalarField()))
+	}, "not a permutation")
+}
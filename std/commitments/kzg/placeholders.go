@@ -0,0 +1,32 @@
+package kzg
+
+import (
+	"github.com/consensys/gnark/std/algebra"
+	"github.com/consensys/gnark/std/math/emulated"
+)
+
+// PlaceholderCommitment returns a commitment placeholder for compiling
+// without a concrete native commitment. The emulated coordinate limbs are
+// allocated lazily by the circuit parser, so the zero value compiles for
+// every supported parametrization.
+func PlaceholderCommitment[G1El algebra.G1ElementT]() Commitment[G1El] {
+	var ret Commitment[G1El]
+	return ret
+}
+
+// PlaceholderOpeningProof returns an opening proof placeholder for compiling
+// without a concrete native proof. See [PlaceholderCommitment].
+func PlaceholderOpeningProof[FR emulated.FieldParams, G1El algebra.G1ElementT]() OpeningProof[FR, G1El] {
+	var ret OpeningProof[FR, G1El]
+	return ret
+}
+
+// PlaceholderFullSRS returns a full SRS placeholder with nbG1Powers G1
+// powers for compiling without a concrete native SRS. The length of the
+// power slice is part of the circuit shape and must match the assignment
+// from [ValueOfFullSRS].
+func PlaceholderFullSRS[G1El algebra.G1ElementT, G2El algebra.G2ElementT](nbG1Powers int) FullSRS[G1El, G2El] {
+	var ret FullSRS[G1El, G2El]
+	ret.G1Powers = make([]G1El, nbG1Powers)
+	return ret
+}
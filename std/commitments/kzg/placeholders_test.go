@@ -0,0 +1,65 @@
+package kzg
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/frontend/cs/scs"
+	"github.com/consensys/gnark/std/algebra"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_bls12381"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_bn254"
+	"github.com/consensys/gnark/std/algebra/native/sw_bls12377"
+	"github.com/consensys/gnark/std/math/emulated"
+	"github.com/consensys/gnark/test"
+)
+
+const placeholderNbG1Powers = 4
+
+type PlaceholderCircuit[FR emulated.FieldParams, G1El algebra.G1ElementT, G2El algebra.G2ElementT] struct {
+	Commitment Commitment[G1El]
+	Proof      OpeningProof[FR, G1El]
+	SRS        FullSRS[G1El, G2El]
+}
+
+func (c *PlaceholderCircuit[FR, G1El, G2El]) Define(api frontend.API) error {
+	if len(c.SRS.G1Powers) != placeholderNbG1Powers {
+		return fmt.Errorf("unexpected number of G1 powers %d", len(c.SRS.G1Powers))
+	}
+	return nil
+}
+
+// TestPlaceholders compiles a circuit declared from the placeholder
+// constructors for each supported curve, without ever constructing a native
+// commitment, proof or SRS.
+func TestPlaceholders(t *testing.T) {
+	assert := test.NewAssert(t)
+	assert.Run(func(assert *test.Assert) {
+		circuit := PlaceholderCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine]{
+			Commitment: PlaceholderCommitment[sw_bn254.G1Affine](),
+			Proof:      PlaceholderOpeningProof[sw_bn254.ScalarField, sw_bn254.G1Affine](),
+			SRS:        PlaceholderFullSRS[sw_bn254.G1Affine, sw_bn254.G2Affine](placeholderNbG1Powers),
+		}
+		_, err := frontend.Compile(ecc.BN254.ScalarField(), scs.NewBuilder, &circuit, frontend.IgnoreUnconstrainedInputs())
+		assert.NoError(err)
+	}, "bn254")
+	assert.Run(func(assert *test.Assert) {
+		circuit := PlaceholderCircuit[sw_bls12377.ScalarField, sw_bls12377.G1Affine, sw_bls12377.G2Affine]{
+			Commitment: PlaceholderCommitment[sw_bls12377.G1Affine](),
+			Proof:      PlaceholderOpeningProof[sw_bls12377.ScalarField, sw_bls12377.G1Affine](),
+			SRS:        PlaceholderFullSRS[sw_bls12377.G1Affine, sw_bls12377.G2Affine](placeholderNbG1Powers),
+		}
+		_, err := frontend.Compile(ecc.BW6_761.ScalarField(), scs.NewBuilder, &circuit, frontend.IgnoreUnconstrainedInputs())
+		assert.NoError(err)
+	}, "bls12377")
+	assert.Run(func(assert *test.Assert) {
+		circuit := PlaceholderCircuit[sw_bls12381.ScalarField, sw_bls12381.G1Affine, sw_bls12381.G2Affine]{
+			Commitment: PlaceholderCommitment[sw_bls12381.G1Affine](),
+			Proof:      PlaceholderOpeningProof[sw_bls12381.ScalarField, sw_bls12381.G1Affine](),
+			SRS:        PlaceholderFullSRS[sw_bls12381.G1Affine, sw_bls12381.G2Affine](placeholderNbG1Powers),
+		}
+		_, err := frontend.Compile(ecc.BN254.ScalarField(), scs.NewBuilder, &circuit, frontend.IgnoreUnconstrainedInputs())
+		assert.NoError(err)
+	}, "bls12381")
+}
@@ -0,0 +1,93 @@
+package kzg
+
+import (
+	"fmt"
+
+	"github.com/consensys/gnark/std/math/emulated"
+)
+
+// EvalVanishing evaluates the vanishing polynomial Zₕ(X) = Xⁿ - 1 of the
+// multiplicative subgroup of size domainSize at the given point. domainSize
+// must be a power of two.
+func (v *Verifier[FR, G1El, G2El, GTEl]) EvalVanishing(point emulated.Element[FR], domainSize uint64) (*emulated.Element[FR], error) {
+	if domainSize == 0 || domainSize&(domainSize-1) != 0 {
+		return nil, fmt.Errorf("domain size %d is not a power of two", domainSize)
+	}
+	res := &point
+	for n := domainSize; n > 1; n >>= 1 {
+		res = v.scalarApi.Mul(res, res)
+	}
+	return v.scalarApi.Sub(res, v.scalarApi.One()), nil
+}
+
+// AssertQuotientRelation asserts the PLONK quotient argument at the evaluation
+// point ζ: the gate polynomial evaluates to the quotient polynomial scaled by
+// the vanishing polynomial of the domain,
+//
+//	gate(ζ) == quotient(ζ)·Zₕ(ζ).
+//
+// The claimed evaluations are taken from the opening proofs, which are both
+// verified at ζ against the corresponding commitments.
+func (v *Verifier[FR, G1El, G2El, GTEl]) AssertQuotientRelation(gateCommitment, quotientCommitment Commitment[G1El], gateProof, quotientProof OpeningProof[FR, G1El], zeta emulated.Element[FR], domainSize uint64, vk VerifyingKey[G1El, G2El]) error {
+	zh, err := v.EvalVanishing(zeta, domainSize)
+	if err != nil {
+		return fmt.Errorf("eval vanishing: %w", err)
+	}
+	scaled := v.scalarApi.Mul(&quotientProof.ClaimedValue, zh)
+	v.scalarApi.AssertIsEqual(&gateProof.ClaimedValue, scaled)
+
+	if err := v.CheckOpeningProof(gateCommitment, gateProof, zeta, vk); err != nil {
+		return fmt.Errorf("gate opening: %w", err)
+	}
+	if err := v.CheckOpeningProof(quotientCommitment, quotientProof, zeta, vk); err != nil {
+		return fmt.Errorf("quotient opening: %w", err)
+	}
+	return nil
+}
+
+// AssertPlonkOpenings asserts the two-point opening combination used by the
+// PLONK verifier: commitmentZeta opens to proofZeta.ClaimedValue at ζ and
+// commitmentShifted opens to proofShifted.ClaimedValue at ωζ, where the two
+// openings are combined with the challenge u into a single pairing check.
+//
+// commitmentZeta is expected to be the linear combination of the individual
+// commitments opened at ζ by powers of the challenge v (with the claimed value
+// folded accordingly), as produced by the PLONK verifier before the final
+// opening check. The method implements
+//
+//	e(F, G₂) · e(-(Q_ζ + u·Q_ωζ), [α]G₂) == 1
+//
+// with F = C_ζ - [v_ζ]G₁ + u·(C_ωζ - [v_ωζ]G₁) + ζ·Q_ζ + u·ωζ·Q_ωζ, which is
+// equivalent to verifying the two openings independently.
+func (v *Verifier[FR, G1El, G2El, GTEl]) AssertPlonkOpenings(commitmentZeta, commitmentShifted Commitment[G1El], proofZeta, proofShifted OpeningProof[FR, G1El], zeta, u, omega emulated.Element[FR], vk VerifyingKey[G1El, G2El]) error {
+	zetaOmega := v.scalarApi.Mul(&zeta, &omega)
+
+	// folded claimed values v_ζ + u·v_ωζ, subtracted from the combination via
+	// the [1]G₁ SRS point.
+	foldedEvals := v.scalarApi.Add(&proofZeta.ClaimedValue, v.scalarApi.Mul(&u, &proofShifted.ClaimedValue))
+	negG1 := v.curve.Neg(&vk.G1)
+	uZetaOmega := v.scalarApi.Mul(&u, zetaOmega)
+
+	// C_ωζ·u - [v_ζ + u·v_ωζ]G₁ + ζ·Q_ζ + u·ωζ·Q_ωζ
+	folded, err := v.curve.MultiScalarMul(
+		[]*G1El{&commitmentShifted.G1El, negG1, &proofZeta.Quotient, &proofShifted.Quotient},
+		[]*emulated.Element[FR]{&u, foldedEvals, &zeta, uZetaOmega},
+	)
+	if err != nil {
+		return fmt.Errorf("fold digests: %w", err)
+	}
+	foldedDigest := v.curve.Add(folded, &commitmentZeta.G1El)
+
+	// -(Q_ζ + u·Q_ωζ)
+	foldedQuotients := v.curve.ScalarMul(&proofShifted.Quotient, &u)
+	foldedQuotients = v.curve.Add(&proofZeta.Quotient, foldedQuotients)
+	foldedQuotients = v.curve.Neg(foldedQuotients)
+
+	if err := v.pairing.PairingCheck(
+		[]*G1El{foldedDigest, foldedQuotients},
+		[]*G2El{&vk.G2[0], &vk.G2[1]},
+	); err != nil {
+		return fmt.Errorf("pairing check: %w", err)
+	}
+	return nil
+}
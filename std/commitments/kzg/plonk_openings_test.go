@@ -0,0 +1,187 @@
+package kzg
+
+import (
+	"crypto/rand"
+	"fmt"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	fr_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	kzg_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/kzg"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/algebra"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_bn254"
+	"github.com/consensys/gnark/std/math/emulated"
+	"github.com/consensys/gnark/test"
+)
+
+type PlonkOpeningsCircuit[FR emulated.FieldParams, G1El algebra.G1ElementT, G2El algebra.G2ElementT, GTEl algebra.GtElementT] struct {
+	Vk                VerifyingKey[G1El, G2El]
+	CommitmentZeta    Commitment[G1El]
+	CommitmentShifted Commitment[G1El]
+	ProofZeta         OpeningProof[FR, G1El]
+	ProofShifted      OpeningProof[FR, G1El]
+	Zeta              emulated.Element[FR]
+	U                 emulated.Element[FR]
+	Omega             emulated.Element[FR]
+}
+
+func (c *PlonkOpeningsCircuit[FR, G1El, G2El, GTEl]) Define(api frontend.API) error {
+	verifier, err := NewVerifier[FR, G1El, G2El, GTEl](api)
+	if err != nil {
+		return fmt.Errorf("new verifier: %w", err)
+	}
+	if err := verifier.AssertPlonkOpenings(c.CommitmentZeta, c.CommitmentShifted, c.ProofZeta, c.ProofShifted, c.Zeta, c.U, c.Omega, c.Vk); err != nil {
+		return fmt.Errorf("assert plonk openings: %w", err)
+	}
+	return nil
+}
+
+type QuotientRelationCircuit[FR emulated.FieldParams, G1El algebra.G1ElementT, G2El algebra.G2ElementT, GTEl algebra.GtElementT] struct {
+	Vk                 VerifyingKey[G1El, G2El]
+	GateCommitment     Commitment[G1El]
+	QuotientCommitment Commitment[G1El]
+	GateProof          OpeningProof[FR, G1El]
+	QuotientProof      OpeningProof[FR, G1El]
+	Zeta               emulated.Element[FR]
+	domainSize         uint64
+}
+
+func (c *QuotientRelationCircuit[FR, G1El, G2El, GTEl]) Define(api frontend.API) error {
+	verifier, err := NewVerifier[FR, G1El, G2El, GTEl](api)
+	if err != nil {
+		return fmt.Errorf("new verifier: %w", err)
+	}
+	if err := verifier.AssertQuotientRelation(c.GateCommitment, c.QuotientCommitment, c.GateProof, c.QuotientProof, c.Zeta, c.domainSize, c.Vk); err != nil {
+		return fmt.Errorf("assert quotient relation: %w", err)
+	}
+	return nil
+}
+
+func TestQuotientRelation(t *testing.T) {
+	assert := test.NewAssert(t)
+	const domainSize = 16
+
+	alpha, err := rand.Int(rand.Reader, ecc.BN254.ScalarField())
+	assert.NoError(err)
+	srs, err := kzg_bn254.NewSRS(kzgSize, alpha)
+	assert.NoError(err)
+
+	// random quotient polynomial and the gate polynomial
+	// gate = quotient·(Xⁿ - 1)
+	quotient := make([]fr_bn254.Element, polynomialSize-domainSize)
+	for i := range quotient {
+		quotient[i].SetRandom()
+	}
+	gate := make([]fr_bn254.Element, len(quotient)+domainSize)
+	for i := range quotient {
+		gate[i].Neg(&quotient[i])
+	}
+	for i := range quotient {
+		gate[i+domainSize].Add(&gate[i+domainSize], &quotient[i])
+	}
+
+	comGate, err := kzg_bn254.Commit(gate, srs.Pk)
+	assert.NoError(err)
+	comQuotient, err := kzg_bn254.Commit(quotient, srs.Pk)
+	assert.NoError(err)
+
+	var zeta fr_bn254.Element
+	zeta.SetRandom()
+	proofGate, err := kzg_bn254.Open(gate, zeta, srs.Pk)
+	assert.NoError(err)
+	proofQuotient, err := kzg_bn254.Open(quotient, zeta, srs.Pk)
+	assert.NoError(err)
+
+	wVk, err := ValueOfVerifyingKey[sw_bn254.G1Affine, sw_bn254.G2Affine](srs.Vk)
+	assert.NoError(err)
+	wComGate, err := ValueOfCommitment[sw_bn254.G1Affine](comGate)
+	assert.NoError(err)
+	wComQuotient, err := ValueOfCommitment[sw_bn254.G1Affine](comQuotient)
+	assert.NoError(err)
+	wProofGate, err := ValueOfOpeningProof[sw_bn254.ScalarField, sw_bn254.G1Affine](proofGate)
+	assert.NoError(err)
+	wProofQuotient, err := ValueOfOpeningProof[sw_bn254.ScalarField, sw_bn254.G1Affine](proofQuotient)
+	assert.NoError(err)
+	wZeta, err := ValueOfScalar[sw_bn254.ScalarField](zeta)
+	assert.NoError(err)
+
+	assignment := QuotientRelationCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{
+		Vk:                 wVk,
+		GateCommitment:     wComGate,
+		QuotientCommitment: wComQuotient,
+		GateProof:          wProofGate,
+		QuotientProof:      wProofQuotient,
+		Zeta:               wZeta,
+	}
+	err = test.IsSolved(&QuotientRelationCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{domainSize: domainSize}, &assignment, ecc.BN254.ScalarField())
+	assert.NoError(err)
+}
+
+func TestPlonkOpenings(t *testing.T) {
+	assert := test.NewAssert(t)
+
+	alpha, err := rand.Int(rand.Reader, ecc.BN254.ScalarField())
+	assert.NoError(err)
+	srs, err := kzg_bn254.NewSRS(kzgSize, alpha)
+	assert.NoError(err)
+
+	// the "folded at ζ" polynomial and the shifted polynomial
+	fZeta := make([]fr_bn254.Element, polynomialSize)
+	fShifted := make([]fr_bn254.Element, polynomialSize)
+	for i := range fZeta {
+		fZeta[i].SetRandom()
+		fShifted[i].SetRandom()
+	}
+	comZeta, err := kzg_bn254.Commit(fZeta, srs.Pk)
+	assert.NoError(err)
+	comShifted, err := kzg_bn254.Commit(fShifted, srs.Pk)
+	assert.NoError(err)
+
+	var zeta, u, omega, zetaOmega fr_bn254.Element
+	zeta.SetRandom()
+	u.SetRandom()
+	omega.SetRandom()
+	zetaOmega.Mul(&zeta, &omega)
+
+	proofZeta, err := kzg_bn254.Open(fZeta, zeta, srs.Pk)
+	assert.NoError(err)
+	proofShifted, err := kzg_bn254.Open(fShifted, zetaOmega, srs.Pk)
+	assert.NoError(err)
+
+	// sanity check the openings natively
+	assert.NoError(kzg_bn254.Verify(&comZeta, &proofZeta, zeta, srs.Vk))
+	assert.NoError(kzg_bn254.Verify(&comShifted, &proofShifted, zetaOmega, srs.Vk))
+
+	wVk, err := ValueOfVerifyingKey[sw_bn254.G1Affine, sw_bn254.G2Affine](srs.Vk)
+	assert.NoError(err)
+	wComZeta, err := ValueOfCommitment[sw_bn254.G1Affine](comZeta)
+	assert.NoError(err)
+	wComShifted, err := ValueOfCommitment[sw_bn254.G1Affine](comShifted)
+	assert.NoError(err)
+	wProofZeta, err := ValueOfOpeningProof[sw_bn254.ScalarField, sw_bn254.G1Affine](proofZeta)
+	assert.NoError(err)
+	wProofShifted, err := ValueOfOpeningProof[sw_bn254.ScalarField, sw_bn254.G1Affine](proofShifted)
+	assert.NoError(err)
+	wZeta, err := ValueOfScalar[sw_bn254.ScalarField](zeta)
+	assert.NoError(err)
+	wU, err := ValueOfScalar[sw_bn254.ScalarField](u)
+	assert.NoError(err)
+	wOmega, err := ValueOfScalar[sw_bn254.ScalarField](omega)
+	assert.NoError(err)
+
+	assignment := PlonkOpeningsCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{
+		Vk:                wVk,
+		CommitmentZeta:    wComZeta,
+		CommitmentShifted: wComShifted,
+		ProofZeta:         wProofZeta,
+		ProofShifted:      wProofShifted,
+		Zeta:              wZeta,
+		U:                 wU,
+		Omega:             wOmega,
+	}
+	assert.CheckCircuit(&PlonkOpeningsCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{},
+		test.WithValidAssignment(&assignment),
+		test.WithCurves(ecc.BN254),
+	)
+}
@@ -0,0 +1,177 @@
+package kzg
+
+import (
+	"fmt"
+	"math/big"
+	"slices"
+
+	fp_bls12377 "github.com/consensys/gnark-crypto/ecc/bls12-377/fp"
+	fr_bls12377 "github.com/consensys/gnark-crypto/ecc/bls12-377/fr"
+	kzg_bls12377 "github.com/consensys/gnark-crypto/ecc/bls12-377/kzg"
+	fp_bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381/fp"
+	fr_bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+	kzg_bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381/kzg"
+	fp_bls24315 "github.com/consensys/gnark-crypto/ecc/bls24-315/fp"
+	fr_bls24315 "github.com/consensys/gnark-crypto/ecc/bls24-315/fr"
+	kzg_bls24315 "github.com/consensys/gnark-crypto/ecc/bls24-315/kzg"
+	fp_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/fp"
+	fr_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	kzg_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/kzg"
+	fp_bw6761 "github.com/consensys/gnark-crypto/ecc/bw6-761/fp"
+	fr_bw6761 "github.com/consensys/gnark-crypto/ecc/bw6-761/fr"
+	kzg_bw6761 "github.com/consensys/gnark-crypto/ecc/bw6-761/kzg"
+)
+
+// Encoding describes how a prover serializes an affine G1 point into bytes:
+// the order of the coordinates and the byte order within each coordinate.
+// Every coordinate occupies the full base-field byte width.
+type Encoding uint8
+
+const (
+	// EncodingXYBigEndian is gnark-crypto's native uncompressed encoding:
+	// big-endian X followed by big-endian Y. It is the default.
+	EncodingXYBigEndian Encoding = iota
+	// EncodingXYLittleEndian is X followed by Y, each coordinate byte-swapped.
+	EncodingXYLittleEndian
+	// EncodingYXBigEndian is big-endian Y followed by big-endian X.
+	EncodingYXBigEndian
+	// EncodingYXLittleEndian is Y followed by X, each coordinate byte-swapped.
+	EncodingYXLittleEndian
+)
+
+// SerializedOpeningProof is an opening proof whose quotient point is kept in
+// the prover's raw serialized form. Use it with [ValueOfOpeningProof] and
+// [WithPointEncoding] when the prover does not use gnark-crypto's point
+// encoding — deserializing such bytes through the native point types would
+// silently reduce out-of-range coordinate readings modulo the base field, so
+// the raw bytes must be carried until the encoding is known. ClaimedValue is
+// the native scalar field element of the target curve; its type also selects
+// the curve the quotient bytes are decoded for.
+type SerializedOpeningProof struct {
+	Quotient     []byte
+	ClaimedValue any
+}
+
+// witnessConfig allows configuring how witness values are constructed from
+// native material.
+type witnessConfig struct {
+	pointEncoding Encoding
+}
+
+// WitnessOption allows configuring the witness constructors.
+type WitnessOption func(*witnessConfig) error
+
+func newWitnessConfig(opts ...WitnessOption) (*witnessConfig, error) {
+	cfg := &witnessConfig{}
+	for _, opt := range opts {
+		if err := opt(cfg); err != nil {
+			return nil, err
+		}
+	}
+	return cfg, nil
+}
+
+// WithPointEncoding sets the serialization the prover used for the quotient
+// point, allowing the witness to be built from provers which byte-swap the
+// coordinates or emit them in Y-then-X order. It only has an effect when the
+// proof is provided as a [SerializedOpeningProof]; native gnark-crypto proof
+// types are already deserialized and use the default [EncodingXYBigEndian].
+func WithPointEncoding(enc Encoding) WitnessOption {
+	return func(cfg *witnessConfig) error {
+		if enc > EncodingYXLittleEndian {
+			return fmt.Errorf("unknown point encoding %d", enc)
+		}
+		cfg.pointEncoding = enc
+		return nil
+	}
+}
+
+// decodeCoordinates splits a raw affine point serialization into its X and Y
+// coordinates according to the encoding. The raw length must be twice the
+// base-field byte width.
+func decodeCoordinates(raw []byte, width int, enc Encoding) (x, y *big.Int, err error) {
+	if len(raw) != 2*width {
+		return nil, nil, fmt.Errorf("expected %d bytes, got %d", 2*width, len(raw))
+	}
+	first := slices.Clone(raw[:width])
+	second := slices.Clone(raw[width:])
+	switch enc {
+	case EncodingXYBigEndian:
+	case EncodingXYLittleEndian:
+		slices.Reverse(first)
+		slices.Reverse(second)
+	case EncodingYXBigEndian:
+		first, second = second, first
+	case EncodingYXLittleEndian:
+		slices.Reverse(first)
+		slices.Reverse(second)
+		first, second = second, first
+	default:
+		return nil, nil, fmt.Errorf("unknown point encoding %d", enc)
+	}
+	return new(big.Int).SetBytes(first), new(big.Int).SetBytes(second), nil
+}
+
+// deserializeOpeningProof decodes a [SerializedOpeningProof] into the native
+// opening proof of the curve selected by the claimed value type. Non-raw
+// inputs are passed through unchanged.
+func deserializeOpeningProof(proof any, enc Encoding) (any, error) {
+	sProof, ok := proof.(SerializedOpeningProof)
+	if !ok {
+		return proof, nil
+	}
+	switch claimed := sProof.ClaimedValue.(type) {
+	case fr_bn254.Element:
+		x, y, err := decodeCoordinates(sProof.Quotient, fp_bn254.Bytes, enc)
+		if err != nil {
+			return nil, fmt.Errorf("decode quotient: %w", err)
+		}
+		var ret kzg_bn254.OpeningProof
+		ret.H.X.SetBigInt(x)
+		ret.H.Y.SetBigInt(y)
+		ret.ClaimedValue = claimed
+		return ret, nil
+	case fr_bls12377.Element:
+		x, y, err := decodeCoordinates(sProof.Quotient, fp_bls12377.Bytes, enc)
+		if err != nil {
+			return nil, fmt.Errorf("decode quotient: %w", err)
+		}
+		var ret kzg_bls12377.OpeningProof
+		ret.H.X.SetBigInt(x)
+		ret.H.Y.SetBigInt(y)
+		ret.ClaimedValue = claimed
+		return ret, nil
+	case fr_bls12381.Element:
+		x, y, err := decodeCoordinates(sProof.Quotient, fp_bls12381.Bytes, enc)
+		if err != nil {
+			return nil, fmt.Errorf("decode quotient: %w", err)
+		}
+		var ret kzg_bls12381.OpeningProof
+		ret.H.X.SetBigInt(x)
+		ret.H.Y.SetBigInt(y)
+		ret.ClaimedValue = claimed
+		return ret, nil
+	case fr_bw6761.Element:
+		x, y, err := decodeCoordinates(sProof.Quotient, fp_bw6761.Bytes, enc)
+		if err != nil {
+			return nil, fmt.Errorf("decode quotient: %w", err)
+		}
+		var ret kzg_bw6761.OpeningProof
+		ret.H.X.SetBigInt(x)
+		ret.H.Y.SetBigInt(y)
+		ret.ClaimedValue = claimed
+		return ret, nil
+	case fr_bls24315.Element:
+		x, y, err := decodeCoordinates(sProof.Quotient, fp_bls24315.Bytes, enc)
+		if err != nil {
+			return nil, fmt.Errorf("decode quotient: %w", err)
+		}
+		var ret kzg_bls24315.OpeningProof
+		ret.H.X.SetBigInt(x)
+		ret.H.Y.SetBigInt(y)
+		ret.ClaimedValue = claimed
+		return ret, nil
+	default:
+		return nil, fmt.Errorf("unknown claimed value type %T", sProof.ClaimedValue)
+	}
+}
@@ -0,0 +1,91 @@
+package kzg
+
+import (
+	"crypto/rand"
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	fr_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	kzg_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/kzg"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_bn254"
+	"github.com/consensys/gnark/std/math/emulated"
+	"github.com/consensys/gnark/test"
+)
+
+// serializeQuotient emulates a partner prover's point serialization.
+func serializeQuotient(proof kzg_bn254.OpeningProof, enc Encoding) []byte {
+	xBytes := proof.H.X.Bytes()
+	yBytes := proof.H.Y.Bytes()
+	first, second := xBytes[:], yBytes[:]
+	switch enc {
+	case EncodingXYLittleEndian:
+		slices.Reverse(first)
+		slices.Reverse(second)
+	case EncodingYXBigEndian:
+		first, second = second, first
+	case EncodingYXLittleEndian:
+		slices.Reverse(first)
+		slices.Reverse(second)
+		first, second = second, first
+	}
+	return append(append([]byte{}, first...), second...)
+}
+
+func TestOpeningProofPointEncoding(t *testing.T) {
+	assert := test.NewAssert(t)
+
+	alpha, err := rand.Int(rand.Reader, ecc.BN254.ScalarField())
+	assert.NoError(err)
+	srs, err := kzg_bn254.NewSRS(kzgSize, alpha)
+	assert.NoError(err)
+
+	f := make([]fr_bn254.Element, polynomialSize)
+	for i := range f {
+		f[i].SetRandom()
+	}
+	com, err := kzg_bn254.Commit(f, srs.Pk)
+	assert.NoError(err)
+	var point fr_bn254.Element
+	point.SetRandom()
+	proof, err := kzg_bn254.Open(f, point, srs.Pk)
+	assert.NoError(err)
+
+	circuit := KZGVerificationCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{}
+	baseAssignment := KZGVerificationCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{
+		Point: emulated.ValueOf[sw_bn254.ScalarField](point),
+	}
+	baseAssignment.VerifyingKey, err = ValueOfVerifyingKey[sw_bn254.G1Affine, sw_bn254.G2Affine](srs.Vk)
+	assert.NoError(err)
+	baseAssignment.Commitment, err = ValueOfCommitment[sw_bn254.G1Affine](com)
+	assert.NoError(err)
+
+	for _, enc := range []Encoding{EncodingXYBigEndian, EncodingXYLittleEndian, EncodingYXBigEndian, EncodingYXLittleEndian} {
+		enc := enc
+		assert.Run(func(assert *test.Assert) {
+			// round trip through the partner's serialization
+			raw := serializeQuotient(proof, enc)
+			assignment := baseAssignment
+			assignment.OpeningProof, err = ValueOfOpeningProof[sw_bn254.ScalarField, sw_bn254.G1Affine](SerializedOpeningProof{
+				Quotient:     raw,
+				ClaimedValue: proof.ClaimedValue,
+			}, WithPointEncoding(enc))
+			assert.NoError(err)
+			assert.NoError(test.IsSolved(&circuit, &assignment, ecc.BN254.ScalarField()))
+		}, fmt.Sprintf("encoding=%d", enc))
+	}
+
+	assert.Run(func(assert *test.Assert) {
+		// interpreting byte-swapped bytes with the default encoding yields a
+		// wrong point
+		raw := serializeQuotient(proof, EncodingXYLittleEndian)
+		assignment := baseAssignment
+		assignment.OpeningProof, err = ValueOfOpeningProof[sw_bn254.ScalarField, sw_bn254.G1Affine](SerializedOpeningProof{
+			Quotient:     raw,
+			ClaimedValue: proof.ClaimedValue,
+		})
+		assert.NoError(err)
+		assert.Error(test.IsSolved(&circuit, &assignment, ecc.BN254.ScalarField()))
+	}, "mismatched encoding")
+}
@@ -0,0 +1,63 @@
+package kzg
+
+import (
+	"fmt"
+
+	"github.com/consensys/gnark/std/math/emulated"
+)
+
+type precheckConfig struct {
+	nonIdentityQuotient bool
+}
+
+// PreCheckOption allows to alter the behavior of [Verifier.PreCheck].
+type PreCheckOption func(*precheckConfig) error
+
+// WithNonIdentityQuotient additionally asserts that the quotient of the proof
+// is not the point at infinity. A valid opening of a non-constant polynomial
+// never has an identity quotient, so enabling the check rejects trivially
+// degenerate proofs early.
+func WithNonIdentityQuotient() PreCheckOption {
+	return func(cfg *precheckConfig) error {
+		cfg.nonIdentityQuotient = true
+		return nil
+	}
+}
+
+// PreCheck asserts cheap well-formedness conditions on the commitment and the
+// opening proof before the expensive pairing: both points are on the curve
+// and the claimed value has a canonical representation. It fails fast on
+// obviously malformed witnesses, which shortens the debugging loop and guards
+// against proofs exploiting non-unique encodings. Call it before
+// [Verifier.CheckOpeningProof], or use
+// [Verifier.CheckOpeningProofPrechecked] which combines the two.
+func (v *Verifier[FR, G1El, G2El, GTEl]) PreCheck(commitment Commitment[G1El], proof OpeningProof[FR, G1El], opts ...PreCheckOption) error {
+	var cfg precheckConfig
+	for _, opt := range opts {
+		if err := opt(&cfg); err != nil {
+			return fmt.Errorf("apply option: %w", err)
+		}
+	}
+	v.pairing.AssertIsOnG1(&commitment.G1El)
+	v.pairing.AssertIsOnG1(&proof.Quotient)
+	// canonical decomposition constrains the claimed value to its unique
+	// reduced representation
+	v.scalarApi.ToBitsCanonical(&proof.ClaimedValue)
+	if cfg.nonIdentityQuotient {
+		// the point at infinity marshals as all zero bits
+		bts := v.curve.MarshalG1(proof.Quotient)
+		acc := v.api.Add(bts[0], bts[1], bts[2:]...)
+		v.api.AssertIsDifferent(acc, 0)
+	}
+	return nil
+}
+
+// CheckOpeningProofPrechecked asserts the validity of the opening proof for
+// the given commitment at point, running [Verifier.PreCheck] on the witness
+// first.
+func (v *Verifier[FR, G1El, G2El, GTEl]) CheckOpeningProofPrechecked(commitment Commitment[G1El], proof OpeningProof[FR, G1El], point emulated.Element[FR], vk VerifyingKey[G1El, G2El], opts ...PreCheckOption) error {
+	if err := v.PreCheck(commitment, proof, opts...); err != nil {
+		return fmt.Errorf("precheck: %w", err)
+	}
+	return v.CheckOpeningProof(commitment, proof, point, vk)
+}
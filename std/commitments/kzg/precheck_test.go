@@ -0,0 +1,94 @@
+package kzg
+
+import (
+	"crypto/rand"
+	"fmt"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bn254"
+	fp_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/fp"
+	fr_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	kzg_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/kzg"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/algebra"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_bn254"
+	"github.com/consensys/gnark/std/math/emulated"
+	"github.com/consensys/gnark/test"
+)
+
+type PreCheckCircuit[FR emulated.FieldParams, G1El algebra.G1ElementT, G2El algebra.G2ElementT, GTEl algebra.GtElementT] struct {
+	Commitment[G1El]
+	OpeningProof[FR, G1El]
+	nonIdentity bool
+}
+
+func (c *PreCheckCircuit[FR, G1El, G2El, GTEl]) Define(api frontend.API) error {
+	verifier, err := NewVerifier[FR, G1El, G2El, GTEl](api)
+	if err != nil {
+		return fmt.Errorf("new verifier: %w", err)
+	}
+	var opts []PreCheckOption
+	if c.nonIdentity {
+		opts = append(opts, WithNonIdentityQuotient())
+	}
+	if err := verifier.PreCheck(c.Commitment, c.OpeningProof, opts...); err != nil {
+		return fmt.Errorf("precheck: %w", err)
+	}
+	return nil
+}
+
+func TestPreCheck(t *testing.T) {
+	assert := test.NewAssert(t)
+
+	alpha, err := rand.Int(rand.Reader, ecc.BN254.ScalarField())
+	assert.NoError(err)
+	srs, err := kzg_bn254.NewSRS(kzgSize, alpha)
+	assert.NoError(err)
+
+	f := make([]fr_bn254.Element, polynomialSize)
+	for i := range f {
+		f[i].SetRandom()
+	}
+	com, err := kzg_bn254.Commit(f, srs.Pk)
+	assert.NoError(err)
+	var point fr_bn254.Element
+	point.SetRandom()
+	proof, err := kzg_bn254.Open(f, point, srs.Pk)
+	assert.NoError(err)
+
+	wCom, err := ValueOfCommitment[sw_bn254.G1Affine](com)
+	assert.NoError(err)
+	wProof, err := ValueOfOpeningProof[sw_bn254.ScalarField, sw_bn254.G1Affine](proof)
+	assert.NoError(err)
+
+	circuit := PreCheckCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{}
+	assignment := PreCheckCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{
+		Commitment:   wCom,
+		OpeningProof: wProof,
+	}
+	assert.Run(func(assert *test.Assert) {
+		assert.NoError(test.IsSolved(&circuit, &assignment, ecc.BN254.ScalarField()))
+	}, "valid")
+
+	assert.Run(func(assert *test.Assert) {
+		// a commitment off the curve is rejected before any pairing
+		var badCom bn254.G1Affine
+		badCom.Set(&com)
+		var one fp_bn254.Element
+		one.SetOne()
+		badCom.X.Add(&badCom.X, &one)
+		badAssignment := assignment
+		badAssignment.Commitment.G1El = sw_bn254.NewG1Affine(badCom)
+		assert.Error(test.IsSolved(&circuit, &badAssignment, ecc.BN254.ScalarField()))
+	}, "off-curve")
+
+	assert.Run(func(assert *test.Assert) {
+		// an identity quotient is rejected when the option is enabled
+		idCircuit := circuit
+		idCircuit.nonIdentity = true
+		badAssignment := assignment
+		badAssignment.OpeningProof.Quotient = sw_bn254.NewG1Affine(bn254.G1Affine{})
+		assert.Error(test.IsSolved(&idCircuit, &badAssignment, ecc.BN254.ScalarField()))
+	}, "identity-quotient")
+}
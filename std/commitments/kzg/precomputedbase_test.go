@@ -0,0 +1,85 @@
+package kzg
+
+import (
+	"crypto/rand"
+	"fmt"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	fr_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	kzg_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/kzg"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/algebra"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_bn254"
+	"github.com/consensys/gnark/std/math/emulated"
+	"github.com/consensys/gnark/test"
+)
+
+const testNbRepeatedProofs = 2
+
+type PrecomputedBaseCircuit[FR emulated.FieldParams, G1El algebra.G1ElementT, G2El algebra.G2ElementT, GTEl algebra.GtElementT] struct {
+	VerifyingKey[G1El, G2El]
+	Commitments [testNbRepeatedProofs]Commitment[G1El]
+	Proofs      [testNbRepeatedProofs]OpeningProof[FR, G1El]
+	Points      [testNbRepeatedProofs]emulated.Element[FR]
+}
+
+func (c *PrecomputedBaseCircuit[FR, G1El, G2El, GTEl]) Define(api frontend.API) error {
+	// repeated opening checks share the fixed-base window table
+	verifier, err := NewVerifier[FR, G1El, G2El, GTEl](api, WithPrecomputedScalarMulBase[FR, G1El]())
+	if err != nil {
+		return fmt.Errorf("new verifier: %w", err)
+	}
+	for i := range c.Proofs {
+		if err := verifier.CheckOpeningProof(c.Commitments[i], c.Proofs[i], c.Points[i], c.VerifyingKey); err != nil {
+			return fmt.Errorf("check opening proof %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func TestVerifierWithPrecomputedScalarMulBase(t *testing.T) {
+	assert := test.NewAssert(t)
+
+	alpha, err := rand.Int(rand.Reader, ecc.BN254.ScalarField())
+	assert.NoError(err)
+	srs, err := kzg_bn254.NewSRS(kzgSize, alpha)
+	assert.NoError(err)
+
+	assignment := PrecomputedBaseCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{}
+	assignment.VerifyingKey, err = ValueOfVerifyingKey[sw_bn254.G1Affine, sw_bn254.G2Affine](srs.Vk)
+	assert.NoError(err)
+	for i := 0; i < testNbRepeatedProofs; i++ {
+		f := make([]fr_bn254.Element, polynomialSize)
+		for j := range f {
+			f[j].SetRandom()
+		}
+		com, err := kzg_bn254.Commit(f, srs.Pk)
+		assert.NoError(err)
+		var point fr_bn254.Element
+		point.SetRandom()
+		proof, err := kzg_bn254.Open(f, point, srs.Pk)
+		assert.NoError(err)
+		assignment.Commitments[i], err = ValueOfCommitment[sw_bn254.G1Affine](com)
+		assert.NoError(err)
+		assignment.Proofs[i], err = ValueOfOpeningProof[sw_bn254.ScalarField, sw_bn254.G1Affine](proof)
+		assert.NoError(err)
+		assignment.Points[i], err = ValueOfScalar[sw_bn254.ScalarField](point)
+		assert.NoError(err)
+	}
+
+	circuit := PrecomputedBaseCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{}
+
+	assert.Run(func(assert *test.Assert) {
+		assert.NoError(test.IsSolved(&circuit, &assignment, ecc.BN254.ScalarField()))
+	}, "valid")
+
+	assert.Run(func(assert *test.Assert) {
+		// the fixed-base path still rejects a tampered claimed value
+		badAssignment := assignment
+		var otherValue fr_bn254.Element
+		otherValue.SetRandom()
+		badAssignment.Proofs[1].ClaimedValue = emulated.ValueOf[sw_bn254.ScalarField](otherValue)
+		assert.Error(test.IsSolved(&circuit, &badAssignment, ecc.BN254.ScalarField()))
+	}, "tampered")
+}
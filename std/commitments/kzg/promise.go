@@ -0,0 +1,56 @@
+package kzg
+
+import (
+	"fmt"
+
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/algebra"
+	"github.com/consensys/gnark/std/math/bits"
+	"github.com/consensys/gnark/std/math/emulated"
+)
+
+// ClaimedValuePromise defers the binding of an opening's claimed value to a
+// native variable that is only produced later in the circuit, typically the
+// output of a sumcheck or GKR verifier. [Verifier.AssertProofWithPromise]
+// verifies the pairing check immediately and returns the promise; once the
+// deferred value is available, [ClaimedValuePromise.Resolve] constrains the
+// claimed value to equal it. Every promise must be resolved exactly once —
+// an unresolved promise leaves the claimed value unconstrained and the
+// opening proves nothing about the protocol's value.
+type ClaimedValuePromise[FR emulated.FieldParams, G1El algebra.G1ElementT, G2El algebra.G2ElementT, GTEl algebra.GtElementT] struct {
+	v        *Verifier[FR, G1El, G2El, GTEl]
+	claimed  *emulated.Element[FR]
+	resolved bool
+}
+
+// AssertProofWithPromise verifies the opening proof and returns a promise for
+// the claimed value, to be resolved against a later-computed native variable
+// with [ClaimedValuePromise.Resolve]. This composes the opening with
+// sub-protocols whose verified output is only available further down the
+// circuit, such as the sumcheck gadget: the pairing check runs where the
+// proof is at hand and the value binding where the sumcheck resolves.
+func (v *Verifier[FR, G1El, G2El, GTEl]) AssertProofWithPromise(commitment Commitment[G1El], proof OpeningProof[FR, G1El], point emulated.Element[FR], vk VerifyingKey[G1El, G2El]) (*ClaimedValuePromise[FR, G1El, G2El, GTEl], error) {
+	var fr FR
+	if fr.Modulus().BitLen() < v.api.Compiler().FieldBitLen() {
+		return nil, fmt.Errorf("emulated scalar field smaller than native field")
+	}
+	if err := v.CheckOpeningProof(commitment, proof, point, vk); err != nil {
+		return nil, fmt.Errorf("check opening proof: %w", err)
+	}
+	return &ClaimedValuePromise[FR, G1El, G2El, GTEl]{v: v, claimed: &proof.ClaimedValue}, nil
+}
+
+// Resolve constrains the promised claimed value to equal the native value,
+// decomposed to bits and recomposed in the scalar field as in
+// [Verifier.AssertProofWithGKRValue]. It errors when called more than once on
+// the same promise.
+func (p *ClaimedValuePromise[FR, G1El, G2El, GTEl]) Resolve(value frontend.Variable) error {
+	if p.resolved {
+		return fmt.Errorf("promise already resolved")
+	}
+	p.resolved = true
+	bts := bits.ToBinary(p.v.api, value, bits.WithNbDigits(p.v.api.Compiler().FieldBitLen()))
+	resolved := p.v.scalarApi.FromBits(bts...)
+	p.v.scalarApi.AssertIsEqual(resolved, p.claimed)
+	return nil
+}
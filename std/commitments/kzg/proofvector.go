@@ -0,0 +1,66 @@
+package kzg
+
+import (
+	"fmt"
+
+	"github.com/consensys/gnark/std/algebra"
+	"github.com/consensys/gnark/std/math/emulated"
+)
+
+// AssertProofs asserts the validity of a fixed-size vector of opening proofs,
+// the i-th proof opening the i-th commitment at the i-th point. Every proof is
+// verified independently with its own pairing check — the openings are not
+// folded, so no assumption is made about the points or the proofs being
+// related. The method shares the transient multi-scalar multiplication
+// buffers between the iterations instead of re-allocating them per proof,
+// which keeps peak memory flat for large vectors during circuit definition.
+// Errors are annotated with the index of the offending proof.
+//
+// When all the openings are at a single point, or when folding the openings
+// with a random challenge is acceptable, [Verifier.BatchVerifySinglePoint]
+// and [Verifier.BatchVerifyMultiPoints] verify the vector with a single
+// pairing check instead.
+func (v *Verifier[FR, G1El, G2El, GTEl]) AssertProofs(commitments []Commitment[G1El], proofs []OpeningProof[FR, G1El], points []emulated.Element[FR], vk VerifyingKey[G1El, G2El]) error {
+	if len(commitments) != len(proofs) {
+		return fmt.Errorf("length mismatch for commitments and proofs")
+	}
+	if len(proofs) != len(points) {
+		return fmt.Errorf("length mismatch for proofs and points")
+	}
+	if len(proofs) == 0 {
+		return fmt.Errorf("number of proofs should be nonzero")
+	}
+	msmPoints := v.scratch.borrowPoints(0, 2)
+	msmScalars := v.scratch.borrowScalars(0, 2)
+	for i := range proofs {
+		// [f(a)]G1 + [-a]([H(α)]G₁) = [f(a) - a*H(α)]G₁
+		pointNeg := v.scalarApi.Neg(&points[i])
+		var totalG1 *G1El
+		if v.scalarMul != nil {
+			totalG1 = v.curve.Add(v.scalarMul(&vk.G1, &proofs[i].ClaimedValue), v.scalarMul(&proofs[i].Quotient, pointNeg))
+		} else if v.precomputedBase {
+			claimedG1 := v.curve.ScalarMulBase(&proofs[i].ClaimedValue)
+			totalG1 = v.curve.Add(claimedG1, v.curve.ScalarMul(&proofs[i].Quotient, pointNeg))
+		} else {
+			msmPoints[0], msmPoints[1] = &vk.G1, &proofs[i].Quotient
+			msmScalars[0], msmScalars[1] = &proofs[i].ClaimedValue, pointNeg
+			var err error
+			totalG1, err = v.curve.MultiScalarMul(msmPoints, msmScalars)
+			if err != nil {
+				return fmt.Errorf("proof %d: fold G1 part: %w", i, err)
+			}
+		}
+
+		// [f(a) - a*H(α)]G₁ + [-f(α)]G₁  = [f(a) - f(α) - a*H(α)]G₁
+		totalG1 = v.curve.Add(totalG1, v.curve.Neg(&commitments[i].G1El))
+
+		// e([f(a)-f(α)-a*H(α)]G₁], G₂).e([H(α)]G₁, [α]G₂) == 1
+		eq := algebra.NewEquation(v.curve, v.pairing)
+		eq.AddPairing(totalG1, &vk.G2[0])
+		eq.AddPairing(&proofs[i].Quotient, &vk.G2[1])
+		if err := eq.AssertEqualsOne(); err != nil {
+			return fmt.Errorf("proof %d: pairing check: %w", i, err)
+		}
+	}
+	return nil
+}
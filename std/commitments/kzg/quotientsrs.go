@@ -0,0 +1,35 @@
+package kzg
+
+import (
+	"fmt"
+
+	"github.com/consensys/gnark/std/math/emulated"
+)
+
+// AssertProofWithQuotientSRS asserts the validity of an opening proof whose
+// quotient polynomial was committed under a different SRS than the
+// commitment. The commitment leg of the pairing equation is checked against
+// vk and the quotient leg against quotientVk:
+//
+//	e([f(a) - f(α) - a*H(α)]G₁, G₂)·e([H(α)]G₁, [α]G₂') == 1
+//
+// where [α]G₂' comes from the quotient SRS.
+//
+// This is only valid when both SRS were generated from the same toxic point α
+// over the same generators, e.g. when the quotient SRS is a larger extension
+// of the commitment SRS produced by the same ceremony. The SRS sizes do not
+// enter the verification — only the G2 part of each setup does — so a staged
+// protocol may commit the witness polynomial under a small setup and its
+// quotient under a larger one. The method cannot detect setups with different
+// toxic points beyond the opening check failing; it is the caller's
+// responsibility to ensure the setups are consistent.
+func (v *Verifier[FR, G1El, G2El, GTEl]) AssertProofWithQuotientSRS(commitment Commitment[G1El], proof OpeningProof[FR, G1El], point emulated.Element[FR], vk VerifyingKey[G1El, G2El], quotientVk VerifyingKey[G1El, G2El]) error {
+	mixedVk := VerifyingKey[G1El, G2El]{
+		G1: vk.G1,
+		G2: [2]G2El{vk.G2[0], quotientVk.G2[1]},
+	}
+	if err := v.CheckOpeningProof(commitment, proof, point, mixedVk); err != nil {
+		return fmt.Errorf("check opening proof: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,88 @@
+package kzg
+
+import (
+	"crypto/rand"
+	"fmt"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	fr_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	kzg_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/kzg"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/algebra"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_bn254"
+	"github.com/consensys/gnark/std/math/emulated"
+	"github.com/consensys/gnark/test"
+)
+
+type QuotientSRSCircuit[FR emulated.FieldParams, G1El algebra.G1ElementT, G2El algebra.G2ElementT, GTEl algebra.GtElementT] struct {
+	VerifyingKey         VerifyingKey[G1El, G2El]
+	QuotientVerifyingKey VerifyingKey[G1El, G2El]
+	Commitment           Commitment[G1El]
+	Proof                OpeningProof[FR, G1El]
+	Point                emulated.Element[FR]
+}
+
+func (c *QuotientSRSCircuit[FR, G1El, G2El, GTEl]) Define(api frontend.API) error {
+	verifier, err := NewVerifier[FR, G1El, G2El, GTEl](api)
+	if err != nil {
+		return fmt.Errorf("new verifier: %w", err)
+	}
+	return verifier.AssertProofWithQuotientSRS(c.Commitment, c.Proof, c.Point, c.VerifyingKey, c.QuotientVerifyingKey)
+}
+
+func TestAssertProofWithQuotientSRS(t *testing.T) {
+	assert := test.NewAssert(t)
+
+	// the small and the large SRS share the toxic point, as in a staged
+	// protocol where the larger setup extends the smaller one
+	alpha, err := rand.Int(rand.Reader, ecc.BN254.ScalarField())
+	assert.NoError(err)
+	srs, err := kzg_bn254.NewSRS(kzgSize, alpha)
+	assert.NoError(err)
+	quotientSRS, err := kzg_bn254.NewSRS(2*kzgSize, alpha)
+	assert.NoError(err)
+
+	f := make([]fr_bn254.Element, polynomialSize)
+	for i := range f {
+		f[i].SetRandom()
+	}
+	com, err := kzg_bn254.Commit(f, srs.Pk)
+	assert.NoError(err)
+	var point fr_bn254.Element
+	point.SetRandom()
+	// the quotient is committed with the larger proving key
+	proof, err := kzg_bn254.Open(f, point, quotientSRS.Pk)
+	assert.NoError(err)
+
+	assignment := QuotientSRSCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{
+		Point: emulated.ValueOf[sw_bn254.ScalarField](point),
+	}
+	assignment.VerifyingKey, err = ValueOfVerifyingKey[sw_bn254.G1Affine, sw_bn254.G2Affine](srs.Vk)
+	assert.NoError(err)
+	assignment.QuotientVerifyingKey, err = ValueOfVerifyingKey[sw_bn254.G1Affine, sw_bn254.G2Affine](quotientSRS.Vk)
+	assert.NoError(err)
+	assignment.Commitment, err = ValueOfCommitment[sw_bn254.G1Affine](com)
+	assert.NoError(err)
+	assignment.Proof, err = ValueOfOpeningProof[sw_bn254.ScalarField, sw_bn254.G1Affine](proof)
+	assert.NoError(err)
+
+	circuit := QuotientSRSCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{}
+
+	assert.Run(func(assert *test.Assert) {
+		assert.NoError(test.IsSolved(&circuit, &assignment, ecc.BN254.ScalarField()))
+	}, "valid")
+
+	assert.Run(func(assert *test.Assert) {
+		// a quotient SRS with a different toxic point does not satisfy the
+		// pairing equation
+		otherAlpha, err := rand.Int(rand.Reader, ecc.BN254.ScalarField())
+		assert.NoError(err)
+		otherSRS, err := kzg_bn254.NewSRS(2*kzgSize, otherAlpha)
+		assert.NoError(err)
+		badAssignment := assignment
+		badAssignment.QuotientVerifyingKey, err = ValueOfVerifyingKey[sw_bn254.G1Affine, sw_bn254.G2Affine](otherSRS.Vk)
+		assert.NoError(err)
+		assert.Error(test.IsSolved(&circuit, &badAssignment, ecc.BN254.ScalarField()))
+	}, "mismatched toxic point")
+}
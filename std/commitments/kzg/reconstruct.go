@@ -0,0 +1,80 @@
+package kzg
+
+import (
+	"fmt"
+
+	"github.com/consensys/gnark/std/math/emulated"
+)
+
+// AssertReconstructedCommitment verifies an opening of a commitment that is
+// never sent: it is reconstructed as the linear combination, with the given
+// coefficients, of the commitments implied by the openings.
+//
+// An opening (Hᵢ, vᵢ) at aᵢ implies the commitment Cᵢ = [vᵢ]G₁ + (α-aᵢ)·Hᵢ,
+// from f(α) = f(aᵢ) + Hᵢ(α)·(α-aᵢ). The expected opening (Q, u) at p of the
+// combination C = Σᵢ λᵢCᵢ then satisfies C - [u]G₁ = (α-p)·Q, which rearranges
+// into the pairing check
+//
+//	e(Σᵢλᵢ([vᵢ]G₁ - aᵢHᵢ) - [u]G₁ + p·Q, G₂) · e(ΣᵢλᵢHᵢ - Q, [α]G₂) == 1
+//
+// whose inputs are all computable in G₁. Soundness reduces to the binding of
+// the SRS: the check holds only if the identity holds at the toxic point α,
+// which for adversarially chosen openings happens with negligible
+// probability. The coefficients λᵢ must be derived from a transcript binding
+// all the openings.
+func (v *Verifier[FR, G1El, G2El, GTEl]) AssertReconstructedCommitment(openings []OpeningProof[FR, G1El], points []emulated.Element[FR], reconstructionCoeffs []emulated.Element[FR], expectedOpening OpeningProof[FR, G1El], expectedPoint emulated.Element[FR], vk VerifyingKey[G1El, G2El]) error {
+	if len(openings) != len(points) {
+		return fmt.Errorf("number of openings doesn't match number of points")
+	}
+	if len(openings) != len(reconstructionCoeffs) {
+		return fmt.Errorf("number of openings doesn't match number of coefficients")
+	}
+	if len(openings) == 0 {
+		return fmt.Errorf("number of openings should be nonzero")
+	}
+	n := len(openings)
+
+	// Σᵢλᵢvᵢ - u
+	foldedEvals := v.scalarApi.Neg(&expectedOpening.ClaimedValue)
+	for i := range openings {
+		tmp := v.scalarApi.Mul(&reconstructionCoeffs[i], &openings[i].ClaimedValue)
+		foldedEvals = v.scalarApi.Add(foldedEvals, tmp)
+	}
+
+	// Σᵢ(-λᵢaᵢ)·Hᵢ + [Σᵢλᵢvᵢ - u]G₁ + p·Q
+	lhsPoints := make([]*G1El, n+2)
+	lhsScalars := make([]*emulated.Element[FR], n+2)
+	for i := range openings {
+		lhsPoints[i] = &openings[i].Quotient
+		lhsScalars[i] = v.scalarApi.Neg(v.scalarApi.Mul(&reconstructionCoeffs[i], &points[i]))
+	}
+	lhsPoints[n] = &vk.G1
+	lhsScalars[n] = foldedEvals
+	lhsPoints[n+1] = &expectedOpening.Quotient
+	lhsScalars[n+1] = &expectedPoint
+	lhs, err := v.curve.MultiScalarMul(lhsPoints, lhsScalars)
+	if err != nil {
+		return fmt.Errorf("fold implied commitments: %w", err)
+	}
+
+	// ΣᵢλᵢHᵢ - Q
+	quotients := make([]*G1El, n)
+	quotientCoeffs := make([]*emulated.Element[FR], n)
+	for i := range openings {
+		quotients[i] = &openings[i].Quotient
+		quotientCoeffs[i] = &reconstructionCoeffs[i]
+	}
+	foldedQuotients, err := v.curve.MultiScalarMul(quotients, quotientCoeffs)
+	if err != nil {
+		return fmt.Errorf("fold quotients: %w", err)
+	}
+	foldedQuotients = v.curve.Add(foldedQuotients, v.curve.Neg(&expectedOpening.Quotient))
+
+	if err := v.pairing.PairingCheck(
+		[]*G1El{lhs, foldedQuotients},
+		[]*G2El{&vk.G2[0], &vk.G2[1]},
+	); err != nil {
+		return fmt.Errorf("pairing check: %w", err)
+	}
+	return nil
+}
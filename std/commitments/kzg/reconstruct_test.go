@@ -0,0 +1,102 @@
+package kzg
+
+import (
+	"crypto/rand"
+	"fmt"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	fr_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	kzg_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/kzg"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/algebra"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_bn254"
+	"github.com/consensys/gnark/std/math/emulated"
+	"github.com/consensys/gnark/test"
+)
+
+type ReconstructedCommitmentCircuit[FR emulated.FieldParams, G1El algebra.G1ElementT, G2El algebra.G2ElementT, GTEl algebra.GtElementT] struct {
+	Vk              VerifyingKey[G1El, G2El]
+	Openings        [2]OpeningProof[FR, G1El]
+	Points          [2]emulated.Element[FR]
+	Coeffs          [2]emulated.Element[FR]
+	ExpectedOpening OpeningProof[FR, G1El]
+	ExpectedPoint   emulated.Element[FR]
+}
+
+func (c *ReconstructedCommitmentCircuit[FR, G1El, G2El, GTEl]) Define(api frontend.API) error {
+	verifier, err := NewVerifier[FR, G1El, G2El, GTEl](api)
+	if err != nil {
+		return fmt.Errorf("new verifier: %w", err)
+	}
+	if err := verifier.AssertReconstructedCommitment(c.Openings[:], c.Points[:], c.Coeffs[:], c.ExpectedOpening, c.ExpectedPoint, c.Vk); err != nil {
+		return fmt.Errorf("assert reconstructed commitment: %w", err)
+	}
+	return nil
+}
+
+func TestReconstructedCommitment(t *testing.T) {
+	assert := test.NewAssert(t)
+
+	alpha, err := rand.Int(rand.Reader, ecc.BN254.ScalarField())
+	assert.NoError(err)
+	srs, err := kzg_bn254.NewSRS(kzgSize, alpha)
+	assert.NoError(err)
+
+	// two polynomials opened at distinct points and their combination
+	var fs [2][]fr_bn254.Element
+	var lambdas [2]fr_bn254.Element
+	combined := make([]fr_bn254.Element, polynomialSize)
+	for i := range fs {
+		fs[i] = make([]fr_bn254.Element, polynomialSize)
+		for j := range fs[i] {
+			fs[i][j].SetRandom()
+		}
+		lambdas[i].SetRandom()
+		var tmp fr_bn254.Element
+		for j := range combined {
+			tmp.Mul(&lambdas[i], &fs[i][j])
+			combined[j].Add(&combined[j], &tmp)
+		}
+	}
+
+	var points [2]fr_bn254.Element
+	var proofs [2]kzg_bn254.OpeningProof
+	for i := range fs {
+		points[i].SetRandom()
+		proofs[i], err = kzg_bn254.Open(fs[i], points[i], srs.Pk)
+		assert.NoError(err)
+	}
+	var expectedPoint fr_bn254.Element
+	expectedPoint.SetRandom()
+	expectedProof, err := kzg_bn254.Open(combined, expectedPoint, srs.Pk)
+	assert.NoError(err)
+
+	wVk, err := ValueOfVerifyingKey[sw_bn254.G1Affine, sw_bn254.G2Affine](srs.Vk)
+	assert.NoError(err)
+	assignment := ReconstructedCommitmentCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{Vk: wVk}
+	for i := range fs {
+		assignment.Openings[i], err = ValueOfOpeningProof[sw_bn254.ScalarField, sw_bn254.G1Affine](proofs[i])
+		assert.NoError(err)
+		assignment.Points[i], err = ValueOfScalar[sw_bn254.ScalarField](points[i])
+		assert.NoError(err)
+		assignment.Coeffs[i], err = ValueOfScalar[sw_bn254.ScalarField](lambdas[i])
+		assert.NoError(err)
+	}
+	assignment.ExpectedOpening, err = ValueOfOpeningProof[sw_bn254.ScalarField, sw_bn254.G1Affine](expectedProof)
+	assert.NoError(err)
+	assignment.ExpectedPoint, err = ValueOfScalar[sw_bn254.ScalarField](expectedPoint)
+	assert.NoError(err)
+
+	circuit := ReconstructedCommitmentCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{}
+	assert.NoError(test.IsSolved(&circuit, &assignment, ecc.BN254.ScalarField()))
+
+	// a tampered claimed value breaks the identity
+	badAssignment := assignment
+	var bad fr_bn254.Element
+	bad.SetOne()
+	bad.Add(&bad, &proofs[0].ClaimedValue)
+	badAssignment.Openings[0].ClaimedValue, err = ValueOfScalar[sw_bn254.ScalarField](bad)
+	assert.NoError(err)
+	assert.Error(test.IsSolved(&circuit, &badAssignment, ecc.BN254.ScalarField()))
+}
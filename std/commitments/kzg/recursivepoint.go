@@ -0,0 +1,25 @@
+package kzg
+
+import (
+	"fmt"
+
+	"github.com/consensys/gnark/std/math/emulated"
+)
+
+// AssertRecursivePointOpening verifies a two-level self-describing opening:
+// the points polynomial is opened at point to reveal the next opening point,
+// and the values polynomial is opened at that revealed point. The binding is
+// by construction rather than by constraint — the values opening is verified
+// directly at pointsProof.ClaimedValue, so there is no separately supplied
+// values point that could diverge from the opened one. Chaining calls, with
+// each level's claimed value feeding the next level's point, verifies an
+// arbitrarily deep recursive opening sequence.
+func (v *Verifier[FR, G1El, G2El, GTEl]) AssertRecursivePointOpening(pointsCommitment, valuesCommitment Commitment[G1El], pointsProof, valuesProof OpeningProof[FR, G1El], point emulated.Element[FR], vk VerifyingKey[G1El, G2El]) error {
+	if err := v.CheckOpeningProof(pointsCommitment, pointsProof, point, vk); err != nil {
+		return fmt.Errorf("points opening: %w", err)
+	}
+	if err := v.CheckOpeningProof(valuesCommitment, valuesProof, pointsProof.ClaimedValue, vk); err != nil {
+		return fmt.Errorf("values opening: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,94 @@
+package kzg
+
+import (
+	"crypto/rand"
+	"fmt"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	fr_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	kzg_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/kzg"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/algebra"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_bn254"
+	"github.com/consensys/gnark/std/math/emulated"
+	"github.com/consensys/gnark/test"
+)
+
+type RecursivePointCircuit[FR emulated.FieldParams, G1El algebra.G1ElementT, G2El algebra.G2ElementT, GTEl algebra.GtElementT] struct {
+	VerifyingKey[G1El, G2El]
+	ComPoints   Commitment[G1El]
+	ComValues   Commitment[G1El]
+	ProofPoints OpeningProof[FR, G1El]
+	ProofValues OpeningProof[FR, G1El]
+	Point       emulated.Element[FR]
+}
+
+func (c *RecursivePointCircuit[FR, G1El, G2El, GTEl]) Define(api frontend.API) error {
+	verifier, err := NewVerifier[FR, G1El, G2El, GTEl](api)
+	if err != nil {
+		return fmt.Errorf("new verifier: %w", err)
+	}
+	return verifier.AssertRecursivePointOpening(c.ComPoints, c.ComValues, c.ProofPoints, c.ProofValues, c.Point, c.VerifyingKey)
+}
+
+func TestAssertRecursivePointOpening(t *testing.T) {
+	assert := test.NewAssert(t)
+
+	alpha, err := rand.Int(rand.Reader, ecc.BN254.ScalarField())
+	assert.NoError(err)
+	srs, err := kzg_bn254.NewSRS(kzgSize, alpha)
+	assert.NoError(err)
+
+	fPoints := make([]fr_bn254.Element, polynomialSize)
+	fValues := make([]fr_bn254.Element, polynomialSize)
+	for i := range fPoints {
+		fPoints[i].SetRandom()
+		fValues[i].SetRandom()
+	}
+	comPoints, err := kzg_bn254.Commit(fPoints, srs.Pk)
+	assert.NoError(err)
+	comValues, err := kzg_bn254.Commit(fValues, srs.Pk)
+	assert.NoError(err)
+
+	// the points polynomial opened at the outer point reveals the inner point
+	var point fr_bn254.Element
+	point.SetRandom()
+	proofPoints, err := kzg_bn254.Open(fPoints, point, srs.Pk)
+	assert.NoError(err)
+	proofValues, err := kzg_bn254.Open(fValues, proofPoints.ClaimedValue, srs.Pk)
+	assert.NoError(err)
+
+	assignment := RecursivePointCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{
+		Point: emulated.ValueOf[sw_bn254.ScalarField](point),
+	}
+	assignment.VerifyingKey, err = ValueOfVerifyingKey[sw_bn254.G1Affine, sw_bn254.G2Affine](srs.Vk)
+	assert.NoError(err)
+	assignment.ComPoints, err = ValueOfCommitment[sw_bn254.G1Affine](comPoints)
+	assert.NoError(err)
+	assignment.ComValues, err = ValueOfCommitment[sw_bn254.G1Affine](comValues)
+	assert.NoError(err)
+	assignment.ProofPoints, err = ValueOfOpeningProof[sw_bn254.ScalarField, sw_bn254.G1Affine](proofPoints)
+	assert.NoError(err)
+	assignment.ProofValues, err = ValueOfOpeningProof[sw_bn254.ScalarField, sw_bn254.G1Affine](proofValues)
+	assert.NoError(err)
+
+	circuit := RecursivePointCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{}
+
+	assert.Run(func(assert *test.Assert) {
+		assert.NoError(test.IsSolved(&circuit, &assignment, ecc.BN254.ScalarField()))
+	}, "valid")
+
+	assert.Run(func(assert *test.Assert) {
+		// a values opening at a point other than the one revealed by the
+		// points opening is rejected
+		var otherPoint fr_bn254.Element
+		otherPoint.SetRandom()
+		otherProof, err := kzg_bn254.Open(fValues, otherPoint, srs.Pk)
+		assert.NoError(err)
+		badAssignment := assignment
+		badAssignment.ProofValues, err = ValueOfOpeningProof[sw_bn254.ScalarField, sw_bn254.G1Affine](otherProof)
+		assert.NoError(err)
+		assert.Error(test.IsSolved(&circuit, &badAssignment, ecc.BN254.ScalarField()))
+	}, "unbound values point")
+}
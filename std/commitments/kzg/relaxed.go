@@ -0,0 +1,57 @@
+package kzg
+
+import (
+	"fmt"
+
+	"github.com/consensys/gnark/std/math/emulated"
+)
+
+type relaxedConfig struct {
+	nonCanonicalScalars bool
+}
+
+// RelaxedOption configures how [Verifier.CheckOpeningProofRelaxed] treats its
+// scalar inputs.
+type RelaxedOption func(*relaxedConfig) error
+
+// WithNonCanonicalScalars accepts claimed values and points that are not
+// reduced modulo the scalar field, as emitted by provers reducing modulo a
+// larger modulus, and reduces them in-circuit before use. Without this option
+// the scalars are asserted canonical and non-reduced inputs are rejected.
+//
+// Opt in deliberately: with relaxation, distinct witness encodings of the same
+// scalar verify against the same proof, so any outer protocol hashing or
+// comparing scalar encodings (rather than values) must use the reduced form.
+func WithNonCanonicalScalars() RelaxedOption {
+	return func(cfg *relaxedConfig) error {
+		cfg.nonCanonicalScalars = true
+		return nil
+	}
+}
+
+// CheckOpeningProofRelaxed asserts the validity of the opening proof for the
+// given commitment at point, with configurable scalar canonicity. By default
+// the claimed value and the point are asserted to be strictly reduced; with
+// [WithNonCanonicalScalars] they are instead reduced in-circuit, matching
+// provers that emit scalars modulo a larger modulus.
+func (v *Verifier[FR, G1El, G2El, GTEl]) CheckOpeningProofRelaxed(commitment Commitment[G1El], proof OpeningProof[FR, G1El], point emulated.Element[FR], vk VerifyingKey[G1El, G2El], opts ...RelaxedOption) error {
+	cfg := relaxedConfig{}
+	for _, opt := range opts {
+		if err := opt(&cfg); err != nil {
+			return fmt.Errorf("apply option: %w", err)
+		}
+	}
+	if cfg.nonCanonicalScalars {
+		// write the reduced elements into fresh values: the deferred
+		// multiplication checks reference the inputs by pointer
+		reducedProof := OpeningProof[FR, G1El]{
+			Quotient:     proof.Quotient,
+			ClaimedValue: *v.scalarApi.ReduceStrict(&proof.ClaimedValue),
+		}
+		reducedPoint := *v.scalarApi.ReduceStrict(&point)
+		return v.CheckOpeningProof(commitment, reducedProof, reducedPoint, vk)
+	}
+	v.scalarApi.AssertIsInRange(&proof.ClaimedValue)
+	v.scalarApi.AssertIsInRange(&point)
+	return v.CheckOpeningProof(commitment, proof, point, vk)
+}
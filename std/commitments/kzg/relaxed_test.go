@@ -0,0 +1,108 @@
+package kzg
+
+import (
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	fr_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	kzg_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/kzg"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/algebra"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_bn254"
+	"github.com/consensys/gnark/std/math/emulated"
+	"github.com/consensys/gnark/test"
+)
+
+type RelaxedScalarCircuit[FR emulated.FieldParams, G1El algebra.G1ElementT, G2El algebra.G2ElementT, GTEl algebra.GtElementT] struct {
+	VerifyingKey[G1El, G2El]
+	Commitment[G1El]
+	OpeningProof[FR, G1El]
+	Point emulated.Element[FR]
+
+	nonCanonical bool
+}
+
+func (c *RelaxedScalarCircuit[FR, G1El, G2El, GTEl]) Define(api frontend.API) error {
+	verifier, err := NewVerifier[FR, G1El, G2El, GTEl](api)
+	if err != nil {
+		return fmt.Errorf("new verifier: %w", err)
+	}
+	var opts []RelaxedOption
+	if c.nonCanonical {
+		opts = append(opts, WithNonCanonicalScalars())
+	}
+	return verifier.CheckOpeningProofRelaxed(c.Commitment, c.OpeningProof, c.Point, c.VerifyingKey, opts...)
+}
+
+// nonCanonicalScalar assigns v + r limb-wise, as a prover reducing modulo a
+// 256-bit modulus would emit it.
+func nonCanonicalScalar(v fr_bn254.Element) emulated.Element[sw_bn254.ScalarField] {
+	var fr sw_bn254.ScalarField
+	val := new(big.Int).Add(v.BigInt(new(big.Int)), fr.Modulus())
+	nbLimbs := int(fr.NbLimbs())
+	mask := new(big.Int).Lsh(big.NewInt(1), fr.BitsPerLimb())
+	mask.Sub(mask, big.NewInt(1))
+	limbs := make([]frontend.Variable, nbLimbs)
+	for i := range limbs {
+		limbs[i] = new(big.Int).And(val, mask)
+		val.Rsh(val, fr.BitsPerLimb())
+	}
+	return emulated.Element[sw_bn254.ScalarField]{Limbs: limbs}
+}
+
+func TestCheckOpeningProofRelaxed(t *testing.T) {
+	assert := test.NewAssert(t)
+
+	alpha, err := rand.Int(rand.Reader, ecc.BN254.ScalarField())
+	assert.NoError(err)
+	srs, err := kzg_bn254.NewSRS(kzgSize, alpha)
+	assert.NoError(err)
+	f := make([]fr_bn254.Element, polynomialSize)
+	for i := range f {
+		f[i].SetRandom()
+	}
+	com, err := kzg_bn254.Commit(f, srs.Pk)
+	assert.NoError(err)
+	var point fr_bn254.Element
+	point.SetRandom()
+	proof, err := kzg_bn254.Open(f, point, srs.Pk)
+	assert.NoError(err)
+
+	wVk, err := ValueOfVerifyingKey[sw_bn254.G1Affine, sw_bn254.G2Affine](srs.Vk)
+	assert.NoError(err)
+	wCom, err := ValueOfCommitment[sw_bn254.G1Affine](com)
+	assert.NoError(err)
+	wProof, err := ValueOfOpeningProof[sw_bn254.ScalarField, sw_bn254.G1Affine](proof)
+	assert.NoError(err)
+	wPoint, err := ValueOfScalar[sw_bn254.ScalarField](point)
+	assert.NoError(err)
+
+	canonical := RelaxedScalarCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{
+		VerifyingKey: wVk,
+		Commitment:   wCom,
+		OpeningProof: wProof,
+		Point:        wPoint,
+	}
+
+	assert.Run(func(assert *test.Assert) {
+		circuit := RelaxedScalarCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{}
+		assert.NoError(test.IsSolved(&circuit, &canonical, ecc.BN254.ScalarField()))
+	}, "canonical")
+
+	relaxed := canonical
+	relaxed.OpeningProof.ClaimedValue = nonCanonicalScalar(proof.ClaimedValue)
+	relaxed.Point = nonCanonicalScalar(point)
+
+	assert.Run(func(assert *test.Assert) {
+		circuit := RelaxedScalarCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{nonCanonical: true}
+		assert.NoError(test.IsSolved(&circuit, &relaxed, ecc.BN254.ScalarField()))
+	}, "non-canonical accepted with option")
+
+	assert.Run(func(assert *test.Assert) {
+		circuit := RelaxedScalarCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{}
+		assert.Error(test.IsSolved(&circuit, &relaxed, ecc.BN254.ScalarField()))
+	}, "non-canonical rejected by default")
+}
@@ -0,0 +1,30 @@
+package kzg
+
+import (
+	"fmt"
+
+	"github.com/consensys/gnark/std/math/emulated"
+)
+
+// AssertProofSafe verifies the opening proof like
+// [Verifier.CheckOpeningProof], additionally asserting membership of the
+// commitment and the quotient in the prime-order G1 subgroup. Use it when
+// the points come from an untrusted serialization layer: a point in a small
+// cofactor subgroup or off the curve entirely would otherwise enter the
+// pairing unchecked. On curves whose G1 has prime order, such as BN254 and
+// the native BLS12-377 parametrization, the membership check reduces to the
+// curve equation.
+//
+// The membership checks cost on the order of an additional scalar
+// multiplication per point on curves with a cofactor, so callers who obtain
+// their points from a trusted constructor should keep using
+// [Verifier.CheckOpeningProof]. For many proofs from the same layer the
+// amortized [Verifier.BatchVerifyMultiPointsWithSubgroupCheck] is cheaper.
+func (v *Verifier[FR, G1El, G2El, GTEl]) AssertProofSafe(commitment Commitment[G1El], proof OpeningProof[FR, G1El], point emulated.Element[FR], vk VerifyingKey[G1El, G2El]) error {
+	v.pairing.AssertIsOnG1(&commitment.G1El)
+	v.pairing.AssertIsOnG1(&proof.Quotient)
+	if err := v.CheckOpeningProof(commitment, proof, point, vk); err != nil {
+		return fmt.Errorf("check opening proof: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,77 @@
+package kzg
+
+import (
+	"fmt"
+
+	"github.com/consensys/gnark/std/algebra"
+	fiatshamir "github.com/consensys/gnark/std/fiat-shamir"
+	"github.com/consensys/gnark/std/math/emulated"
+)
+
+// ScalarMulFunc computes [s]p for the custom scalar multiplication hook of
+// [WithScalarMul]. An implementation must constrain the result to be exactly
+// [s]p for every scalar in [0, r) — the verifier feeds it arbitrary reduced
+// scalars, including claimed values and negated evaluation points, so a
+// gadget that is only complete for a restricted scalar distribution breaks
+// soundness or completeness of the opening check. The input point is a
+// trusted verifier input and may be assumed on the curve.
+type ScalarMulFunc[FR emulated.FieldParams, G1El algebra.G1ElementT] func(p *G1El, s *emulated.Element[FR]) *G1El
+
+// VerifierOption configures the [Verifier] at construction time.
+type VerifierOption[FR emulated.FieldParams, G1El algebra.G1ElementT] func(*verifierConfig[FR, G1El]) error
+
+type verifierConfig[FR emulated.FieldParams, G1El algebra.G1ElementT] struct {
+	scalarMul         ScalarMulFunc[FR, G1El]
+	precomputedBase   bool
+	sharedTranscript  *fiatshamir.Transcript
+	sharedChallengeID string
+	cofactorClear     bool
+}
+
+func newVerifierConfig[FR emulated.FieldParams, G1El algebra.G1ElementT](opts ...VerifierOption[FR, G1El]) (*verifierConfig[FR, G1El], error) {
+	cfg := &verifierConfig[FR, G1El]{}
+	for _, opt := range opts {
+		if err := opt(cfg); err != nil {
+			return nil, err
+		}
+	}
+	if cfg.scalarMul != nil && cfg.precomputedBase {
+		return nil, fmt.Errorf("custom scalar multiplication and precomputed base are mutually exclusive")
+	}
+	return cfg, nil
+}
+
+// WithScalarMul makes the verifier compute the [f(a)]G₁ and [-a]H terms of
+// the opening check with the given scalar multiplication instead of the
+// default multi-scalar multiplication. This is an extensibility hook for
+// performance experimentation with hand-optimized gadgets; see
+// [ScalarMulFunc] for the contract the implementation must satisfy.
+func WithScalarMul[FR emulated.FieldParams, G1El algebra.G1ElementT](f ScalarMulFunc[FR, G1El]) VerifierOption[FR, G1El] {
+	return func(cfg *verifierConfig[FR, G1El]) error {
+		if f == nil {
+			return fmt.Errorf("nil scalar multiplication")
+		}
+		cfg.scalarMul = f
+		return nil
+	}
+}
+
+// WithPrecomputedScalarMulBase makes the verifier compute the [f(a)]G₁ term
+// of the opening check with the fixed-base multiplication
+// [algebra.Curve.ScalarMulBase] instead of folding it into the generic
+// multi-scalar multiplication. The fixed-base gadget is driven by a
+// precomputed window table of generator multiples which consists of circuit
+// constants, so repeated opening checks in the same circuit share a single
+// table instead of re-deriving the multiplication structure per call. The
+// computed value is identical to the default; only the constraint structure
+// changes.
+//
+// The option assumes vk.G1 is the canonical generator of the curve, which
+// holds for SRS generated by gnark-crypto. It is mutually exclusive with
+// [WithScalarMul], which takes over both scalar multiplications of the check.
+func WithPrecomputedScalarMulBase[FR emulated.FieldParams, G1El algebra.G1ElementT]() VerifierOption[FR, G1El] {
+	return func(cfg *verifierConfig[FR, G1El]) error {
+		cfg.precomputedBase = true
+		return nil
+	}
+}
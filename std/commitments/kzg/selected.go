@@ -0,0 +1,40 @@
+package kzg
+
+import (
+	"fmt"
+
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/math/emulated"
+)
+
+// AssertSelectedOpening verifies the opening proof at the index-th position
+// of the committed set without revealing which one: the commitment and proof
+// are picked with an N-way multiplexer, so the constraint system is identical
+// for every index. The index is range-checked against the set size. This is
+// the verification half of an oblivious lookup into a set of
+// (commitment, proof) pairs.
+func (v *Verifier[FR, G1El, G2El, GTEl]) AssertSelectedOpening(commitments []Commitment[G1El], proofs []OpeningProof[FR, G1El], index frontend.Variable, point emulated.Element[FR], vk VerifyingKey[G1El, G2El]) error {
+	if len(commitments) != len(proofs) {
+		return fmt.Errorf("number of commitments doesn't match number of proofs")
+	}
+	if len(commitments) == 0 {
+		return fmt.Errorf("number of commitments should be nonzero")
+	}
+	n := len(commitments)
+	v.api.AssertIsLessOrEqual(index, n-1)
+
+	comEls := make([]*G1El, n)
+	quotients := make([]*G1El, n)
+	values := make([]*emulated.Element[FR], n)
+	for i := range commitments {
+		comEls[i] = &commitments[i].G1El
+		quotients[i] = &proofs[i].Quotient
+		values[i] = &proofs[i].ClaimedValue
+	}
+	selected := Commitment[G1El]{G1El: *v.curve.Mux(index, comEls...)}
+	selectedProof := OpeningProof[FR, G1El]{
+		Quotient:     *v.curve.Mux(index, quotients...),
+		ClaimedValue: *v.scalarApi.Mux(index, values...),
+	}
+	return v.CheckOpeningProof(selected, selectedProof, point, vk)
+}
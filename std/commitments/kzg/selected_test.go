@@ -0,0 +1,90 @@
+package kzg
+
+import (
+	"crypto/rand"
+	"fmt"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	fr_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	kzg_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/kzg"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/algebra"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_bn254"
+	"github.com/consensys/gnark/std/math/emulated"
+	"github.com/consensys/gnark/test"
+)
+
+type SelectedOpeningCircuit[FR emulated.FieldParams, G1El algebra.G1ElementT, G2El algebra.G2ElementT, GTEl algebra.GtElementT] struct {
+	VerifyingKey[G1El, G2El]
+	Commitments [4]Commitment[G1El]
+	Proofs      [4]OpeningProof[FR, G1El]
+	Index       frontend.Variable
+	Point       emulated.Element[FR]
+}
+
+func (c *SelectedOpeningCircuit[FR, G1El, G2El, GTEl]) Define(api frontend.API) error {
+	verifier, err := NewVerifier[FR, G1El, G2El, GTEl](api)
+	if err != nil {
+		return fmt.Errorf("new verifier: %w", err)
+	}
+	if err := verifier.AssertSelectedOpening(c.Commitments[:], c.Proofs[:], c.Index, c.Point, c.VerifyingKey); err != nil {
+		return fmt.Errorf("assert selected opening: %w", err)
+	}
+	return nil
+}
+
+func TestAssertSelectedOpening(t *testing.T) {
+	assert := test.NewAssert(t)
+	const nbPairs = 4
+	const hiddenIndex = 2
+
+	alpha, err := rand.Int(rand.Reader, ecc.BN254.ScalarField())
+	assert.NoError(err)
+	srs, err := kzg_bn254.NewSRS(kzgSize, alpha)
+	assert.NoError(err)
+
+	// all the openings are at the same point, but only the hidden index has a
+	// valid proof for it: the others are opened at a different point
+	var point, otherPoint fr_bn254.Element
+	point.SetRandom()
+	otherPoint.SetRandom()
+
+	circuit := SelectedOpeningCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{}
+	assignment := SelectedOpeningCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{
+		Index: hiddenIndex,
+	}
+	assignment.VerifyingKey, err = ValueOfVerifyingKey[sw_bn254.G1Affine, sw_bn254.G2Affine](srs.Vk)
+	assert.NoError(err)
+	assignment.Point, err = ValueOfScalar[sw_bn254.ScalarField](point)
+	assert.NoError(err)
+	for i := 0; i < nbPairs; i++ {
+		f := make([]fr_bn254.Element, polynomialSize)
+		for j := range f {
+			f[j].SetRandom()
+		}
+		com, err := kzg_bn254.Commit(f, srs.Pk)
+		assert.NoError(err)
+		openAt := otherPoint
+		if i == hiddenIndex {
+			openAt = point
+		}
+		proof, err := kzg_bn254.Open(f, openAt, srs.Pk)
+		assert.NoError(err)
+		assignment.Commitments[i], err = ValueOfCommitment[sw_bn254.G1Affine](com)
+		assert.NoError(err)
+		assignment.Proofs[i], err = ValueOfOpeningProof[sw_bn254.ScalarField, sw_bn254.G1Affine](proof)
+		assert.NoError(err)
+	}
+	assert.NoError(test.IsSolved(&circuit, &assignment, ecc.BN254.ScalarField()))
+
+	// selecting an index whose proof is for the other point fails
+	badAssignment := assignment
+	badAssignment.Index = 1
+	assert.Error(test.IsSolved(&circuit, &badAssignment, ecc.BN254.ScalarField()))
+
+	// out of range index is rejected
+	oobAssignment := assignment
+	oobAssignment.Index = nbPairs
+	assert.Error(test.IsSolved(&circuit, &oobAssignment, ecc.BN254.ScalarField()))
+}
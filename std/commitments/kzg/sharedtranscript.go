@@ -0,0 +1,82 @@
+package kzg
+
+import (
+	"fmt"
+
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/algebra"
+	fiatshamir "github.com/consensys/gnark/std/fiat-shamir"
+	"github.com/consensys/gnark/std/math/emulated"
+	"github.com/consensys/gnark/std/recursion"
+)
+
+// SharedTranscript is a Fiat-Shamir transcript state shared between several
+// [Verifier] instances, binding the sub-protocols they verify together: every
+// challenge absorbs the previous computed challenge, so a verifier's
+// challenge commits to everything the earlier verifiers absorbed. Construct
+// it with [NewSharedTranscript], declaring one challenge identifier per
+// verifier, and pass it to each verifier with [WithSharedTranscript].
+//
+// The ordering contract follows the underlying [fiatshamir.Transcript]:
+// challenges must be computed in the order their identifiers were declared,
+// and a challenge can no longer absorb values once a later verifier has
+// computed its challenge. In practice this means the verifiers must perform
+// their transcript-driven checks in declaration order within the circuit.
+type SharedTranscript[FR emulated.FieldParams] struct {
+	fs *fiatshamir.Transcript
+}
+
+// NewSharedTranscript returns a transcript over the wrapped recursion hash
+// (see [recursion.NewHash]) with the given challenge identifiers, to be
+// shared between several verifiers. The challenges can be reproduced natively
+// with a gnark-crypto Fiat-Shamir transcript over the hash returned by
+// [recursion.NewShort], binding the gnark-crypto serialization of the same
+// elements in the same order.
+func NewSharedTranscript[FR emulated.FieldParams](api frontend.API, challengeIDs []string) (*SharedTranscript[FR], error) {
+	var fr FR
+	fs, err := recursion.NewTranscript(api, fr.Modulus(), challengeIDs)
+	if err != nil {
+		return nil, fmt.Errorf("new transcript: %w", err)
+	}
+	return &SharedTranscript[FR]{fs: fs}, nil
+}
+
+// WithSharedTranscript makes the verifier derive its batching challenge with
+// the given identifier from a transcript shared with other verifiers instead
+// of an internal one. The identifier must be one of those the transcript was
+// constructed with and must not be claimed by another verifier. See
+// [SharedTranscript] for the ordering contract.
+func WithSharedTranscript[FR emulated.FieldParams, G1El algebra.G1ElementT](transcript *SharedTranscript[FR], challengeID string) VerifierOption[FR, G1El] {
+	return func(cfg *verifierConfig[FR, G1El]) error {
+		if transcript == nil {
+			return fmt.Errorf("nil shared transcript")
+		}
+		if challengeID == "" {
+			return fmt.Errorf("empty challenge identifier")
+		}
+		cfg.sharedTranscript = transcript.fs
+		cfg.sharedChallengeID = challengeID
+		return nil
+	}
+}
+
+// SharedTranscript returns the verifier's view of the shared transcript as a
+// [Transcript] bound to the verifier's challenge identifier. It errors when
+// the verifier was constructed without [WithSharedTranscript].
+func (v *Verifier[FR, G1El, G2El, GTEl]) SharedTranscript() (Transcript[FR, G1El], error) {
+	if v.sharedFS == nil {
+		return nil, fmt.Errorf("verifier constructed without shared transcript")
+	}
+	return &hashTranscript[FR, G1El, G2El, GTEl]{v: v, fs: v.sharedFS, challengeID: v.sharedChallengeID}, nil
+}
+
+// BatchVerifySinglePointSharedTranscript is as
+// [Verifier.BatchVerifySinglePointWithTranscript], deriving the folding
+// challenge from the shared transcript the verifier was constructed with.
+func (v *Verifier[FR, G1El, G2El, GTEl]) BatchVerifySinglePointSharedTranscript(digests []Commitment[G1El], batchOpeningProof BatchOpeningProof[FR, G1El], point emulated.Element[FR], vk VerifyingKey[G1El, G2El]) error {
+	transcript, err := v.SharedTranscript()
+	if err != nil {
+		return fmt.Errorf("shared transcript: %w", err)
+	}
+	return v.BatchVerifySinglePointWithTranscript(digests, batchOpeningProof, point, vk, transcript)
+}
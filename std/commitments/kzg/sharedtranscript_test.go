@@ -0,0 +1,172 @@
+package kzg
+
+import (
+	"crypto/rand"
+	"fmt"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	fr_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	kzg_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/kzg"
+	cryptofs "github.com/consensys/gnark-crypto/fiat-shamir"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/algebra"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_bn254"
+	"github.com/consensys/gnark/std/math/emulated"
+	"github.com/consensys/gnark/std/recursion"
+	"github.com/consensys/gnark/test"
+)
+
+const testNbSharedBatch = 2
+
+type SharedTranscriptCircuit[FR emulated.FieldParams, G1El algebra.G1ElementT, G2El algebra.G2ElementT, GTEl algebra.GtElementT] struct {
+	VerifyingKey[G1El, G2El]
+	DigestsA    [testNbSharedBatch]Commitment[G1El]
+	BatchProofA BatchOpeningProof[FR, G1El]
+	PointA      emulated.Element[FR]
+	DigestsB    [testNbSharedBatch]Commitment[G1El]
+	BatchProofB BatchOpeningProof[FR, G1El]
+	PointB      emulated.Element[FR]
+}
+
+func (c *SharedTranscriptCircuit[FR, G1El, G2El, GTEl]) Define(api frontend.API) error {
+	transcript, err := NewSharedTranscript[FR](api, []string{"gammaA", "gammaB"})
+	if err != nil {
+		return fmt.Errorf("new shared transcript: %w", err)
+	}
+	verifierA, err := NewVerifier[FR, G1El, G2El, GTEl](api, WithSharedTranscript[FR, G1El](transcript, "gammaA"))
+	if err != nil {
+		return fmt.Errorf("new verifier A: %w", err)
+	}
+	verifierB, err := NewVerifier[FR, G1El, G2El, GTEl](api, WithSharedTranscript[FR, G1El](transcript, "gammaB"))
+	if err != nil {
+		return fmt.Errorf("new verifier B: %w", err)
+	}
+	// the checks run in challenge declaration order; B's challenge absorbs
+	// A's, binding the two sub-protocols
+	if err := verifierA.BatchVerifySinglePointSharedTranscript(c.DigestsA[:], c.BatchProofA, c.PointA, c.VerifyingKey); err != nil {
+		return fmt.Errorf("batch verify A: %w", err)
+	}
+	if err := verifierB.BatchVerifySinglePointSharedTranscript(c.DigestsB[:], c.BatchProofB, c.PointB, c.VerifyingKey); err != nil {
+		return fmt.Errorf("batch verify B: %w", err)
+	}
+	return nil
+}
+
+func TestSharedTranscriptTwoVerifiers(t *testing.T) {
+	assert := test.NewAssert(t)
+
+	alpha, err := rand.Int(rand.Reader, ecc.BN254.ScalarField())
+	assert.NoError(err)
+	srs, err := kzg_bn254.NewSRS(kzgSize, alpha)
+	assert.NoError(err)
+
+	newBatch := func() ([][]fr_bn254.Element, []kzg_bn254.Digest, []fr_bn254.Element, fr_bn254.Element) {
+		var point fr_bn254.Element
+		point.SetRandom()
+		polys := make([][]fr_bn254.Element, testNbSharedBatch)
+		coms := make([]kzg_bn254.Digest, testNbSharedBatch)
+		claimedValues := make([]fr_bn254.Element, testNbSharedBatch)
+		for i := range polys {
+			polys[i] = make([]fr_bn254.Element, polynomialSize)
+			for j := range polys[i] {
+				polys[i][j].SetRandom()
+			}
+			var err error
+			coms[i], err = kzg_bn254.Commit(polys[i], srs.Pk)
+			assert.NoError(err)
+			claimedValues[i] = evalPolynomial(polys[i], point)
+		}
+		return polys, coms, claimedValues, point
+	}
+	polysA, comsA, valuesA, pointA := newBatch()
+	polysB, comsB, valuesB, pointB := newBatch()
+
+	// replay the shared transcript natively, in declaration order
+	h, err := recursion.NewShort(ecc.BN254.ScalarField(), ecc.BN254.ScalarField())
+	assert.NoError(err)
+	fs := cryptofs.NewTranscript(h, "gammaA", "gammaB")
+	bindBatch := func(challengeID string, coms []kzg_bn254.Digest, values []fr_bn254.Element, point fr_bn254.Element) fr_bn254.Element {
+		for i := range coms {
+			assert.NoError(fs.Bind(challengeID, coms[i].Marshal()))
+		}
+		for i := range values {
+			assert.NoError(fs.Bind(challengeID, values[i].Marshal()))
+		}
+		assert.NoError(fs.Bind(challengeID, point.Marshal()))
+		gammaBytes, err := fs.ComputeChallenge(challengeID)
+		assert.NoError(err)
+		var gamma fr_bn254.Element
+		gamma.SetBytes(gammaBytes)
+		return gamma
+	}
+	gammaA := bindBatch("gammaA", comsA, valuesA, pointA)
+	gammaB := bindBatch("gammaB", comsB, valuesB, pointB)
+
+	foldOpen := func(polys [][]fr_bn254.Element, gamma, point fr_bn254.Element, values []fr_bn254.Element) kzg_bn254.BatchOpeningProof {
+		foldedPoly := make([]fr_bn254.Element, polynomialSize)
+		var gammaPow, tmp fr_bn254.Element
+		gammaPow.SetOne()
+		for i := range polys {
+			for j := range polys[i] {
+				tmp.Mul(&polys[i][j], &gammaPow)
+				foldedPoly[j].Add(&foldedPoly[j], &tmp)
+			}
+			gammaPow.Mul(&gammaPow, &gamma)
+		}
+		foldedProof, err := kzg_bn254.Open(foldedPoly, point, srs.Pk)
+		assert.NoError(err)
+		return kzg_bn254.BatchOpeningProof{H: foldedProof.H, ClaimedValues: values}
+	}
+	batchProofA := foldOpen(polysA, gammaA, pointA, valuesA)
+	batchProofB := foldOpen(polysB, gammaB, pointB, valuesB)
+
+	assignment := SharedTranscriptCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{
+		PointA: emulated.ValueOf[sw_bn254.ScalarField](pointA),
+		PointB: emulated.ValueOf[sw_bn254.ScalarField](pointB),
+	}
+	assignment.VerifyingKey, err = ValueOfVerifyingKey[sw_bn254.G1Affine, sw_bn254.G2Affine](srs.Vk)
+	assert.NoError(err)
+	for i := 0; i < testNbSharedBatch; i++ {
+		assignment.DigestsA[i], err = ValueOfCommitment[sw_bn254.G1Affine](comsA[i])
+		assert.NoError(err)
+		assignment.DigestsB[i], err = ValueOfCommitment[sw_bn254.G1Affine](comsB[i])
+		assert.NoError(err)
+	}
+	assignment.BatchProofA, err = ValueOfBatchOpeningProof[sw_bn254.ScalarField, sw_bn254.G1Affine](batchProofA)
+	assert.NoError(err)
+	assignment.BatchProofB, err = ValueOfBatchOpeningProof[sw_bn254.ScalarField, sw_bn254.G1Affine](batchProofB)
+	assert.NoError(err)
+
+	circuit := SharedTranscriptCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{
+		BatchProofA: BatchOpeningProof[sw_bn254.ScalarField, sw_bn254.G1Affine]{
+			ClaimedValues: make([]emulated.Element[sw_bn254.ScalarField], testNbSharedBatch),
+		},
+		BatchProofB: BatchOpeningProof[sw_bn254.ScalarField, sw_bn254.G1Affine]{
+			ClaimedValues: make([]emulated.Element[sw_bn254.ScalarField], testNbSharedBatch),
+		},
+	}
+
+	assert.Run(func(assert *test.Assert) {
+		assert.NoError(test.IsSolved(&circuit, &assignment, ecc.BN254.ScalarField()))
+	}, "valid")
+
+	assert.Run(func(assert *test.Assert) {
+		// tampering with the first sub-protocol's inputs shifts its challenge
+		badAssignment := assignment
+		var otherValue fr_bn254.Element
+		otherValue.SetRandom()
+		badAssignment.BatchProofA.ClaimedValues = append([]emulated.Element[sw_bn254.ScalarField]{}, assignment.BatchProofA.ClaimedValues...)
+		badAssignment.BatchProofA.ClaimedValues[0] = emulated.ValueOf[sw_bn254.ScalarField](otherValue)
+		assert.Error(test.IsSolved(&circuit, &badAssignment, ecc.BN254.ScalarField()))
+	}, "tampered A")
+
+	assert.Run(func(assert *test.Assert) {
+		// tampering with the second sub-protocol's inputs fails its fold
+		badAssignment := assignment
+		var otherPoint fr_bn254.Element
+		otherPoint.SetRandom()
+		badAssignment.PointB = emulated.ValueOf[sw_bn254.ScalarField](otherPoint)
+		assert.Error(test.IsSolved(&circuit, &badAssignment, ecc.BN254.ScalarField()))
+	}, "tampered B")
+}
@@ -0,0 +1,40 @@
+package kzg
+
+import (
+	"fmt"
+
+	"github.com/consensys/gnark/std/algebra/native/twistededwards"
+	"github.com/consensys/gnark/std/hash"
+	"github.com/consensys/gnark/std/math/bits"
+	"github.com/consensys/gnark/std/math/emulated"
+	"github.com/consensys/gnark/std/signature/eddsa"
+)
+
+// AssertSignedOpening verifies the opening proof and that sig is a valid
+// EdDSA signature over the claimed value under pubKey. This composes the
+// opening check with signature verification for oracle designs where the
+// committer signs the evaluations it serves: the circuit accepts only values
+// that are both consistent with the commitment and endorsed by the oracle
+// key.
+//
+// The claimed value is decomposed canonically and repacked as the native
+// message passed to the signature hasher, so the signer must hash the
+// evaluation as a single native field element (see [eddsa.Verify]). This
+// requires the emulated scalar field to fit the native one; in practice the
+// gadget is used with the native parametrizations or with BN254 inside a
+// BN254 circuit.
+func (v *Verifier[FR, G1El, G2El, GTEl]) AssertSignedOpening(commitment Commitment[G1El], proof OpeningProof[FR, G1El], point emulated.Element[FR], vk VerifyingKey[G1El, G2El], sig eddsa.Signature, pubKey eddsa.PublicKey, curve twistededwards.Curve, hasher hash.FieldHasher) error {
+	var fr FR
+	if fr.Modulus().Cmp(v.api.Compiler().Field()) > 0 {
+		return fmt.Errorf("emulated scalar field doesn't fit the native field")
+	}
+	bts := v.scalarApi.ToBitsCanonical(&proof.ClaimedValue)
+	msg := bits.FromBinary(v.api, bts)
+	if err := eddsa.Verify(curve, sig, msg, pubKey, hasher); err != nil {
+		return fmt.Errorf("verify signature: %w", err)
+	}
+	if err := v.CheckOpeningProof(commitment, proof, point, vk); err != nil {
+		return fmt.Errorf("check opening proof: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,106 @@
+package kzg
+
+import (
+	"crypto/rand"
+	"fmt"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	fr_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	kzg_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/kzg"
+	tedwards "github.com/consensys/gnark-crypto/ecc/twistededwards"
+	crypto_hash "github.com/consensys/gnark-crypto/hash"
+	eddsa_native "github.com/consensys/gnark-crypto/signature/eddsa"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/algebra"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_bn254"
+	"github.com/consensys/gnark/std/algebra/native/twistededwards"
+	"github.com/consensys/gnark/std/hash/mimc"
+	"github.com/consensys/gnark/std/math/emulated"
+	"github.com/consensys/gnark/std/signature/eddsa"
+	"github.com/consensys/gnark/test"
+)
+
+type SignedOpeningCircuit[FR emulated.FieldParams, G1El algebra.G1ElementT, G2El algebra.G2ElementT, GTEl algebra.GtElementT] struct {
+	VerifyingKey[G1El, G2El]
+	Commitment[G1El]
+	OpeningProof[FR, G1El]
+	Point     emulated.Element[FR]
+	Signature eddsa.Signature
+	PublicKey eddsa.PublicKey
+}
+
+func (c *SignedOpeningCircuit[FR, G1El, G2El, GTEl]) Define(api frontend.API) error {
+	verifier, err := NewVerifier[FR, G1El, G2El, GTEl](api)
+	if err != nil {
+		return fmt.Errorf("new verifier: %w", err)
+	}
+	curve, err := twistededwards.NewEdCurve(api, tedwards.BN254)
+	if err != nil {
+		return fmt.Errorf("new twisted edwards curve: %w", err)
+	}
+	hasher, err := mimc.NewMiMC(api)
+	if err != nil {
+		return fmt.Errorf("new mimc: %w", err)
+	}
+	if err := verifier.AssertSignedOpening(c.Commitment, c.OpeningProof, c.Point, c.VerifyingKey, c.Signature, c.PublicKey, curve, &hasher); err != nil {
+		return fmt.Errorf("assert signed opening: %w", err)
+	}
+	return nil
+}
+
+func TestAssertSignedOpening(t *testing.T) {
+	assert := test.NewAssert(t)
+
+	alpha, err := rand.Int(rand.Reader, ecc.BN254.ScalarField())
+	assert.NoError(err)
+	srs, err := kzg_bn254.NewSRS(kzgSize, alpha)
+	assert.NoError(err)
+
+	f := make([]fr_bn254.Element, polynomialSize)
+	for i := range f {
+		f[i].SetRandom()
+	}
+	com, err := kzg_bn254.Commit(f, srs.Pk)
+	assert.NoError(err)
+	var point fr_bn254.Element
+	point.SetRandom()
+	proof, err := kzg_bn254.Open(f, point, srs.Pk)
+	assert.NoError(err)
+
+	// the oracle signs the claimed value
+	privKey, err := eddsa_native.New(tedwards.BN254, rand.Reader)
+	assert.NoError(err)
+	msg := proof.ClaimedValue.Marshal()
+	signature, err := privKey.Sign(msg, crypto_hash.MIMC_BN254.New())
+	assert.NoError(err)
+
+	wVk, err := ValueOfVerifyingKey[sw_bn254.G1Affine, sw_bn254.G2Affine](srs.Vk)
+	assert.NoError(err)
+	wCom, err := ValueOfCommitment[sw_bn254.G1Affine](com)
+	assert.NoError(err)
+	wProof, err := ValueOfOpeningProof[sw_bn254.ScalarField, sw_bn254.G1Affine](proof)
+	assert.NoError(err)
+	wPoint, err := ValueOfScalar[sw_bn254.ScalarField](point)
+	assert.NoError(err)
+
+	circuit := SignedOpeningCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{}
+	assignment := SignedOpeningCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{
+		VerifyingKey: wVk,
+		Commitment:   wCom,
+		OpeningProof: wProof,
+		Point:        wPoint,
+	}
+	assignment.PublicKey.Assign(tedwards.BN254, privKey.Public().Bytes())
+	assignment.Signature.Assign(tedwards.BN254, signature)
+	assert.NoError(test.IsSolved(&circuit, &assignment, ecc.BN254.ScalarField()))
+
+	// a signature over a different value does not verify
+	var otherValue fr_bn254.Element
+	otherValue.SetRandom()
+	badSignature, err := privKey.Sign(otherValue.Marshal(), crypto_hash.MIMC_BN254.New())
+	assert.NoError(err)
+	badAssignment := assignment
+	badAssignment.Signature.Assign(tedwards.BN254, badSignature)
+	assert.Error(test.IsSolved(&circuit, &badAssignment, ecc.BN254.ScalarField()))
+}
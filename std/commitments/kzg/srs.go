@@ -0,0 +1,115 @@
+package kzg
+
+import (
+	"fmt"
+
+	kzg_bls12377 "github.com/consensys/gnark-crypto/ecc/bls12-377/kzg"
+	kzg_bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381/kzg"
+	kzg_bls24315 "github.com/consensys/gnark-crypto/ecc/bls24-315/kzg"
+	kzg_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/kzg"
+	kzg_bw6761 "github.com/consensys/gnark-crypto/ecc/bw6-761/kzg"
+	"github.com/consensys/gnark/std/algebra"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_bls12381"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_bn254"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_bw6761"
+	"github.com/consensys/gnark/std/algebra/native/sw_bls12377"
+	"github.com/consensys/gnark/std/algebra/native/sw_bls24315"
+)
+
+// FullSRS is a witness for the full trusted setup of the KZG polynomial
+// commitment scheme, including the G1 powers of the proving key in addition to
+// the verification G2 points. It is the witness foundation for re-deriving a
+// commitment in-circuit.
+//
+// Note that the witness size grows linearly with the SRS size: every G1 power
+// is carried as a separate witness point. For large SRS this dominates the
+// witness and the number of constraints needed to validate it, so the type is
+// only practical for small setups. Use [VerifyingKey] when only opening-proof
+// verification is needed.
+type FullSRS[G1El algebra.G1ElementT, G2El algebra.G2ElementT] struct {
+	VerifyingKey[G1El, G2El]
+	G1Powers []G1El
+}
+
+// ValueOfFullSRS initializes the full SRS witness from the native SRS,
+// reading both the verifying key and the G1 powers of the proving key. It
+// returns an error if there is a mismatch between the type parameters and the
+// provided SRS type.
+func ValueOfFullSRS[G1El algebra.G1ElementT, G2El algebra.G2ElementT](srs any) (FullSRS[G1El, G2El], error) {
+	var ret FullSRS[G1El, G2El]
+	switch s := any(&ret).(type) {
+	case *FullSRS[sw_bn254.G1Affine, sw_bn254.G2Affine]:
+		tSrs, ok := srs.(*kzg_bn254.SRS)
+		if !ok {
+			return ret, fmt.Errorf("mismatching types %T %T", ret, srs)
+		}
+		vk, err := ValueOfVerifyingKey[sw_bn254.G1Affine, sw_bn254.G2Affine](tSrs.Vk)
+		if err != nil {
+			return ret, fmt.Errorf("verifying key: %w", err)
+		}
+		s.VerifyingKey = vk
+		s.G1Powers = make([]sw_bn254.G1Affine, len(tSrs.Pk.G1))
+		for i := range tSrs.Pk.G1 {
+			s.G1Powers[i] = sw_bn254.NewG1Affine(tSrs.Pk.G1[i])
+		}
+	case *FullSRS[sw_bls12377.G1Affine, sw_bls12377.G2Affine]:
+		tSrs, ok := srs.(*kzg_bls12377.SRS)
+		if !ok {
+			return ret, fmt.Errorf("mismatching types %T %T", ret, srs)
+		}
+		vk, err := ValueOfVerifyingKey[sw_bls12377.G1Affine, sw_bls12377.G2Affine](tSrs.Vk)
+		if err != nil {
+			return ret, fmt.Errorf("verifying key: %w", err)
+		}
+		s.VerifyingKey = vk
+		s.G1Powers = make([]sw_bls12377.G1Affine, len(tSrs.Pk.G1))
+		for i := range tSrs.Pk.G1 {
+			s.G1Powers[i] = sw_bls12377.NewG1Affine(tSrs.Pk.G1[i])
+		}
+	case *FullSRS[sw_bls12381.G1Affine, sw_bls12381.G2Affine]:
+		tSrs, ok := srs.(*kzg_bls12381.SRS)
+		if !ok {
+			return ret, fmt.Errorf("mismatching types %T %T", ret, srs)
+		}
+		vk, err := ValueOfVerifyingKey[sw_bls12381.G1Affine, sw_bls12381.G2Affine](tSrs.Vk)
+		if err != nil {
+			return ret, fmt.Errorf("verifying key: %w", err)
+		}
+		s.VerifyingKey = vk
+		s.G1Powers = make([]sw_bls12381.G1Affine, len(tSrs.Pk.G1))
+		for i := range tSrs.Pk.G1 {
+			s.G1Powers[i] = sw_bls12381.NewG1Affine(tSrs.Pk.G1[i])
+		}
+	case *FullSRS[sw_bw6761.G1Affine, sw_bw6761.G2Affine]:
+		tSrs, ok := srs.(*kzg_bw6761.SRS)
+		if !ok {
+			return ret, fmt.Errorf("mismatching types %T %T", ret, srs)
+		}
+		vk, err := ValueOfVerifyingKey[sw_bw6761.G1Affine, sw_bw6761.G2Affine](tSrs.Vk)
+		if err != nil {
+			return ret, fmt.Errorf("verifying key: %w", err)
+		}
+		s.VerifyingKey = vk
+		s.G1Powers = make([]sw_bw6761.G1Affine, len(tSrs.Pk.G1))
+		for i := range tSrs.Pk.G1 {
+			s.G1Powers[i] = sw_bw6761.NewG1Affine(tSrs.Pk.G1[i])
+		}
+	case *FullSRS[sw_bls24315.G1Affine, sw_bls24315.G2Affine]:
+		tSrs, ok := srs.(*kzg_bls24315.SRS)
+		if !ok {
+			return ret, fmt.Errorf("mismatching types %T %T", ret, srs)
+		}
+		vk, err := ValueOfVerifyingKey[sw_bls24315.G1Affine, sw_bls24315.G2Affine](tSrs.Vk)
+		if err != nil {
+			return ret, fmt.Errorf("verifying key: %w", err)
+		}
+		s.VerifyingKey = vk
+		s.G1Powers = make([]sw_bls24315.G1Affine, len(tSrs.Pk.G1))
+		for i := range tSrs.Pk.G1 {
+			s.G1Powers[i] = sw_bls24315.NewG1Affine(tSrs.Pk.G1[i])
+		}
+	default:
+		return ret, fmt.Errorf("unknown type parametrization")
+	}
+	return ret, nil
+}
@@ -0,0 +1,56 @@
+package kzg
+
+import (
+	"crypto/rand"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	kzg_bls12377 "github.com/consensys/gnark-crypto/ecc/bls12-377/kzg"
+	kzg_bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381/kzg"
+	kzg_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/kzg"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_bls12381"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_bn254"
+	"github.com/consensys/gnark/std/algebra/native/sw_bls12377"
+	"github.com/consensys/gnark/test"
+)
+
+func TestValueOfFullSRS(t *testing.T) {
+	const srsSize = 8
+	assert := test.NewAssert(t)
+	assert.Run(func(assert *test.Assert) {
+		alpha, err := rand.Int(rand.Reader, ecc.BN254.ScalarField())
+		assert.NoError(err)
+		srs, err := kzg_bn254.NewSRS(srsSize, alpha)
+		assert.NoError(err)
+		assignment, err := ValueOfFullSRS[sw_bn254.G1Affine, sw_bn254.G2Affine](srs)
+		assert.NoError(err)
+		assert.Equal(len(srs.Pk.G1), len(assignment.G1Powers))
+	}, "bn254")
+	assert.Run(func(assert *test.Assert) {
+		alpha, err := rand.Int(rand.Reader, ecc.BLS12_377.ScalarField())
+		assert.NoError(err)
+		srs, err := kzg_bls12377.NewSRS(srsSize, alpha)
+		assert.NoError(err)
+		assignment, err := ValueOfFullSRS[sw_bls12377.G1Affine, sw_bls12377.G2Affine](srs)
+		assert.NoError(err)
+		assert.Equal(len(srs.Pk.G1), len(assignment.G1Powers))
+	}, "bls12377")
+	assert.Run(func(assert *test.Assert) {
+		alpha, err := rand.Int(rand.Reader, ecc.BLS12_381.ScalarField())
+		assert.NoError(err)
+		srs, err := kzg_bls12381.NewSRS(srsSize, alpha)
+		assert.NoError(err)
+		assignment, err := ValueOfFullSRS[sw_bls12381.G1Affine, sw_bls12381.G2Affine](srs)
+		assert.NoError(err)
+		assert.Equal(len(srs.Pk.G1), len(assignment.G1Powers))
+	}, "bls12381")
+	assert.Run(func(assert *test.Assert) {
+		// a genuine type mismatch keeps the existing error behavior
+		alpha, err := rand.Int(rand.Reader, ecc.BLS12_381.ScalarField())
+		assert.NoError(err)
+		srs, err := kzg_bls12381.NewSRS(srsSize, alpha)
+		assert.NoError(err)
+		_, err = ValueOfFullSRS[sw_bn254.G1Affine, sw_bn254.G2Affine](srs)
+		assert.Error(err)
+	}, "mismatch")
+}
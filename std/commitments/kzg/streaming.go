@@ -0,0 +1,28 @@
+package kzg
+
+import (
+	"fmt"
+
+	"github.com/consensys/gnark/std/math/emulated"
+)
+
+// AssertStreamedCommitmentOpening verifies an opening of a commitment
+// produced by a streaming prover: the polynomial is committed in chunks and
+// the final commitment is the sum of the chunk commitments. The method
+// asserts that finalCommitment equals the sum of chunkCommitments and then
+// verifies the opening proof against it. The chunk sums use complete
+// arithmetic as the intermediate sums are not controlled by the caller.
+func (v *Verifier[FR, G1El, G2El, GTEl]) AssertStreamedCommitmentOpening(chunkCommitments []Commitment[G1El], finalCommitment Commitment[G1El], proof OpeningProof[FR, G1El], point emulated.Element[FR], vk VerifyingKey[G1El, G2El]) error {
+	if len(chunkCommitments) == 0 {
+		return fmt.Errorf("number of chunk commitments should be nonzero")
+	}
+	sum := &chunkCommitments[0].G1El
+	for i := 1; i < len(chunkCommitments); i++ {
+		sum = v.curve.AddUnified(sum, &chunkCommitments[i].G1El)
+	}
+	v.curve.AssertIsEqual(sum, &finalCommitment.G1El)
+	if err := v.CheckOpeningProof(finalCommitment, proof, point, vk); err != nil {
+		return fmt.Errorf("check opening proof: %w", err)
+	}
+	return nil
+}
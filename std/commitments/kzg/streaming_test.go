@@ -0,0 +1,84 @@
+package kzg
+
+import (
+	"crypto/rand"
+	"fmt"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	fr_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	kzg_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/kzg"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/algebra"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_bn254"
+	"github.com/consensys/gnark/std/math/emulated"
+	"github.com/consensys/gnark/test"
+)
+
+type StreamedOpeningCircuit[FR emulated.FieldParams, G1El algebra.G1ElementT, G2El algebra.G2ElementT, GTEl algebra.GtElementT] struct {
+	Vk               VerifyingKey[G1El, G2El]
+	ChunkCommitments [3]Commitment[G1El]
+	FinalCommitment  Commitment[G1El]
+	Proof            OpeningProof[FR, G1El]
+	Point            emulated.Element[FR]
+}
+
+func (c *StreamedOpeningCircuit[FR, G1El, G2El, GTEl]) Define(api frontend.API) error {
+	verifier, err := NewVerifier[FR, G1El, G2El, GTEl](api)
+	if err != nil {
+		return fmt.Errorf("new verifier: %w", err)
+	}
+	if err := verifier.AssertStreamedCommitmentOpening(c.ChunkCommitments[:], c.FinalCommitment, c.Proof, c.Point, c.Vk); err != nil {
+		return fmt.Errorf("assert streamed opening: %w", err)
+	}
+	return nil
+}
+
+func TestStreamedCommitmentOpening(t *testing.T) {
+	assert := test.NewAssert(t)
+
+	alpha, err := rand.Int(rand.Reader, ecc.BN254.ScalarField())
+	assert.NoError(err)
+	srs, err := kzg_bn254.NewSRS(kzgSize, alpha)
+	assert.NoError(err)
+
+	// the polynomial is committed in three streamed chunks summing to the
+	// full commitment
+	f := make([]fr_bn254.Element, polynomialSize)
+	for i := range f {
+		f[i].SetRandom()
+	}
+	var chunks [3][]fr_bn254.Element
+	chunkSize := (len(f) + 2) / 3
+	for i := range chunks {
+		chunks[i] = make([]fr_bn254.Element, len(f))
+		end := min((i+1)*chunkSize, len(f))
+		copy(chunks[i][i*chunkSize:], f[i*chunkSize:end])
+	}
+
+	com, err := kzg_bn254.Commit(f, srs.Pk)
+	assert.NoError(err)
+	var point fr_bn254.Element
+	point.SetRandom()
+	proof, err := kzg_bn254.Open(f, point, srs.Pk)
+	assert.NoError(err)
+
+	wVk, err := ValueOfVerifyingKey[sw_bn254.G1Affine, sw_bn254.G2Affine](srs.Vk)
+	assert.NoError(err)
+	assignment := StreamedOpeningCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{Vk: wVk}
+	for i := range chunks {
+		chunkCom, err := kzg_bn254.Commit(chunks[i], srs.Pk)
+		assert.NoError(err)
+		assignment.ChunkCommitments[i], err = ValueOfCommitment[sw_bn254.G1Affine](chunkCom)
+		assert.NoError(err)
+	}
+	assignment.FinalCommitment, err = ValueOfCommitment[sw_bn254.G1Affine](com)
+	assert.NoError(err)
+	assignment.Proof, err = ValueOfOpeningProof[sw_bn254.ScalarField, sw_bn254.G1Affine](proof)
+	assert.NoError(err)
+	assignment.Point, err = ValueOfScalar[sw_bn254.ScalarField](point)
+	assert.NoError(err)
+
+	err = test.IsSolved(&StreamedOpeningCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{}, &assignment, ecc.BN254.ScalarField())
+	assert.NoError(err)
+}
@@ -0,0 +1,32 @@
+package kzg
+
+import (
+	"fmt"
+
+	"github.com/consensys/gnark/std/math/emulated"
+)
+
+// AssertProofEqualsTarget is as [Verifier.CheckOpeningProof] but asserts the
+// pairing product equals the given GT constant instead of the identity. It
+// computes the full pairing, including the final exponentiation, over the
+// proof pairing inputs and asserts GT equality with target.
+//
+// The target is derived natively as the residual pairing product the protocol
+// variant expects. For example, when the claimed value deliberately omits a
+// fixed offset δ, the product evaluates to e([δ]G₁, G₂)⁻¹ = e(-[δ]G₁, G₂)
+// instead of one; committing that constant as the target verifies the offset
+// opening without materializing the offset in-circuit. The identity target
+// reduces to the plain opening check, for which [Verifier.CheckOpeningProof]
+// is cheaper as it avoids the final exponentiation.
+func (v *Verifier[FR, G1El, G2El, GTEl]) AssertProofEqualsTarget(commitment Commitment[G1El], proof OpeningProof[FR, G1El], point emulated.Element[FR], target GTEl, vk VerifyingKey[G1El, G2El]) error {
+	g1Points, g2Points, err := v.ProofPairingInputs(commitment, proof, point, vk)
+	if err != nil {
+		return fmt.Errorf("proof pairing inputs: %w", err)
+	}
+	res, err := v.pairing.Pair(g1Points, g2Points)
+	if err != nil {
+		return fmt.Errorf("pair: %w", err)
+	}
+	v.pairing.AssertIsEqual(res, &target)
+	return nil
+}
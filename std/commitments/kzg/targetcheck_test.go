@@ -0,0 +1,101 @@
+package kzg
+
+import (
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bn254"
+	fr_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	kzg_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/kzg"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/algebra"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_bn254"
+	"github.com/consensys/gnark/std/math/emulated"
+	"github.com/consensys/gnark/test"
+)
+
+type TargetCheckCircuit[FR emulated.FieldParams, G1El algebra.G1ElementT, G2El algebra.G2ElementT, GTEl algebra.GtElementT] struct {
+	VerifyingKey[G1El, G2El]
+	Commitment[G1El]
+	OpeningProof[FR, G1El]
+	Point  emulated.Element[FR]
+	Target GTEl
+}
+
+func (c *TargetCheckCircuit[FR, G1El, G2El, GTEl]) Define(api frontend.API) error {
+	verifier, err := NewVerifier[FR, G1El, G2El, GTEl](api)
+	if err != nil {
+		return fmt.Errorf("new verifier: %w", err)
+	}
+	return verifier.AssertProofEqualsTarget(c.Commitment, c.OpeningProof, c.Point, c.Target, c.VerifyingKey)
+}
+
+func TestAssertProofEqualsTarget(t *testing.T) {
+	assert := test.NewAssert(t)
+
+	alpha, err := rand.Int(rand.Reader, ecc.BN254.ScalarField())
+	assert.NoError(err)
+	srs, err := kzg_bn254.NewSRS(kzgSize, alpha)
+	assert.NoError(err)
+
+	f := make([]fr_bn254.Element, polynomialSize)
+	for i := range f {
+		f[i].SetRandom()
+	}
+	com, err := kzg_bn254.Commit(f, srs.Pk)
+	assert.NoError(err)
+	var point fr_bn254.Element
+	point.SetRandom()
+	proof, err := kzg_bn254.Open(f, point, srs.Pk)
+	assert.NoError(err)
+
+	// the claimed value omits a fixed offset δ, leaving a nontrivial residual
+	// pairing product as the target
+	var delta fr_bn254.Element
+	delta.SetRandom()
+	proof.ClaimedValue.Sub(&proof.ClaimedValue, &delta)
+
+	// native residual: the pairing product over the opening-check inputs
+	var fG1, aH, totalG1 bn254.G1Affine
+	fG1.ScalarMultiplication(&srs.Vk.G1, proof.ClaimedValue.BigInt(new(big.Int)))
+	aH.ScalarMultiplication(&proof.H, point.BigInt(new(big.Int)))
+	totalG1.Sub(&fG1, &aH)
+	totalG1.Sub(&totalG1, &com)
+	target, err := bn254.Pair(
+		[]bn254.G1Affine{totalG1, proof.H},
+		[]bn254.G2Affine{srs.Vk.G2[0], srs.Vk.G2[1]},
+	)
+	assert.NoError(err)
+	assert.False(target.IsOne())
+
+	assignment := TargetCheckCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{
+		Point: emulated.ValueOf[sw_bn254.ScalarField](point),
+	}
+	assignment.VerifyingKey, err = ValueOfVerifyingKey[sw_bn254.G1Affine, sw_bn254.G2Affine](srs.Vk)
+	assert.NoError(err)
+	assignment.Commitment, err = ValueOfCommitment[sw_bn254.G1Affine](com)
+	assert.NoError(err)
+	assignment.OpeningProof, err = ValueOfOpeningProof[sw_bn254.ScalarField, sw_bn254.G1Affine](proof)
+	assert.NoError(err)
+	assignment.Target, err = ValueOfGt[sw_bn254.GTEl](target)
+	assert.NoError(err)
+
+	circuit := TargetCheckCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{}
+
+	assert.Run(func(assert *test.Assert) {
+		assert.NoError(test.IsSolved(&circuit, &assignment, ecc.BN254.ScalarField()))
+	}, "nontrivial target")
+
+	assert.Run(func(assert *test.Assert) {
+		// the offset proof does not verify against the identity target
+		badAssignment := assignment
+		var one bn254.GT
+		one.SetOne()
+		badAssignment.Target, err = ValueOfGt[sw_bn254.GTEl](one)
+		assert.NoError(err)
+		assert.Error(test.IsSolved(&circuit, &badAssignment, ecc.BN254.ScalarField()))
+	}, "identity target")
+}
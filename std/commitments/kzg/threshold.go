@@ -0,0 +1,80 @@
+package kzg
+
+import (
+	"fmt"
+
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/algebra"
+	"github.com/consensys/gnark/std/math/emulated"
+)
+
+// gtEqualityTester is implemented by pairing contexts which can compare GT
+// elements without asserting, returning the outcome as a variable.
+type gtEqualityTester[GTEl algebra.GtElementT] interface {
+	IsEqual(x, y *GTEl) frontend.Variable
+}
+
+// IsProofValid returns a boolean variable set to 1 when the opening proof for
+// the given commitment at point verifies and to 0 otherwise, without
+// asserting. This costs a full pairing computation including the final
+// exponentiation — unlike [Verifier.CheckOpeningProof] nothing can be
+// amortized, as the result must be an honest bit even for invalid openings.
+//
+// The method requires the pairing context to support non-asserting GT
+// comparison; among the supported curves this is currently only the emulated
+// BN254 implementation.
+func (v *Verifier[FR, G1El, G2El, GTEl]) IsProofValid(commitment Commitment[G1El], proof OpeningProof[FR, G1El], point emulated.Element[FR], vk VerifyingKey[G1El, G2El]) (frontend.Variable, error) {
+	tester, ok := any(v.pairing).(gtEqualityTester[GTEl])
+	if !ok {
+		return nil, fmt.Errorf("pairing context does not support non-asserting GT comparison")
+	}
+	g1Points, g2Points, err := v.ProofPairingInputs(commitment, proof, point, vk)
+	if err != nil {
+		return nil, fmt.Errorf("proof pairing inputs: %w", err)
+	}
+	res, err := v.pairing.Pair(g1Points, g2Points)
+	if err != nil {
+		return nil, fmt.Errorf("pair: %w", err)
+	}
+	one, err := oneGt[GTEl]()
+	if err != nil {
+		return nil, fmt.Errorf("one in GT: %w", err)
+	}
+	return tester.IsEqual(res, one), nil
+}
+
+// AssertThresholdOpenings asserts that at least k of the supplied openings
+// are valid, tolerating invalid shares as long as the threshold is met. Each
+// opening is checked individually with [Verifier.IsProofValid] and the
+// resulting bit is constrained to equal the corresponding supplied validity
+// flag, so the flags are sound for downstream branching; the flags sum is
+// then asserted to be at least k.
+//
+// Every opening is fully evaluated with its own pairing regardless of the
+// flags — the cost is m full pairing checks and cannot be amortized, since
+// the gadget must determine validity honestly even for invalid shares.
+func (v *Verifier[FR, G1El, G2El, GTEl]) AssertThresholdOpenings(commitments []Commitment[G1El], proofs []OpeningProof[FR, G1El], points []emulated.Element[FR], validityFlags []frontend.Variable, k int, vk VerifyingKey[G1El, G2El]) error {
+	if len(commitments) != len(proofs) {
+		return fmt.Errorf("length mismatch for commitments and proofs")
+	}
+	if len(proofs) != len(points) {
+		return fmt.Errorf("length mismatch for proofs and points")
+	}
+	if len(proofs) != len(validityFlags) {
+		return fmt.Errorf("length mismatch for proofs and validity flags")
+	}
+	if k <= 0 || k > len(proofs) {
+		return fmt.Errorf("threshold out of range")
+	}
+	sum := frontend.Variable(0)
+	for i := range proofs {
+		valid, err := v.IsProofValid(commitments[i], proofs[i], points[i], vk)
+		if err != nil {
+			return fmt.Errorf("proof %d: %w", i, err)
+		}
+		v.api.AssertIsEqual(valid, validityFlags[i])
+		sum = v.api.Add(sum, valid)
+	}
+	v.api.AssertIsLessOrEqual(k, sum)
+	return nil
+}
@@ -0,0 +1,100 @@
+package kzg
+
+import (
+	"crypto/rand"
+	"fmt"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	fr_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	kzg_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/kzg"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/algebra"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_bn254"
+	"github.com/consensys/gnark/std/math/emulated"
+	"github.com/consensys/gnark/test"
+)
+
+const (
+	testNbThresholdOpenings = 3
+	testThreshold           = 2
+)
+
+type ThresholdOpeningsCircuit[FR emulated.FieldParams, G1El algebra.G1ElementT, G2El algebra.G2ElementT, GTEl algebra.GtElementT] struct {
+	VerifyingKey[G1El, G2El]
+	Commitments   [testNbThresholdOpenings]Commitment[G1El]
+	Proofs        [testNbThresholdOpenings]OpeningProof[FR, G1El]
+	Points        [testNbThresholdOpenings]emulated.Element[FR]
+	ValidityFlags [testNbThresholdOpenings]frontend.Variable
+}
+
+func (c *ThresholdOpeningsCircuit[FR, G1El, G2El, GTEl]) Define(api frontend.API) error {
+	verifier, err := NewVerifier[FR, G1El, G2El, GTEl](api)
+	if err != nil {
+		return fmt.Errorf("new verifier: %w", err)
+	}
+	return verifier.AssertThresholdOpenings(c.Commitments[:], c.Proofs[:], c.Points[:], c.ValidityFlags[:], testThreshold, c.VerifyingKey)
+}
+
+func TestAssertThresholdOpenings(t *testing.T) {
+	assert := test.NewAssert(t)
+
+	alpha, err := rand.Int(rand.Reader, ecc.BN254.ScalarField())
+	assert.NoError(err)
+	srs, err := kzg_bn254.NewSRS(kzgSize, alpha)
+	assert.NoError(err)
+
+	// buildAssignment marks the first nbInvalid openings invalid by tampering
+	// with their claimed values
+	buildAssignment := func(nbInvalid int) ThresholdOpeningsCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl] {
+		assignment := ThresholdOpeningsCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{}
+		assignment.VerifyingKey, err = ValueOfVerifyingKey[sw_bn254.G1Affine, sw_bn254.G2Affine](srs.Vk)
+		assert.NoError(err)
+		for i := 0; i < testNbThresholdOpenings; i++ {
+			f := make([]fr_bn254.Element, polynomialSize)
+			for j := range f {
+				f[j].SetRandom()
+			}
+			com, err := kzg_bn254.Commit(f, srs.Pk)
+			assert.NoError(err)
+			var point fr_bn254.Element
+			point.SetRandom()
+			proof, err := kzg_bn254.Open(f, point, srs.Pk)
+			assert.NoError(err)
+			if i < nbInvalid {
+				proof.ClaimedValue.SetRandom()
+				assignment.ValidityFlags[i] = 0
+			} else {
+				assignment.ValidityFlags[i] = 1
+			}
+			assignment.Commitments[i], err = ValueOfCommitment[sw_bn254.G1Affine](com)
+			assert.NoError(err)
+			assignment.Proofs[i], err = ValueOfOpeningProof[sw_bn254.ScalarField, sw_bn254.G1Affine](proof)
+			assert.NoError(err)
+			assignment.Points[i], err = ValueOfScalar[sw_bn254.ScalarField](point)
+			assert.NoError(err)
+		}
+		return assignment
+	}
+
+	circuit := ThresholdOpeningsCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{}
+
+	assert.Run(func(assert *test.Assert) {
+		// one invalid share out of three still meets the threshold of two
+		assignment := buildAssignment(1)
+		assert.NoError(test.IsSolved(&circuit, &assignment, ecc.BN254.ScalarField()))
+	}, "at threshold")
+
+	assert.Run(func(assert *test.Assert) {
+		// two invalid shares leave only one valid opening
+		assignment := buildAssignment(2)
+		assert.Error(test.IsSolved(&circuit, &assignment, ecc.BN254.ScalarField()))
+	}, "below threshold")
+
+	assert.Run(func(assert *test.Assert) {
+		// flags inconsistent with the actual validity are rejected
+		assignment := buildAssignment(1)
+		assignment.ValidityFlags[0] = 1
+		assert.Error(test.IsSolved(&circuit, &assignment, ecc.BN254.ScalarField()))
+	}, "inconsistent flags")
+}
@@ -0,0 +1,49 @@
+package kzg
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/algebra/algopts"
+	"github.com/consensys/gnark/std/hash"
+	"github.com/consensys/gnark/std/math/bits"
+	"github.com/consensys/gnark/std/math/emulated"
+)
+
+// VerifyToPublic asserts the validity of the opening proof and returns a
+// digest binding the commitment, the proof and the point. Exposing the digest
+// as a public output lets an outer recursion layer consume the verification
+// result without access to the full witness: it only has to assert equality
+// with the digest recomputed from the values it trusts.
+//
+// Soundness requires the digest to bind every verified input, which is why
+// the commitment, the quotient, the claimed value and the point are all
+// absorbed in canonical bit representation. The verifying key is NOT part of
+// the digest; it must be fixed by the outer protocol (e.g. as a circuit
+// constant), as is usual for a trusted setup. The digest does not replace the
+// pairing check — it is performed here — it only communicates that it
+// happened for exactly these inputs.
+func (v *Verifier[FR, G1El, G2El, GTEl]) VerifyToPublic(commitment Commitment[G1El], proof OpeningProof[FR, G1El], point emulated.Element[FR], vk VerifyingKey[G1El, G2El], hasher hash.FieldHasher) (frontend.Variable, error) {
+	if err := v.CheckOpeningProof(commitment, proof, point, vk); err != nil {
+		return nil, fmt.Errorf("check opening proof: %w", err)
+	}
+	var stream []frontend.Variable
+	stream = append(stream, v.curve.MarshalG1(commitment.G1El, algopts.WithCanonicalBitRepresentation())...)
+	stream = append(stream, v.curve.MarshalG1(proof.Quotient, algopts.WithCanonicalBitRepresentation())...)
+	stream = append(stream, v.curve.MarshalScalar(proof.ClaimedValue, algopts.WithCanonicalBitRepresentation())...)
+	stream = append(stream, v.curve.MarshalScalar(point, algopts.WithCanonicalBitRepresentation())...)
+
+	// absorb the bit stream packed into native field elements, most
+	// significant chunk first
+	chunk := v.api.Compiler().FieldBitLen() - 1
+	hasher.Reset()
+	for start := 0; start < len(stream); start += chunk {
+		end := min(start+chunk, len(stream))
+		grp := make([]frontend.Variable, end-start)
+		copy(grp, stream[start:end])
+		slices.Reverse(grp)
+		hasher.Write(bits.FromBinary(v.api, grp))
+	}
+	return hasher.Sum(), nil
+}
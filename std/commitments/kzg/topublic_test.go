@@ -0,0 +1,142 @@
+package kzg
+
+import (
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	fr_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	kzg_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/kzg"
+	crypto_mimc "github.com/consensys/gnark-crypto/hash"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/algebra"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_bn254"
+	"github.com/consensys/gnark/std/hash/mimc"
+	"github.com/consensys/gnark/std/math/emulated"
+	"github.com/consensys/gnark/test"
+)
+
+// InnerAcceptanceCircuit verifies the opening and exposes the acceptance
+// digest as a public input, as the inner layer of a two-level recursion.
+type InnerAcceptanceCircuit[FR emulated.FieldParams, G1El algebra.G1ElementT, G2El algebra.G2ElementT, GTEl algebra.GtElementT] struct {
+	VerifyingKey[G1El, G2El]
+	Commitment[G1El]
+	OpeningProof[FR, G1El]
+	Point  emulated.Element[FR]
+	Digest frontend.Variable `gnark:",public"`
+}
+
+func (c *InnerAcceptanceCircuit[FR, G1El, G2El, GTEl]) Define(api frontend.API) error {
+	verifier, err := NewVerifier[FR, G1El, G2El, GTEl](api)
+	if err != nil {
+		return fmt.Errorf("new verifier: %w", err)
+	}
+	hasher, err := mimc.NewMiMC(api)
+	if err != nil {
+		return fmt.Errorf("new mimc: %w", err)
+	}
+	digest, err := verifier.VerifyToPublic(c.Commitment, c.OpeningProof, c.Point, c.VerifyingKey, &hasher)
+	if err != nil {
+		return fmt.Errorf("verify to public: %w", err)
+	}
+	api.AssertIsEqual(digest, c.Digest)
+	return nil
+}
+
+// OuterAcceptanceCircuit consumes the inner acceptance digest without
+// redoing the pairing.
+type OuterAcceptanceCircuit struct {
+	InnerDigest    frontend.Variable `gnark:",public"`
+	ExpectedDigest frontend.Variable
+}
+
+func (c *OuterAcceptanceCircuit) Define(api frontend.API) error {
+	api.AssertIsEqual(c.InnerDigest, c.ExpectedDigest)
+	return nil
+}
+
+// acceptanceDigest recomputes the binding digest natively: the canonical
+// big-endian bytes of the commitment, quotient, claimed value and point are
+// concatenated, the bit stream is packed into 253-bit chunks and absorbed by
+// MiMC.
+func acceptanceDigest(com kzg_bn254.Digest, proof kzg_bn254.OpeningProof, point fr_bn254.Element) *big.Int {
+	var data []byte
+	data = append(data, com.X.Marshal()...)
+	data = append(data, com.Y.Marshal()...)
+	data = append(data, proof.H.X.Marshal()...)
+	data = append(data, proof.H.Y.Marshal()...)
+	data = append(data, proof.ClaimedValue.Marshal()...)
+	data = append(data, point.Marshal()...)
+	stream := make([]uint, 0, 8*len(data))
+	for _, b := range data {
+		for i := 7; i >= 0; i-- {
+			stream = append(stream, uint(b>>i)&1)
+		}
+	}
+	const chunk = 253
+	hasher := crypto_mimc.MIMC_BN254.New()
+	for start := 0; start < len(stream); start += chunk {
+		end := min(start+chunk, len(stream))
+		val := new(big.Int)
+		for _, b := range stream[start:end] {
+			val.Lsh(val, 1).Add(val, big.NewInt(int64(b)))
+		}
+		buf := make([]byte, fr_bn254.Bytes)
+		val.FillBytes(buf)
+		hasher.Write(buf)
+	}
+	return new(big.Int).SetBytes(hasher.Sum(nil))
+}
+
+func TestVerifyToPublic(t *testing.T) {
+	assert := test.NewAssert(t)
+
+	alpha, err := rand.Int(rand.Reader, ecc.BN254.ScalarField())
+	assert.NoError(err)
+	srs, err := kzg_bn254.NewSRS(kzgSize, alpha)
+	assert.NoError(err)
+	f := make([]fr_bn254.Element, polynomialSize)
+	for i := range f {
+		f[i].SetRandom()
+	}
+	com, err := kzg_bn254.Commit(f, srs.Pk)
+	assert.NoError(err)
+	var point fr_bn254.Element
+	point.SetRandom()
+	proof, err := kzg_bn254.Open(f, point, srs.Pk)
+	assert.NoError(err)
+
+	digest := acceptanceDigest(com, proof, point)
+
+	wVk, err := ValueOfVerifyingKey[sw_bn254.G1Affine, sw_bn254.G2Affine](srs.Vk)
+	assert.NoError(err)
+	wCom, err := ValueOfCommitment[sw_bn254.G1Affine](com)
+	assert.NoError(err)
+	wProof, err := ValueOfOpeningProof[sw_bn254.ScalarField, sw_bn254.G1Affine](proof)
+	assert.NoError(err)
+	wPoint, err := ValueOfScalar[sw_bn254.ScalarField](point)
+	assert.NoError(err)
+
+	// level one: verify the opening and expose the digest
+	circuit := InnerAcceptanceCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{}
+	assignment := InnerAcceptanceCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{
+		VerifyingKey: wVk,
+		Commitment:   wCom,
+		OpeningProof: wProof,
+		Point:        wPoint,
+		Digest:       digest,
+	}
+	assert.NoError(test.IsSolved(&circuit, &assignment, ecc.BN254.ScalarField()))
+
+	// level two: the outer circuit consumes the digest
+	outer := OuterAcceptanceCircuit{}
+	outerAssignment := OuterAcceptanceCircuit{InnerDigest: digest, ExpectedDigest: digest}
+	assert.NoError(test.IsSolved(&outer, &outerAssignment, ecc.BN254.ScalarField()))
+
+	// a digest for different inputs is rejected by the inner circuit
+	badAssignment := assignment
+	badAssignment.Digest = new(big.Int).Add(digest, big.NewInt(1))
+	assert.Error(test.IsSolved(&circuit, &badAssignment, ecc.BN254.ScalarField()))
+}
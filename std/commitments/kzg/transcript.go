@@ -0,0 +1,54 @@
+package kzg
+
+import (
+	"fmt"
+
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/math/emulated"
+)
+
+type transcriptConfig struct {
+	extraBinding []frontend.Variable
+}
+
+// TranscriptOption allows customizing the Fiat-Shamir transcript used for
+// deriving the folding challenge.
+type TranscriptOption func(*transcriptConfig)
+
+// WithExtraBinding absorbs the given native values into the Fiat-Shamir
+// transcript before the folding challenge is squeezed, binding the batch
+// opening to them. A typical use is binding the proof to an epoch number for
+// replay protection: the same proof does not verify under a different epoch.
+//
+// To reproduce the challenge off-circuit, write each value big-endian, padded
+// to the byte length of the scalar field, to the transcript after the proof
+// data.
+func WithExtraBinding(values ...frontend.Variable) TranscriptOption {
+	return func(cfg *transcriptConfig) {
+		cfg.extraBinding = append(cfg.extraBinding, values...)
+	}
+}
+
+// FoldProofWithBinding is as [Verifier.FoldProof], but applies the given
+// transcript options when deriving the folding challenge.
+func (v *Verifier[FR, G1El, G2El, GTEl]) FoldProofWithBinding(digests []Commitment[G1El], batchOpeningProof BatchOpeningProof[FR, G1El], point emulated.Element[FR], opts ...TranscriptOption) (OpeningProof[FR, G1El], Commitment[G1El], error) {
+	var cfg transcriptConfig
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	return v.foldProof(digests, batchOpeningProof, point, cfg.extraBinding, nil)
+}
+
+// BatchVerifySinglePointWithBinding is as [Verifier.BatchVerifySinglePoint],
+// but applies the given transcript options when deriving the folding
+// challenge.
+func (v *Verifier[FR, G1El, G2El, GTEl]) BatchVerifySinglePointWithBinding(digests []Commitment[G1El], batchOpeningProof BatchOpeningProof[FR, G1El], point emulated.Element[FR], vk VerifyingKey[G1El, G2El], opts ...TranscriptOption) error {
+	foldedProof, foldedDigest, err := v.FoldProofWithBinding(digests, batchOpeningProof, point, opts...)
+	if err != nil {
+		return fmt.Errorf("fold proofs: %w", err)
+	}
+	if err := v.CheckOpeningProof(foldedDigest, foldedProof, point, vk); err != nil {
+		return fmt.Errorf("check opening proof: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,102 @@
+package kzg
+
+import (
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	fr_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	kzg_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/kzg"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_bn254"
+	"github.com/consensys/gnark/std/math/emulated"
+	"github.com/consensys/gnark/std/recursion"
+	"github.com/consensys/gnark/test"
+)
+
+type ExtraBindingCircuit[S emulated.FieldParams, G1El, G2El, GTEl any] struct {
+	Vk                VerifyingKey[G1El, G2El]
+	Digests           [3]Commitment[G1El]
+	BatchOpeningProof BatchOpeningProof[S, G1El]
+	Point             emulated.Element[S]
+	Epoch             frontend.Variable
+}
+
+func (c *ExtraBindingCircuit[S, G1El, G2El, GTEl]) Define(api frontend.API) error {
+	verifier, err := NewVerifier[S, G1El, G2El, GTEl](api)
+	if err != nil {
+		return fmt.Errorf("new verifier: %w", err)
+	}
+	if err := verifier.BatchVerifySinglePointWithBinding(c.Digests[:], c.BatchOpeningProof, c.Point, c.Vk, WithExtraBinding(c.Epoch)); err != nil {
+		return fmt.Errorf("batch verify: %w", err)
+	}
+	return nil
+}
+
+func TestBatchVerifyWithExtraBinding(t *testing.T) {
+	assert := test.NewAssert(t)
+
+	alpha, err := rand.Int(rand.Reader, ecc.BN254.ScalarField())
+	assert.NoError(err)
+	srs, err := kzg_bn254.NewSRS(kzgSize, alpha)
+	assert.NoError(err)
+
+	var polynomials [3][]fr_bn254.Element
+	var coms [3]kzg_bn254.Digest
+	for i := range polynomials {
+		polynomials[i] = make([]fr_bn254.Element, polynomialSize)
+		for j := range polynomials[i] {
+			polynomials[i][j].SetRandom()
+		}
+		coms[i], err = kzg_bn254.Commit(polynomials[i], srs.Pk)
+		assert.NoError(err)
+	}
+	var point fr_bn254.Element
+	point.SetRandom()
+
+	var target big.Int
+	target.SetUint64(1)
+	nbBits := ecc.BLS12_381.ScalarField().BitLen()
+	nn := ((nbBits+7)/8)*8 - 8
+	target.Lsh(&target, uint(nn))
+	h, err := recursion.NewShort(ecc.BLS12_381.ScalarField(), &target)
+	assert.NoError(err)
+
+	// the epoch is absorbed after the proof data, big-endian padded to the
+	// scalar field byte length
+	const epoch = 1234
+	epochBytes := big.NewInt(epoch).FillBytes(make([]byte, fr_bn254.Bytes))
+	batchOpeningProof, err := kzg_bn254.BatchOpenSinglePoint(polynomials[:], coms[:], point, h, srs.Pk, epochBytes)
+	assert.NoError(err)
+	assert.NoError(kzg_bn254.BatchVerifySinglePoint(coms[:], &batchOpeningProof, point, h, srs.Vk, epochBytes))
+
+	wVk, err := ValueOfVerifyingKey[sw_bn254.G1Affine, sw_bn254.G2Affine](srs.Vk)
+	assert.NoError(err)
+	wPoint, err := ValueOfScalar[emulated.BN254Fr](point)
+	assert.NoError(err)
+	var wDigests [3]Commitment[sw_bn254.G1Affine]
+	for i := range coms {
+		wDigests[i], err = ValueOfCommitment[sw_bn254.G1Affine](coms[i])
+		assert.NoError(err)
+	}
+	wBatchOpeningProof, err := ValueOfBatchOpeningProof[emulated.BN254Fr, sw_bn254.G1Affine](batchOpeningProof)
+	assert.NoError(err)
+
+	assignment := ExtraBindingCircuit[emulated.BN254Fr, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{
+		Vk:                wVk,
+		Point:             wPoint,
+		Digests:           wDigests,
+		BatchOpeningProof: wBatchOpeningProof,
+		Epoch:             epoch,
+	}
+	var circuit ExtraBindingCircuit[emulated.BN254Fr, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]
+	circuit.BatchOpeningProof.ClaimedValues = make([]emulated.Element[emulated.BN254Fr], 3)
+	assert.NoError(test.IsSolved(&circuit, &assignment, ecc.BLS12_381.ScalarField()))
+
+	// the same proof does not verify under a different epoch
+	badAssignment := assignment
+	badAssignment.Epoch = epoch + 1
+	assert.Error(test.IsSolved(&circuit, &badAssignment, ecc.BLS12_381.ScalarField()))
+}
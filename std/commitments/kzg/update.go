@@ -0,0 +1,51 @@
+package kzg
+
+import (
+	"fmt"
+
+	"github.com/consensys/gnark/std/algebra"
+	"github.com/consensys/gnark/std/math/emulated"
+)
+
+// CommitmentUpdate is a single homomorphic commitment update adding
+// [Delta]·Basis to the commitment. Basis is the basis element of the updated
+// position — the SRS power for a coefficient update, or the committed
+// Lagrange basis polynomial for an evaluation update — and Index records
+// which position it is. The index is informational and not constrained: the
+// caller must supply the basis element actually belonging to it, typically
+// from a [FullSRS] bound to the trusted setup.
+type CommitmentUpdate[FR emulated.FieldParams, G1El algebra.G1ElementT] struct {
+	Index int
+	Delta emulated.Element[FR]
+	Basis G1El
+}
+
+// AssertProofAfterUpdate applies the homomorphic updates to the base
+// commitment in-circuit,
+//
+//	updated = base + Σ [updates[i].Delta]·updates[i].Basis,
+//
+// and verifies the opening proof against the updated commitment, so an
+// incrementally updated commitment is verified without re-sending it. The
+// proof must open the updated polynomial, with the claimed value reflecting
+// the updates.
+func (v *Verifier[FR, G1El, G2El, GTEl]) AssertProofAfterUpdate(baseCommitment Commitment[G1El], updates []CommitmentUpdate[FR, G1El], proof OpeningProof[FR, G1El], point emulated.Element[FR], vk VerifyingKey[G1El, G2El]) error {
+	if len(updates) == 0 {
+		return fmt.Errorf("number of updates should be nonzero")
+	}
+	bases := make([]*G1El, len(updates))
+	deltas := make([]*emulated.Element[FR], len(updates))
+	for i := range updates {
+		bases[i] = &updates[i].Basis
+		deltas[i] = &updates[i].Delta
+	}
+	folded, err := v.curve.MultiScalarMul(bases, deltas)
+	if err != nil {
+		return fmt.Errorf("fold updates: %w", err)
+	}
+	updated := v.curve.Add(&baseCommitment.G1El, folded)
+	if err := v.CheckOpeningProof(Commitment[G1El]{G1El: *updated}, proof, point, vk); err != nil {
+		return fmt.Errorf("check opening proof: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,94 @@
+package kzg
+
+import (
+	"crypto/rand"
+	"fmt"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	fr_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	kzg_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/kzg"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/algebra"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_bn254"
+	"github.com/consensys/gnark/std/math/emulated"
+	"github.com/consensys/gnark/test"
+)
+
+const testNbUpdates = 2
+
+type UpdatedCommitmentCircuit[FR emulated.FieldParams, G1El algebra.G1ElementT, G2El algebra.G2ElementT, GTEl algebra.GtElementT] struct {
+	VerifyingKey[G1El, G2El]
+	BaseCommitment Commitment[G1El]
+	Updates        [testNbUpdates]CommitmentUpdate[FR, G1El]
+	Proof          OpeningProof[FR, G1El]
+	Point          emulated.Element[FR]
+}
+
+func (c *UpdatedCommitmentCircuit[FR, G1El, G2El, GTEl]) Define(api frontend.API) error {
+	verifier, err := NewVerifier[FR, G1El, G2El, GTEl](api)
+	if err != nil {
+		return fmt.Errorf("new verifier: %w", err)
+	}
+	return verifier.AssertProofAfterUpdate(c.BaseCommitment, c.Updates[:], c.Proof, c.Point, c.VerifyingKey)
+}
+
+func TestAssertProofAfterUpdate(t *testing.T) {
+	assert := test.NewAssert(t)
+
+	alpha, err := rand.Int(rand.Reader, ecc.BN254.ScalarField())
+	assert.NoError(err)
+	srs, err := kzg_bn254.NewSRS(kzgSize, alpha)
+	assert.NoError(err)
+
+	f := make([]fr_bn254.Element, polynomialSize)
+	for i := range f {
+		f[i].SetRandom()
+	}
+	com, err := kzg_bn254.Commit(f, srs.Pk)
+	assert.NoError(err)
+
+	// update two coefficients; the basis elements are the SRS powers
+	indices := [testNbUpdates]int{3, 17}
+	var deltas [testNbUpdates]fr_bn254.Element
+	for i, idx := range indices {
+		deltas[i].SetRandom()
+		f[idx].Add(&f[idx], &deltas[i])
+	}
+	var point fr_bn254.Element
+	point.SetRandom()
+	proof, err := kzg_bn254.Open(f, point, srs.Pk)
+	assert.NoError(err)
+
+	assignment := UpdatedCommitmentCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{
+		Point: emulated.ValueOf[sw_bn254.ScalarField](point),
+	}
+	for i, idx := range indices {
+		assignment.Updates[i] = CommitmentUpdate[sw_bn254.ScalarField, sw_bn254.G1Affine]{
+			Index: idx,
+			Delta: emulated.ValueOf[sw_bn254.ScalarField](deltas[i]),
+			Basis: sw_bn254.NewG1Affine(srs.Pk.G1[idx]),
+		}
+	}
+	assignment.VerifyingKey, err = ValueOfVerifyingKey[sw_bn254.G1Affine, sw_bn254.G2Affine](srs.Vk)
+	assert.NoError(err)
+	assignment.BaseCommitment, err = ValueOfCommitment[sw_bn254.G1Affine](com)
+	assert.NoError(err)
+	assignment.Proof, err = ValueOfOpeningProof[sw_bn254.ScalarField, sw_bn254.G1Affine](proof)
+	assert.NoError(err)
+
+	circuit := UpdatedCommitmentCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{}
+
+	assert.Run(func(assert *test.Assert) {
+		assert.NoError(test.IsSolved(&circuit, &assignment, ecc.BN254.ScalarField()))
+	}, "valid")
+
+	assert.Run(func(assert *test.Assert) {
+		// a wrong update delta yields a different updated commitment
+		badAssignment := assignment
+		var other fr_bn254.Element
+		other.SetRandom()
+		badAssignment.Updates[1].Delta = emulated.ValueOf[sw_bn254.ScalarField](other)
+		assert.Error(test.IsSolved(&circuit, &badAssignment, ecc.BN254.ScalarField()))
+	}, "wrong delta")
+}
@@ -0,0 +1,29 @@
+package kzg
+
+import (
+	"fmt"
+
+	"github.com/consensys/gnark/std/math/emulated"
+	"github.com/consensys/gnark/std/math/polynomial"
+)
+
+// AssertProofValueSatisfies verifies the opening proof and asserts that the
+// claimed value is a root of the constraint polynomial g given by its
+// coefficients in ascending degree order, i.e. g(f(a)) = 0. This couples the
+// opening with an algebraic constraint on the revealed value, e.g. restricting
+// it to a fixed set of admissible values via g(X) = Π (X - sᵢ).
+func (v *Verifier[FR, G1El, G2El, GTEl]) AssertProofValueSatisfies(commitment Commitment[G1El], proof OpeningProof[FR, G1El], point emulated.Element[FR], constraint polynomial.Univariate[FR], vk VerifyingKey[G1El, G2El]) error {
+	if len(constraint) == 0 {
+		return fmt.Errorf("empty constraint polynomial")
+	}
+	if err := v.CheckOpeningProof(commitment, proof, point, vk); err != nil {
+		return fmt.Errorf("check opening proof: %w", err)
+	}
+	p, err := polynomial.New[FR](v.api)
+	if err != nil {
+		return fmt.Errorf("new polynomial evaluator: %w", err)
+	}
+	eval := p.EvalUnivariate(constraint, &proof.ClaimedValue)
+	v.scalarApi.AssertIsEqual(eval, v.scalarApi.Zero())
+	return nil
+}
@@ -0,0 +1,94 @@
+package kzg
+
+import (
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	fr_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	kzg_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/kzg"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/algebra"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_bn254"
+	"github.com/consensys/gnark/std/math/emulated"
+	"github.com/consensys/gnark/std/math/polynomial"
+	"github.com/consensys/gnark/test"
+)
+
+type ValueConstraintCircuit[FR emulated.FieldParams, G1El algebra.G1ElementT, G2El algebra.G2ElementT, GTEl algebra.GtElementT] struct {
+	VerifyingKey[G1El, G2El]
+	Commitment[G1El]
+	OpeningProof[FR, G1El]
+	Point      emulated.Element[FR]
+	Constraint polynomial.Univariate[FR]
+}
+
+func (c *ValueConstraintCircuit[FR, G1El, G2El, GTEl]) Define(api frontend.API) error {
+	verifier, err := NewVerifier[FR, G1El, G2El, GTEl](api)
+	if err != nil {
+		return fmt.Errorf("new verifier: %w", err)
+	}
+	return verifier.AssertProofValueSatisfies(c.Commitment, c.OpeningProof, c.Point, c.Constraint, c.VerifyingKey)
+}
+
+func TestAssertProofValueSatisfies(t *testing.T) {
+	assert := test.NewAssert(t)
+
+	alpha, err := rand.Int(rand.Reader, ecc.BN254.ScalarField())
+	assert.NoError(err)
+	srs, err := kzg_bn254.NewSRS(kzgSize, alpha)
+	assert.NoError(err)
+
+	f := make([]fr_bn254.Element, polynomialSize)
+	for i := range f {
+		f[i].SetRandom()
+	}
+	com, err := kzg_bn254.Commit(f, srs.Pk)
+	assert.NoError(err)
+	var point fr_bn254.Element
+	point.SetRandom()
+	proof, err := kzg_bn254.Open(f, point, srs.Pk)
+	assert.NoError(err)
+
+	// constraintFor returns the coefficients of g(X) = (X - r₀)(X - r₁)
+	constraintFor := func(r0, r1 fr_bn254.Element) []*big.Int {
+		var c0, c1 fr_bn254.Element
+		c0.Mul(&r0, &r1)
+		c1.Add(&r0, &r1).Neg(&c1)
+		return []*big.Int{c0.BigInt(new(big.Int)), c1.BigInt(new(big.Int)), big.NewInt(1)}
+	}
+	var other fr_bn254.Element
+	other.SetRandom()
+
+	assignment := ValueConstraintCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{
+		Point:      emulated.ValueOf[sw_bn254.ScalarField](point),
+		Constraint: polynomial.ValueOfUnivariate[sw_bn254.ScalarField](constraintFor(proof.ClaimedValue, other)),
+	}
+	assignment.VerifyingKey, err = ValueOfVerifyingKey[sw_bn254.G1Affine, sw_bn254.G2Affine](srs.Vk)
+	assert.NoError(err)
+	assignment.Commitment, err = ValueOfCommitment[sw_bn254.G1Affine](com)
+	assert.NoError(err)
+	assignment.OpeningProof, err = ValueOfOpeningProof[sw_bn254.ScalarField, sw_bn254.G1Affine](proof)
+	assert.NoError(err)
+
+	circuit := ValueConstraintCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{
+		Constraint: polynomial.PlaceholderUnivariate[sw_bn254.ScalarField](3),
+	}
+
+	assert.Run(func(assert *test.Assert) {
+		// the claimed value is a root of the constraint polynomial
+		assert.NoError(test.IsSolved(&circuit, &assignment, ecc.BN254.ScalarField()))
+	}, "satisfying value")
+
+	assert.Run(func(assert *test.Assert) {
+		// a constraint the claimed value does not satisfy is rejected
+		var r0, r1 fr_bn254.Element
+		r0.SetRandom()
+		r1.SetRandom()
+		badAssignment := assignment
+		badAssignment.Constraint = polynomial.ValueOfUnivariate[sw_bn254.ScalarField](constraintFor(r0, r1))
+		assert.Error(test.IsSolved(&circuit, &badAssignment, ecc.BN254.ScalarField()))
+	}, "non-satisfying value")
+}
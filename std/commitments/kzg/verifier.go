@@ -9,10 +9,23 @@
 // This package supersedes previous type-specific implementations and allows to
 // use any implemented pairing-friendly curve implementation, being defined over
 // a 2-chain (native implementation) or using field emulation.
+//
+// The supported parametrizations are:
+//   - BLS12-377 proofs inside a BW6-761 circuit (native, [sw_bls12377]);
+//   - BLS24-315 proofs inside a BW6-633 circuit (native, [sw_bls24315]);
+//   - BN254, BLS12-381 and BW6-761 proofs inside any circuit using field
+//     emulation ([sw_bn254], [sw_bls12381], [sw_bw6761]).
+//
+// Additionally, BLS12-377 commitments and opening proofs can be loaded into
+// emulated G1 witnesses ([sw_emulated.AffinePoint] over [emparams.BLS12377Fp])
+// for use outside the 2-chain, e.g. for group-operation checks inside a BN254
+// circuit. Full opening-proof verification of this parametrization is not
+// possible yet as it requires an emulated BLS12-377 pairing.
 package kzg
 
 import (
 	"fmt"
+	"slices"
 
 	bls12377 "github.com/consensys/gnark-crypto/ecc/bls12-377"
 	fr_bls12377 "github.com/consensys/gnark-crypto/ecc/bls12-377/fr"
@@ -35,10 +48,13 @@ import (
 	"github.com/consensys/gnark/std/algebra/emulated/sw_bls12381"
 	"github.com/consensys/gnark/std/algebra/emulated/sw_bn254"
 	"github.com/consensys/gnark/std/algebra/emulated/sw_bw6761"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_emulated"
 	"github.com/consensys/gnark/std/algebra/native/sw_bls12377"
 	"github.com/consensys/gnark/std/algebra/native/sw_bls24315"
+	fiatshamir "github.com/consensys/gnark/std/fiat-shamir"
 	"github.com/consensys/gnark/std/math/bits"
 	"github.com/consensys/gnark/std/math/emulated"
+	"github.com/consensys/gnark/std/math/emulated/emparams"
 	"github.com/consensys/gnark/std/recursion"
 )
 
@@ -91,7 +107,10 @@ type Commitment[G1El algebra.G1ElementT] struct {
 
 // ValueOfCommitment initializes a KZG commitment witness from a native
 // commitment. It returns an error if there is a conflict between the type
-// parameters and provided native commitment type.
+// parameters and provided native commitment type. The supported
+// parametrizations are BN254, BLS12-377, BLS24-315, BLS12-381 and BW6-761;
+// the latter two cover EIP-4844-style blob commitments and 2-chain outer
+// circuits respectively.
 func ValueOfCommitment[G1El algebra.G1ElementT](cmt any) (Commitment[G1El], error) {
 	var ret Commitment[G1El]
 	switch s := any(&ret).(type) {
@@ -107,6 +126,15 @@ func ValueOfCommitment[G1El algebra.G1ElementT](cmt any) (Commitment[G1El], erro
 			return ret, fmt.Errorf("mismatching types %T %T", ret, cmt)
 		}
 		s.G1El = sw_bls12377.NewG1Affine(tCmt)
+	case *Commitment[sw_emulated.AffinePoint[emparams.BLS12377Fp]]:
+		tCmt, ok := cmt.(bls12377.G1Affine)
+		if !ok {
+			return ret, fmt.Errorf("mismatching types %T %T", ret, cmt)
+		}
+		s.G1El = sw_emulated.AffinePoint[emparams.BLS12377Fp]{
+			X: emulated.ValueOf[emparams.BLS12377Fp](tCmt.X),
+			Y: emulated.ValueOf[emparams.BLS12377Fp](tCmt.Y),
+		}
 	case *Commitment[sw_bls12381.G1Affine]:
 		tCmt, ok := cmt.(bls12381.G1Affine)
 		if !ok {
@@ -142,8 +170,20 @@ type OpeningProof[FR emulated.FieldParams, G1El algebra.G1ElementT] struct {
 // ValueOfOpeningProof initializes an opening proof from the given proof and
 // point. It returns an error if there is a mismatch between the type parameters
 // and types of the provided point and proof.
-func ValueOfOpeningProof[FR emulated.FieldParams, G1El algebra.G1ElementT](proof any) (OpeningProof[FR, G1El], error) {
+//
+// When the proof comes from a prover using a non-native point serialization,
+// provide it as a [SerializedOpeningProof] together with [WithPointEncoding]
+// describing the prover's format.
+func ValueOfOpeningProof[FR emulated.FieldParams, G1El algebra.G1ElementT](proof any, opts ...WitnessOption) (OpeningProof[FR, G1El], error) {
 	var ret OpeningProof[FR, G1El]
+	cfg, err := newWitnessConfig(opts...)
+	if err != nil {
+		return ret, fmt.Errorf("apply options: %w", err)
+	}
+	proof, err = deserializeOpeningProof(proof, cfg.pointEncoding)
+	if err != nil {
+		return ret, fmt.Errorf("deserialize proof: %w", err)
+	}
 	switch s := any(&ret).(type) {
 	case *OpeningProof[sw_bn254.ScalarField, sw_bn254.G1Affine]:
 		tProof, ok := proof.(kzg_bn254.OpeningProof)
@@ -159,6 +199,16 @@ func ValueOfOpeningProof[FR emulated.FieldParams, G1El algebra.G1ElementT](proof
 		}
 		s.Quotient = sw_bls12377.NewG1Affine(tProof.H)
 		s.ClaimedValue = sw_bls12377.NewScalar(tProof.ClaimedValue)
+	case *OpeningProof[emparams.BLS12377Fr, sw_emulated.AffinePoint[emparams.BLS12377Fp]]:
+		tProof, ok := proof.(kzg_bls12377.OpeningProof)
+		if !ok {
+			return ret, fmt.Errorf("mismatching types %T %T", ret, proof)
+		}
+		s.Quotient = sw_emulated.AffinePoint[emparams.BLS12377Fp]{
+			X: emulated.ValueOf[emparams.BLS12377Fp](tProof.H.X),
+			Y: emulated.ValueOf[emparams.BLS12377Fp](tProof.H.Y),
+		}
+		s.ClaimedValue = sw_bls12377.NewScalar(tProof.ClaimedValue)
 	case *OpeningProof[sw_bls12381.ScalarField, sw_bls12381.G1Affine]:
 		tProof, ok := proof.(kzg_bls12381.OpeningProof)
 		if !ok {
@@ -396,10 +446,34 @@ type Verifier[FR emulated.FieldParams, G1El algebra.G1ElementT, G2El algebra.G2E
 	scalarApi *emulated.Field[FR]
 	curve     algebra.Curve[FR, G1El]
 	pairing   algebra.Pairing[G1El, G2El, GtEl]
+
+	// buffers reused across batch folds; see foldScratch
+	scratch foldScratch[FR, G1El]
+
+	// custom scalar multiplication, nil for the default multi-scalar
+	// multiplication; see [WithScalarMul]
+	scalarMul ScalarMulFunc[FR, G1El]
+
+	// compute the [f(a)]G₁ term with the fixed-base window table of the
+	// generator; see [WithPrecomputedScalarMulBase]
+	precomputedBase bool
+
+	// transcript state shared with other verifiers and the challenge
+	// identifier reserved for this verifier; see [WithSharedTranscript]
+	sharedFS          *fiatshamir.Transcript
+	sharedChallengeID string
+
+	// assert prime-order subgroup membership of the commitment and the
+	// quotient before use; see [WithCommitmentCofactorClear]
+	cofactorClear bool
 }
 
 // NewVerifier initializes a new Verifier instance.
-func NewVerifier[FR emulated.FieldParams, G1El algebra.G1ElementT, G2El algebra.G2ElementT, GtEl algebra.G2ElementT](api frontend.API) (*Verifier[FR, G1El, G2El, GtEl], error) {
+func NewVerifier[FR emulated.FieldParams, G1El algebra.G1ElementT, G2El algebra.G2ElementT, GtEl algebra.G2ElementT](api frontend.API, opts ...VerifierOption[FR, G1El]) (*Verifier[FR, G1El, G2El, GtEl], error) {
+	cfg, err := newVerifierConfig(opts...)
+	if err != nil {
+		return nil, fmt.Errorf("apply options: %w", err)
+	}
 	curve, err := algebra.GetCurve[FR, G1El](api)
 	if err != nil {
 		return nil, err
@@ -412,6 +486,38 @@ func NewVerifier[FR emulated.FieldParams, G1El algebra.G1ElementT, G2El algebra.
 	if err != nil {
 		return nil, err
 	}
+	return &Verifier[FR, G1El, G2El, GtEl]{
+		api:               api,
+		scalarApi:         scalarApi,
+		curve:             curve,
+		pairing:           pairing,
+		scalarMul:         cfg.scalarMul,
+		precomputedBase:   cfg.precomputedBase,
+		sharedFS:          cfg.sharedTranscript,
+		sharedChallengeID: cfg.sharedChallengeID,
+		cofactorClear:     cfg.cofactorClear,
+	}, nil
+}
+
+// NewVerifierWithContext returns a new Verifier reusing the caller-provided
+// emulated field, curve and pairing instances instead of constructing fresh
+// ones. Use it when the enclosing circuit already instantiated these gadgets:
+// it avoids redundant setup and rules out subtle mismatches between two
+// emulated field instances operating on the same witness. The verifier never
+// constructs its own field when given one; all arguments must be non-nil.
+func NewVerifierWithContext[FR emulated.FieldParams, G1El algebra.G1ElementT, G2El algebra.G2ElementT, GtEl algebra.GtElementT](api frontend.API, scalarApi *emulated.Field[FR], curve algebra.Curve[FR, G1El], pairing algebra.Pairing[G1El, G2El, GtEl]) (*Verifier[FR, G1El, G2El, GtEl], error) {
+	if api == nil {
+		return nil, fmt.Errorf("api is nil")
+	}
+	if scalarApi == nil {
+		return nil, fmt.Errorf("scalar field is nil")
+	}
+	if curve == nil {
+		return nil, fmt.Errorf("curve is nil")
+	}
+	if pairing == nil {
+		return nil, fmt.Errorf("pairing is nil")
+	}
 	return &Verifier[FR, G1El, G2El, GtEl]{
 		api:       api,
 		scalarApi: scalarApi,
@@ -420,15 +526,39 @@ func NewVerifier[FR emulated.FieldParams, G1El algebra.G1ElementT, G2El algebra.
 	}, nil
 }
 
-// CheckOpeningProof asserts the validity of the opening proof for the given
-// commitment at point.
-func (v *Verifier[FR, G1El, G2El, GTEl]) CheckOpeningProof(commitment Commitment[G1El], proof OpeningProof[FR, G1El], point emulated.Element[FR], vk VerifyingKey[G1El, G2El]) error {
+// ProofPairingInputs computes the pairing inputs of the opening check for
+// the given commitment at point, without performing the final pairing check.
+// The product of pairings over the returned G₁ and G₂ points equals one
+// exactly when the opening proof is valid. The caller can accumulate the
+// inputs with those of other pairing-based checks and assert them together
+// with a single [algebra.Pairing.PairingCheck], amortizing the final
+// exponentiation. See also [Verifier.CheckOpeningProof] which performs the
+// check directly.
+func (v *Verifier[FR, G1El, G2El, GTEl]) ProofPairingInputs(commitment Commitment[G1El], proof OpeningProof[FR, G1El], point emulated.Element[FR], vk VerifyingKey[G1El, G2El]) ([]*G1El, []*G2El, error) {
+
+	if v.cofactorClear {
+		// see [WithCommitmentCofactorClear]
+		v.pairing.AssertIsOnG1(&commitment.G1El)
+		v.pairing.AssertIsOnG1(&proof.Quotient)
+	}
 
 	// [f(a)]G1 + [-a]([H(α)]G₁) = [f(a) - a*H(α)]G₁
 	pointNeg := v.scalarApi.Neg(&point)
-	totalG1, err := v.curve.MultiScalarMul([]*G1El{&vk.G1, &proof.Quotient}, []*emulated.Element[FR]{&proof.ClaimedValue, pointNeg})
-	if err != nil {
-		return fmt.Errorf("check opening proof: %w", err)
+	var totalG1 *G1El
+	if v.scalarMul != nil {
+		totalG1 = v.curve.Add(v.scalarMul(&vk.G1, &proof.ClaimedValue), v.scalarMul(&proof.Quotient, pointNeg))
+	} else if v.precomputedBase {
+		// the fixed-base window table consists of circuit constants shared
+		// between repeated opening checks; assumes vk.G1 is the canonical
+		// generator, see [WithPrecomputedScalarMulBase]
+		claimedG1 := v.curve.ScalarMulBase(&proof.ClaimedValue)
+		totalG1 = v.curve.Add(claimedG1, v.curve.ScalarMul(&proof.Quotient, pointNeg))
+	} else {
+		var err error
+		totalG1, err = v.curve.MultiScalarMul([]*G1El{&vk.G1, &proof.Quotient}, []*emulated.Element[FR]{&proof.ClaimedValue, pointNeg})
+		if err != nil {
+			return nil, nil, fmt.Errorf("fold G1 part: %w", err)
+		}
 	}
 
 	// [f(a) - a*H(α)]G₁ + [-f(α)]G₁  = [f(a) - f(α) - a*H(α)]G₁
@@ -436,16 +566,31 @@ func (v *Verifier[FR, G1El, G2El, GTEl]) CheckOpeningProof(commitment Commitment
 	totalG1 = v.curve.Add(totalG1, commitmentNeg)
 
 	// e([f(a)-f(α)-a*H(α)]G₁], G₂).e([H(α)]G₁, [α]G₂) == 1
-	if err := v.pairing.PairingCheck(
-		[]*G1El{totalG1, &proof.Quotient},
-		[]*G2El{&vk.G2[0], &vk.G2[1]},
-	); err != nil {
+	return []*G1El{totalG1, &proof.Quotient}, []*G2El{&vk.G2[0], &vk.G2[1]}, nil
+}
+
+// CheckOpeningProof asserts the validity of the opening proof for the given
+// commitment at point.
+func (v *Verifier[FR, G1El, G2El, GTEl]) CheckOpeningProof(commitment Commitment[G1El], proof OpeningProof[FR, G1El], point emulated.Element[FR], vk VerifyingKey[G1El, G2El]) error {
+	g1Points, g2Points, err := v.ProofPairingInputs(commitment, proof, point, vk)
+	if err != nil {
+		return fmt.Errorf("check opening proof: %w", err)
+	}
+	eq := algebra.NewEquation(v.curve, v.pairing)
+	for i := range g1Points {
+		eq.AddPairing(g1Points[i], g2Points[i])
+	}
+	if err := eq.AssertEqualsOne(); err != nil {
 		return fmt.Errorf("pairing check: %w", err)
 	}
 	return nil
 }
 
-// BatchVerifySinglePoint verifies multiple opening proofs at a single point.
+// BatchVerifySinglePoint verifies multiple opening proofs at a single point
+// with a single pairing check, mirroring gnark-crypto's native
+// BatchVerifySinglePoint: the commitments and claimed values are folded with
+// [Verifier.FoldProof] and the folded opening is checked with
+// [Verifier.CheckOpeningProof].
 func (v *Verifier[FR, G1El, G2El, GTEl]) BatchVerifySinglePoint(digests []Commitment[G1El], batchOpeningProof BatchOpeningProof[FR, G1El], point emulated.Element[FR], vk VerifyingKey[G1El, G2El], dataTranscript ...emulated.Element[FR]) error {
 	// fold the proof
 	foldedProof, foldedDigest, err := v.FoldProof(digests, batchOpeningProof, point, dataTranscript...)
@@ -481,7 +626,7 @@ func (v *Verifier[FR, G1El, G2El, GTEl]) FoldProofsMultiPoint(digests []Commitme
 	}
 
 	// sample random numbers λᵢ for sampling
-	randomNumbers := make([]*emulated.Element[FR], len(digests))
+	randomNumbers := v.scratch.borrowScalars(0, len(digests))
 	randomNumbers[0] = v.scalarApi.One()
 	whSnark, err := recursion.NewHash(v.api, fr.Modulus(), true)
 	if err != nil {
@@ -510,7 +655,14 @@ func (v *Verifier[FR, G1El, G2El, GTEl]) FoldProofsMultiPoint(digests []Commitme
 		// https://github.com/Consensys/gnark/issues/1108
 		randomNumbers[i] = v.scalarApi.Mul(randomNumbers[1], randomNumbers[i-1])
 	}
-	randomPointNumbers := make([]*emulated.Element[FR], len(randomNumbers))
+	return v.foldMultiPoint(digests, proofs, points, randomNumbers, vk)
+}
+
+// foldMultiPoint folds the digests, quotients and claimed values with the
+// powers λᵢ of the folding coefficient. It implements the body of
+// [Verifier.FoldProofsMultiPoint] once the coefficients are derived.
+func (v *Verifier[FR, G1El, G2El, GTEl]) foldMultiPoint(digests []Commitment[G1El], proofs []OpeningProof[FR, G1El], points []emulated.Element[FR], randomNumbers []*emulated.Element[FR], vk VerifyingKey[G1El, G2El]) (*G1El, *G1El, error) {
+	randomPointNumbers := v.scratch.borrowScalars(1, len(randomNumbers))
 	randomPointNumbers[0] = &points[0]
 	for i := 1; i < len(randomPointNumbers); i++ {
 		randomPointNumbers[i] = v.scalarApi.Mul(randomNumbers[i], &points[i])
@@ -518,7 +670,7 @@ func (v *Verifier[FR, G1El, G2El, GTEl]) FoldProofsMultiPoint(digests []Commitme
 
 	// fold the committed quotients compute ∑ᵢλᵢ[Hᵢ(α)]G₁ and
 	// ∑ᵢλᵢ[p_i]([Hᵢ(α)]G₁)
-	quotients := make([]*G1El, len(proofs))
+	quotients := v.scratch.borrowPoints(0, len(proofs))
 	for i := 0; i < len(randomNumbers); i++ {
 		quotients[i] = &proofs[i].Quotient
 	}
@@ -565,7 +717,13 @@ func (v *Verifier[FR, G1El, G2El, GTEl]) FoldProofsMultiPoint(digests []Commitme
 	return foldedDigest, foldedQuotients, nil
 }
 
-// BatchVerifyMultiPoints verifies multiple opening proofs at different points.
+// BatchVerifyMultiPoints verifies multiple opening proofs at different points
+// with two pairings total: the digests, quotients and claimed values are
+// folded with powers of an in-circuit challenge derived from all the inputs
+// (see [Verifier.FoldProofsMultiPoint]) and the folded terms go through a
+// single pairing check. This matches the final step of PLONK-style
+// verification where the linearised polynomial and the grand product are
+// opened at different points.
 func (v *Verifier[FR, G1El, G2El, GTEl]) BatchVerifyMultiPoints(digests []Commitment[G1El], proofs []OpeningProof[FR, G1El], points []emulated.Element[FR], vk VerifyingKey[G1El, G2El]) error {
 
 	// if only one proof go to base case
@@ -593,6 +751,10 @@ func (v *Verifier[FR, G1El, G2El, GTEl]) BatchVerifyMultiPoints(digests []Commit
 
 // FoldProof folds multiple commitments and a batch opening proof for a single opening check.
 func (v *Verifier[FR, G1El, G2El, GTEl]) FoldProof(digests []Commitment[G1El], batchOpeningProof BatchOpeningProof[FR, G1El], point emulated.Element[FR], dataTranscript ...emulated.Element[FR]) (OpeningProof[FR, G1El], Commitment[G1El], error) {
+	return v.foldProof(digests, batchOpeningProof, point, nil, dataTranscript)
+}
+
+func (v *Verifier[FR, G1El, G2El, GTEl]) foldProof(digests []Commitment[G1El], batchOpeningProof BatchOpeningProof[FR, G1El], point emulated.Element[FR], extraBinding []frontend.Variable, dataTranscript []emulated.Element[FR]) (OpeningProof[FR, G1El], Commitment[G1El], error) {
 	var retP OpeningProof[FR, G1El]
 	var retC Commitment[G1El]
 	// we assume the short hash output size is full byte fitting into the modulus length.
@@ -604,7 +766,7 @@ func (v *Verifier[FR, G1El, G2El, GTEl]) FoldProof(digests []Commitment[G1El], b
 	}
 
 	// derive the challenge γ, binded to the point and the commitments
-	gamma, err := v.deriveGamma(point, digests, batchOpeningProof.ClaimedValues, dataTranscript...)
+	gamma, err := v.deriveGamma(point, digests, batchOpeningProof.ClaimedValues, extraBinding, dataTranscript...)
 	if err != nil {
 		return retP, retC, fmt.Errorf("derive gamma: %w", err)
 	}
@@ -644,8 +806,9 @@ func (v *Verifier[FR, G1El, G2El, GTEl]) FoldProof(digests []Commitment[G1El], b
 }
 
 // deriveGamma derives a challenge using Fiat Shamir to fold proofs.
-// dataTranscript are supposed to be bits.
-func (v *Verifier[FR, G1El, G2El, GTEl]) deriveGamma(point emulated.Element[FR], digests []Commitment[G1El], claimedValues []emulated.Element[FR], dataTranscript ...emulated.Element[FR]) (*emulated.Element[FR], error) {
+// dataTranscript are supposed to be bits. extraBinding are native variables
+// absorbed big-endian before squeezing the challenge, see [WithExtraBinding].
+func (v *Verifier[FR, G1El, G2El, GTEl]) deriveGamma(point emulated.Element[FR], digests []Commitment[G1El], claimedValues []emulated.Element[FR], extraBinding []frontend.Variable, dataTranscript ...emulated.Element[FR]) (*emulated.Element[FR], error) {
 	var fr FR
 	fs, err := recursion.NewTranscript(v.api, fr.Modulus(), []string{"gamma"})
 	if err != nil {
@@ -672,6 +835,14 @@ func (v *Verifier[FR, G1El, G2El, GTEl]) deriveGamma(point emulated.Element[FR],
 		}
 	}
 
+	for i := range extraBinding {
+		bts := bits.ToBinary(v.api, extraBinding[i], bits.WithNbDigits(((fr.Modulus().BitLen()+7)/8)*8))
+		slices.Reverse(bts)
+		if err := fs.Bind("gamma", bts); err != nil {
+			return nil, fmt.Errorf("bind %d-th extra value: %w", i, err)
+		}
+	}
+
 	gamma, err := fs.ComputeChallenge("gamma")
 	if err != nil {
 		return nil, fmt.Errorf("compute challenge: %w", err)
@@ -695,7 +866,7 @@ func (v *Verifier[FR, G1El, G2El, GTEl]) fold(digests []Commitment[G1El], fai []
 	}
 
 	// fold the digests ∑ᵢ[cᵢ]([fᵢ(α)]G₁)
-	digestPoints := make([]*G1El, len(digests))
+	digestPoints := v.scratch.borrowPoints(1, len(digests))
 	for i := range digestPoints {
 		digestPoints[i] = &digests[i].G1El
 	}
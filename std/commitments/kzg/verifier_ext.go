@@ -0,0 +1,121 @@
+package kzg
+
+import (
+	"fmt"
+
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/math/bits"
+	"github.com/consensys/gnark/std/math/emulated"
+)
+
+// CheckOpeningProofWithRange asserts the validity of the opening proof for the
+// given commitment at point and additionally asserts that the claimed value is
+// strictly less than 2^bits. The claimed value is decomposed canonically so
+// that the range check is sound even if the emulated scalar is given in
+// non-unique representation.
+//
+// This is useful when the opened value has a semantic range (e.g. a u64
+// balance) and the range check must be bound to the same value the opening is
+// verified against.
+func (v *Verifier[FR, G1El, G2El, GTEl]) CheckOpeningProofWithRange(commitment Commitment[G1El], proof OpeningProof[FR, G1El], point emulated.Element[FR], vk VerifyingKey[G1El, G2El], bits int) error {
+	var fr FR
+	if bits <= 0 || bits >= fr.Modulus().BitLen() {
+		return fmt.Errorf("range width %d out of bounds for scalar field", bits)
+	}
+	// canonical decomposition ensures the bits represent the unique reduced
+	// value of the claimed value.
+	bts := v.scalarApi.ToBitsCanonical(&proof.ClaimedValue)
+	for i := bits; i < len(bts); i++ {
+		v.api.AssertIsEqual(bts[i], 0)
+	}
+	return v.CheckOpeningProof(commitment, proof, point, vk)
+}
+
+// AssertSRSSubgroup asserts that both G2 points of the verifying key are in
+// the correct prime-order subgroup. When the verifying key is an untrusted
+// witness this check is required for soundness on curves where G2 has a
+// cofactor (BN254, BLS12-381 and the other emulated curves); for verifying
+// keys embedded as circuit constants via [ValueOfVerifyingKeyFixed] it can be
+// skipped. The check is expensive, so call it once after constructing the
+// verifier rather than per opening proof.
+func (v *Verifier[FR, G1El, G2El, GTEl]) AssertSRSSubgroup(vk VerifyingKey[G1El, G2El]) {
+	v.pairing.AssertIsOnG2(&vk.G2[0])
+	v.pairing.AssertIsOnG2(&vk.G2[1])
+}
+
+// AssertProofPublicPointPrivateValue verifies the opening proof at a point
+// given as a native variable, typically a public input such as a timestamp
+// index, while the claimed value stays a private witness. The native point is
+// converted to an emulated scalar before checking the opening, so the proof
+// is bound to the public point.
+func (v *Verifier[FR, G1El, G2El, GTEl]) AssertProofPublicPointPrivateValue(commitment Commitment[G1El], proof OpeningProof[FR, G1El], publicPoint frontend.Variable, vk VerifyingKey[G1El, G2El]) error {
+	var fr FR
+	bts := bits.ToBinary(v.api, publicPoint, bits.WithNbDigits(fr.Modulus().BitLen()))
+	point := v.scalarApi.FromBits(bts...)
+	if err := v.CheckOpeningProof(commitment, proof, *point, vk); err != nil {
+		return fmt.Errorf("check opening proof: %w", err)
+	}
+	return nil
+}
+
+// AssertBatchProofWithCoeff verifies multiple opening proofs at different
+// points, folding them with the powers of the caller-supplied coefficient
+// instead of a transcript-derived one.
+//
+// It is intended for soundness testing only: injecting chosen coefficients
+// allows checking that a tampered proof fails for all but negligibly many
+// folding coefficients. It MUST NOT be used in production — there the
+// coefficient has to be derived from a transcript binding all commitments,
+// proofs and points, as done in [Verifier.BatchVerifyMultiPoints].
+func (v *Verifier[FR, G1El, G2El, GTEl]) AssertBatchProofWithCoeff(digests []Commitment[G1El], proofs []OpeningProof[FR, G1El], points []emulated.Element[FR], coeff emulated.Element[FR], vk VerifyingKey[G1El, G2El]) error {
+	if len(digests) != len(proofs) {
+		return fmt.Errorf("number of commitments doesn't match number of proofs")
+	}
+	if len(digests) != len(points) {
+		return fmt.Errorf("number of commitments doesn't match number of points")
+	}
+	if len(digests) == 0 {
+		return fmt.Errorf("number of digests should be nonzero")
+	}
+	// powers of the supplied coefficient [1, c, c², ...]
+	randomNumbers := make([]*emulated.Element[FR], len(digests))
+	randomNumbers[0] = v.scalarApi.One()
+	if len(digests) > 1 {
+		randomNumbers[1] = &coeff
+	}
+	for i := 2; i < len(randomNumbers); i++ {
+		randomNumbers[i] = v.scalarApi.Mul(randomNumbers[1], randomNumbers[i-1])
+	}
+	foldedDigest, foldedQuotients, err := v.foldMultiPoint(digests, proofs, points, randomNumbers, vk)
+	if err != nil {
+		return fmt.Errorf("fold multi point: %w", err)
+	}
+	if err := v.pairing.PairingCheck(
+		[]*G1El{foldedDigest, foldedQuotients},
+		[]*G2El{&vk.G2[0], &vk.G2[1]},
+	); err != nil {
+		return fmt.Errorf("pairing check: %w", err)
+	}
+	return nil
+}
+
+// SelectProof returns the real proof if sel is 1 and the dummy proof if sel is
+// 0, without branching. This allows fixed-shape batches where the number of
+// active proofs varies at runtime: disabled slots carry an inert dummy that
+// can still be folded (e.g. with coefficient zero). sel must be boolean
+// constrained.
+func (v *Verifier[FR, G1El, G2El, GTEl]) SelectProof(sel frontend.Variable, real, dummy OpeningProof[FR, G1El]) OpeningProof[FR, G1El] {
+	return OpeningProof[FR, G1El]{
+		Quotient:     *v.curve.Select(sel, &real.Quotient, &dummy.Quotient),
+		ClaimedValue: *v.scalarApi.Select(sel, &real.ClaimedValue, &dummy.ClaimedValue),
+	}
+}
+
+// SelectCommitment returns the real commitment if sel is 1 and the dummy
+// commitment if sel is 0, without branching. See [Verifier.SelectProof]. sel
+// must be boolean constrained.
+func (v *Verifier[FR, G1El, G2El, GTEl]) SelectCommitment(sel frontend.Variable, real, dummy Commitment[G1El]) Commitment[G1El] {
+	return Commitment[G1El]{
+		G1El: *v.curve.Select(sel, &real.G1El, &dummy.G1El),
+	}
+}
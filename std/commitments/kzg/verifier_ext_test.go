@@ -0,0 +1,475 @@
+package kzg
+
+import (
+	"crypto/rand"
+	"fmt"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	fr_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	kzg_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/kzg"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/algebra"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_bn254"
+	"github.com/consensys/gnark/std/math/emulated"
+	"github.com/consensys/gnark/test"
+)
+
+// evalPolynomial evaluates the polynomial given by its coefficients at point.
+func evalPolynomial(f []fr_bn254.Element, point fr_bn254.Element) fr_bn254.Element {
+	var res fr_bn254.Element
+	for i := len(f) - 1; i >= 0; i-- {
+		res.Mul(&res, &point).Add(&res, &f[i])
+	}
+	return res
+}
+
+type KZGVerificationWithRangeCircuit[FR emulated.FieldParams, G1El algebra.G1ElementT, G2El algebra.G2ElementT, GTEl algebra.GtElementT] struct {
+	VerifyingKey[G1El, G2El]
+	Commitment[G1El]
+	OpeningProof[FR, G1El]
+	Point emulated.Element[FR]
+	bits  int
+}
+
+func (c *KZGVerificationWithRangeCircuit[FR, G1El, G2El, GTEl]) Define(api frontend.API) error {
+	verifier, err := NewVerifier[FR, G1El, G2El, GTEl](api)
+	if err != nil {
+		return fmt.Errorf("new verifier: %w", err)
+	}
+	if err := verifier.CheckOpeningProofWithRange(c.Commitment, c.OpeningProof, c.Point, c.VerifyingKey, c.bits); err != nil {
+		return fmt.Errorf("assert proof with range: %w", err)
+	}
+	return nil
+}
+
+func TestKZGVerificationWithRange(t *testing.T) {
+	assert := test.NewAssert(t)
+
+	alpha, err := rand.Int(rand.Reader, ecc.BN254.ScalarField())
+	assert.NoError(err)
+	srs, err := kzg_bn254.NewSRS(kzgSize, alpha)
+	assert.NoError(err)
+
+	// adjust the constant term of a random polynomial so that its evaluation
+	// at point fits in the range.
+	var point fr_bn254.Element
+	point.SetRandom()
+	f := make([]fr_bn254.Element, polynomialSize)
+	for i := range f {
+		f[i].SetRandom()
+	}
+	eval := evalPolynomial(f, point)
+	var inRange fr_bn254.Element
+	inRange.SetUint64(0xdeadbeef)
+	f[0].Sub(&f[0], &eval).Add(&f[0], &inRange)
+
+	com, err := kzg_bn254.Commit(f, srs.Pk)
+	assert.NoError(err)
+
+	proof, err := kzg_bn254.Open(f, point, srs.Pk)
+	assert.NoError(err)
+
+	wCmt, err := ValueOfCommitment[sw_bn254.G1Affine](com)
+	assert.NoError(err)
+	wProof, err := ValueOfOpeningProof[sw_bn254.ScalarField, sw_bn254.G1Affine](proof)
+	assert.NoError(err)
+	wVk, err := ValueOfVerifyingKey[sw_bn254.G1Affine, sw_bn254.G2Affine](srs.Vk)
+	assert.NoError(err)
+	wPt, err := ValueOfScalar[sw_bn254.ScalarField](point)
+	assert.NoError(err)
+
+	assignment := KZGVerificationWithRangeCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{
+		VerifyingKey: wVk,
+		Commitment:   wCmt,
+		OpeningProof: wProof,
+		Point:        wPt,
+	}
+
+	// an opening of a random polynomial is out of range with overwhelming
+	// probability.
+	fBig := make([]fr_bn254.Element, polynomialSize)
+	for i := range fBig {
+		fBig[i].SetRandom()
+	}
+	comBig, err := kzg_bn254.Commit(fBig, srs.Pk)
+	assert.NoError(err)
+	proofBig, err := kzg_bn254.Open(fBig, point, srs.Pk)
+	assert.NoError(err)
+	wCmtBig, err := ValueOfCommitment[sw_bn254.G1Affine](comBig)
+	assert.NoError(err)
+	wProofBig, err := ValueOfOpeningProof[sw_bn254.ScalarField, sw_bn254.G1Affine](proofBig)
+	assert.NoError(err)
+	invalidAssignment := KZGVerificationWithRangeCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{
+		VerifyingKey: wVk,
+		Commitment:   wCmtBig,
+		OpeningProof: wProofBig,
+		Point:        wPt,
+	}
+
+	circuit := KZGVerificationWithRangeCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{bits: 64}
+	assert.CheckCircuit(&circuit,
+		test.WithValidAssignment(&assignment),
+		test.WithInvalidAssignment(&invalidAssignment),
+		test.WithCurves(ecc.BN254),
+	)
+}
+
+type SRSSubgroupCircuit[FR emulated.FieldParams, G1El algebra.G1ElementT, G2El algebra.G2ElementT, GTEl algebra.GtElementT] struct {
+	VerifyingKey[G1El, G2El]
+}
+
+func (c *SRSSubgroupCircuit[FR, G1El, G2El, GTEl]) Define(api frontend.API) error {
+	verifier, err := NewVerifier[FR, G1El, G2El, GTEl](api)
+	if err != nil {
+		return fmt.Errorf("new verifier: %w", err)
+	}
+	verifier.AssertSRSSubgroup(c.VerifyingKey)
+	return nil
+}
+
+func TestAssertSRSSubgroup(t *testing.T) {
+	assert := test.NewAssert(t)
+
+	alpha, err := rand.Int(rand.Reader, ecc.BN254.ScalarField())
+	assert.NoError(err)
+	srs, err := kzg_bn254.NewSRS(kzgSize, alpha)
+	assert.NoError(err)
+
+	wVk, err := ValueOfVerifyingKey[sw_bn254.G1Affine, sw_bn254.G2Affine](srs.Vk)
+	assert.NoError(err)
+	assignment := SRSSubgroupCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{
+		VerifyingKey: wVk,
+	}
+	err = test.IsSolved(&SRSSubgroupCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{}, &assignment, ecc.BN254.ScalarField())
+	assert.NoError(err)
+
+	// a point on the twist but outside the prime-order subgroup must be
+	// rejected
+	badVk := srs.Vk
+	_, err = badVk.G2[1].X.A0.SetString("0x07192b9fd0e2a32e3e1caa8e59462b757326d48f641924e6a1d00d66478913eb")
+	assert.NoError(err)
+	_, err = badVk.G2[1].X.A1.SetString("0x15ce93f1b1c4946dd6cfbb3d287d9c9a1cdedb264bda7aada0844416d8a47a63")
+	assert.NoError(err)
+	_, err = badVk.G2[1].Y.A0.SetString("0x0fa65a9b48ba018361ed081e3b9e958451de5d9e8ae0bd251833ebb4b2fafc96")
+	assert.NoError(err)
+	_, err = badVk.G2[1].Y.A1.SetString("0x06e1f5e20f68f6dfa8a91a3bea048df66d9eaf56cc7f11215401f7e05027e0c6")
+	assert.NoError(err)
+	assert.True(badVk.G2[1].IsOnCurve())
+	assert.False(badVk.G2[1].IsInSubGroup())
+
+	wBadVk, err := ValueOfVerifyingKey[sw_bn254.G1Affine, sw_bn254.G2Affine](badVk)
+	assert.NoError(err)
+	badAssignment := SRSSubgroupCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{
+		VerifyingKey: wBadVk,
+	}
+	err = test.IsSolved(&SRSSubgroupCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{}, &badAssignment, ecc.BN254.ScalarField())
+	assert.Error(err)
+}
+
+type SelectProofCircuit[FR emulated.FieldParams, G1El algebra.G1ElementT, G2El algebra.G2ElementT, GTEl algebra.GtElementT] struct {
+	VerifyingKey[G1El, G2El]
+	Sel             frontend.Variable
+	RealCommitment  Commitment[G1El]
+	DummyCommitment Commitment[G1El]
+	RealProof       OpeningProof[FR, G1El]
+	DummyProof      OpeningProof[FR, G1El]
+	Point           emulated.Element[FR]
+}
+
+func (c *SelectProofCircuit[FR, G1El, G2El, GTEl]) Define(api frontend.API) error {
+	verifier, err := NewVerifier[FR, G1El, G2El, GTEl](api)
+	if err != nil {
+		return fmt.Errorf("new verifier: %w", err)
+	}
+	api.AssertIsBoolean(c.Sel)
+	proof := verifier.SelectProof(c.Sel, c.RealProof, c.DummyProof)
+	commitment := verifier.SelectCommitment(c.Sel, c.RealCommitment, c.DummyCommitment)
+	if err := verifier.CheckOpeningProof(commitment, proof, c.Point, c.VerifyingKey); err != nil {
+		return fmt.Errorf("check opening proof: %w", err)
+	}
+	return nil
+}
+
+func TestSelectProof(t *testing.T) {
+	assert := test.NewAssert(t)
+
+	alpha, err := rand.Int(rand.Reader, ecc.BN254.ScalarField())
+	assert.NoError(err)
+	srs, err := kzg_bn254.NewSRS(kzgSize, alpha)
+	assert.NoError(err)
+
+	var point fr_bn254.Element
+	point.SetRandom()
+
+	// two independent valid openings; per assignment one slot is selected and
+	// the other is tampered with to show the disabled slot does not influence
+	// the verification.
+	openings := make([]struct {
+		com   kzg_bn254.Digest
+		proof kzg_bn254.OpeningProof
+	}, 2)
+	for i := range openings {
+		f := make([]fr_bn254.Element, polynomialSize)
+		for j := range f {
+			f[j].SetRandom()
+		}
+		openings[i].com, err = kzg_bn254.Commit(f, srs.Pk)
+		assert.NoError(err)
+		openings[i].proof, err = kzg_bn254.Open(f, point, srs.Pk)
+		assert.NoError(err)
+	}
+	// tamper the claimed value of the second opening
+	openings[1].proof.ClaimedValue.SetRandom()
+
+	wVk, err := ValueOfVerifyingKey[sw_bn254.G1Affine, sw_bn254.G2Affine](srs.Vk)
+	assert.NoError(err)
+	wPt, err := ValueOfScalar[sw_bn254.ScalarField](point)
+	assert.NoError(err)
+	wComReal, err := ValueOfCommitment[sw_bn254.G1Affine](openings[0].com)
+	assert.NoError(err)
+	wComDummy, err := ValueOfCommitment[sw_bn254.G1Affine](openings[1].com)
+	assert.NoError(err)
+	wProofReal, err := ValueOfOpeningProof[sw_bn254.ScalarField, sw_bn254.G1Affine](openings[0].proof)
+	assert.NoError(err)
+	wProofDummy, err := ValueOfOpeningProof[sw_bn254.ScalarField, sw_bn254.G1Affine](openings[1].proof)
+	assert.NoError(err)
+
+	assignment := SelectProofCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{
+		VerifyingKey:    wVk,
+		Sel:             1,
+		RealCommitment:  wComReal,
+		DummyCommitment: wComDummy,
+		RealProof:       wProofReal,
+		DummyProof:      wProofDummy,
+		Point:           wPt,
+	}
+	invalidAssignment := SelectProofCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{
+		VerifyingKey:    wVk,
+		Sel:             0,
+		RealCommitment:  wComReal,
+		DummyCommitment: wComDummy,
+		RealProof:       wProofReal,
+		DummyProof:      wProofDummy,
+		Point:           wPt,
+	}
+	assert.CheckCircuit(&SelectProofCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{},
+		test.WithValidAssignment(&assignment),
+		test.WithInvalidAssignment(&invalidAssignment),
+		test.WithCurves(ecc.BN254),
+	)
+}
+
+type BatchWithCoeffCircuit[FR emulated.FieldParams, G1El algebra.G1ElementT, G2El algebra.G2ElementT, GTEl algebra.GtElementT] struct {
+	Vk          VerifyingKey[G1El, G2El]
+	Commitments [2]Commitment[G1El]
+	Proofs      [2]OpeningProof[FR, G1El]
+	Points      [2]emulated.Element[FR]
+	Coeff       emulated.Element[FR]
+}
+
+func (c *BatchWithCoeffCircuit[FR, G1El, G2El, GTEl]) Define(api frontend.API) error {
+	verifier, err := NewVerifier[FR, G1El, G2El, GTEl](api)
+	if err != nil {
+		return fmt.Errorf("new verifier: %w", err)
+	}
+	if err := verifier.AssertBatchProofWithCoeff(c.Commitments[:], c.Proofs[:], c.Points[:], c.Coeff, c.Vk); err != nil {
+		return fmt.Errorf("assert batch proof: %w", err)
+	}
+	return nil
+}
+
+func TestAssertBatchProofWithCoeff(t *testing.T) {
+	assert := test.NewAssert(t)
+
+	alpha, err := rand.Int(rand.Reader, ecc.BN254.ScalarField())
+	assert.NoError(err)
+	srs, err := kzg_bn254.NewSRS(kzgSize, alpha)
+	assert.NoError(err)
+
+	var coms [2]kzg_bn254.Digest
+	var proofs [2]kzg_bn254.OpeningProof
+	var points [2]fr_bn254.Element
+	for i := range coms {
+		f := make([]fr_bn254.Element, polynomialSize)
+		for j := range f {
+			f[j].SetRandom()
+		}
+		coms[i], err = kzg_bn254.Commit(f, srs.Pk)
+		assert.NoError(err)
+		points[i].SetRandom()
+		proofs[i], err = kzg_bn254.Open(f, points[i], srs.Pk)
+		assert.NoError(err)
+	}
+
+	wVk, err := ValueOfVerifyingKey[sw_bn254.G1Affine, sw_bn254.G2Affine](srs.Vk)
+	assert.NoError(err)
+	assignment := BatchWithCoeffCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{Vk: wVk}
+	for i := range coms {
+		assignment.Commitments[i], err = ValueOfCommitment[sw_bn254.G1Affine](coms[i])
+		assert.NoError(err)
+		assignment.Proofs[i], err = ValueOfOpeningProof[sw_bn254.ScalarField, sw_bn254.G1Affine](proofs[i])
+		assert.NoError(err)
+		assignment.Points[i], err = ValueOfScalar[sw_bn254.ScalarField](points[i])
+		assert.NoError(err)
+	}
+
+	circuit := BatchWithCoeffCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{}
+
+	// valid proofs verify under an arbitrary folding coefficient
+	var coeff fr_bn254.Element
+	coeff.SetRandom()
+	assignment.Coeff, err = ValueOfScalar[sw_bn254.ScalarField](coeff)
+	assert.NoError(err)
+	assert.NoError(test.IsSolved(&circuit, &assignment, ecc.BN254.ScalarField()))
+
+	// tamper with one claimed value: the batch must fail for every sampled
+	// coefficient (soundness holds for all but negligibly many)
+	tampered := assignment
+	var badValue fr_bn254.Element
+	badValue.SetOne()
+	badValue.Add(&badValue, &proofs[1].ClaimedValue)
+	tampered.Proofs[1].ClaimedValue, err = ValueOfScalar[sw_bn254.ScalarField](badValue)
+	assert.NoError(err)
+	for i := 0; i < 3; i++ {
+		coeff.SetRandom()
+		tampered.Coeff, err = ValueOfScalar[sw_bn254.ScalarField](coeff)
+		assert.NoError(err)
+		assert.Error(test.IsSolved(&circuit, &tampered, ecc.BN254.ScalarField()))
+	}
+}
+
+type PublicPointCircuit[FR emulated.FieldParams, G1El algebra.G1ElementT, G2El algebra.G2ElementT, GTEl algebra.GtElementT] struct {
+	Vk          VerifyingKey[G1El, G2El]
+	Commitment  Commitment[G1El]
+	Proof       OpeningProof[FR, G1El]
+	PublicPoint frontend.Variable `gnark:",public"`
+}
+
+func (c *PublicPointCircuit[FR, G1El, G2El, GTEl]) Define(api frontend.API) error {
+	verifier, err := NewVerifier[FR, G1El, G2El, GTEl](api)
+	if err != nil {
+		return fmt.Errorf("new verifier: %w", err)
+	}
+	if err := verifier.AssertProofPublicPointPrivateValue(c.Commitment, c.Proof, c.PublicPoint, c.Vk); err != nil {
+		return fmt.Errorf("assert proof: %w", err)
+	}
+	return nil
+}
+
+func TestProofPublicPointPrivateValue(t *testing.T) {
+	assert := test.NewAssert(t)
+
+	alpha, err := rand.Int(rand.Reader, ecc.BN254.ScalarField())
+	assert.NoError(err)
+	srs, err := kzg_bn254.NewSRS(kzgSize, alpha)
+	assert.NoError(err)
+
+	f := make([]fr_bn254.Element, polynomialSize)
+	for i := range f {
+		f[i].SetRandom()
+	}
+	com, err := kzg_bn254.Commit(f, srs.Pk)
+	assert.NoError(err)
+
+	// the query point is a small public index
+	var point fr_bn254.Element
+	point.SetUint64(1234)
+	proof, err := kzg_bn254.Open(f, point, srs.Pk)
+	assert.NoError(err)
+
+	wVk, err := ValueOfVerifyingKey[sw_bn254.G1Affine, sw_bn254.G2Affine](srs.Vk)
+	assert.NoError(err)
+	wCom, err := ValueOfCommitment[sw_bn254.G1Affine](com)
+	assert.NoError(err)
+	wProof, err := ValueOfOpeningProof[sw_bn254.ScalarField, sw_bn254.G1Affine](proof)
+	assert.NoError(err)
+
+	circuit := PublicPointCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{}
+	assignment := PublicPointCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{
+		Vk:          wVk,
+		Commitment:  wCom,
+		Proof:       wProof,
+		PublicPoint: 1234,
+	}
+	assert.NoError(test.IsSolved(&circuit, &assignment, ecc.BN254.ScalarField()))
+
+	// the proof does not verify at a different public point
+	badAssignment := assignment
+	badAssignment.PublicPoint = 1235
+	assert.Error(test.IsSolved(&circuit, &badAssignment, ecc.BN254.ScalarField()))
+}
+
+type ContextVerifierCircuit[FR emulated.FieldParams, G1El algebra.G1ElementT, G2El algebra.G2ElementT, GTEl algebra.GtElementT] struct {
+	VerifyingKey[G1El, G2El]
+	Commitment[G1El]
+	OpeningProof[FR, G1El]
+	Point emulated.Element[FR]
+}
+
+func (c *ContextVerifierCircuit[FR, G1El, G2El, GTEl]) Define(api frontend.API) error {
+	// the enclosing circuit already has the gadgets instantiated
+	scalarApi, err := emulated.NewField[FR](api)
+	if err != nil {
+		return fmt.Errorf("new scalar field: %w", err)
+	}
+	curve, err := algebra.GetCurve[FR, G1El](api)
+	if err != nil {
+		return fmt.Errorf("get curve: %w", err)
+	}
+	pairing, err := algebra.GetPairing[G1El, G2El, GTEl](api)
+	if err != nil {
+		return fmt.Errorf("get pairing: %w", err)
+	}
+	verifier, err := NewVerifierWithContext[FR](api, scalarApi, curve, pairing)
+	if err != nil {
+		return fmt.Errorf("new verifier: %w", err)
+	}
+	if err := verifier.CheckOpeningProof(c.Commitment, c.OpeningProof, c.Point, c.VerifyingKey); err != nil {
+		return fmt.Errorf("check opening proof: %w", err)
+	}
+	return nil
+}
+
+func TestNewVerifierWithContext(t *testing.T) {
+	assert := test.NewAssert(t)
+
+	alpha, err := rand.Int(rand.Reader, ecc.BN254.ScalarField())
+	assert.NoError(err)
+	srs, err := kzg_bn254.NewSRS(kzgSize, alpha)
+	assert.NoError(err)
+
+	f := make([]fr_bn254.Element, polynomialSize)
+	for i := range f {
+		f[i].SetRandom()
+	}
+	com, err := kzg_bn254.Commit(f, srs.Pk)
+	assert.NoError(err)
+
+	var point fr_bn254.Element
+	point.SetRandom()
+	proof, err := kzg_bn254.Open(f, point, srs.Pk)
+	assert.NoError(err)
+
+	wVk, err := ValueOfVerifyingKey[sw_bn254.G1Affine, sw_bn254.G2Affine](srs.Vk)
+	assert.NoError(err)
+	wCom, err := ValueOfCommitment[sw_bn254.G1Affine](com)
+	assert.NoError(err)
+	wProof, err := ValueOfOpeningProof[sw_bn254.ScalarField, sw_bn254.G1Affine](proof)
+	assert.NoError(err)
+	wPoint, err := ValueOfScalar[sw_bn254.ScalarField](point)
+	assert.NoError(err)
+
+	circuit := ContextVerifierCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{}
+	assignment := ContextVerifierCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{
+		VerifyingKey: wVk,
+		Commitment:   wCom,
+		OpeningProof: wProof,
+		Point:        wPoint,
+	}
+	assert.NoError(test.IsSolved(&circuit, &assignment, ecc.BN254.ScalarField()))
+
+	// missing instances are rejected instead of silently recreated
+	_, err = NewVerifierWithContext[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl](nil, nil, nil, nil)
+	assert.Error(err)
+}
@@ -0,0 +1,98 @@
+package kzg
+
+import (
+	"fmt"
+
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/algebra"
+	"github.com/consensys/gnark/std/math/emulated"
+)
+
+// Supported proof versions for [Verifier.AssertVersionedProof]. The version
+// is a runtime value, so one compiled circuit verifies proofs of any
+// supported version; unsupported values fail to solve.
+const (
+	// VersionStandardProof is the plain opening equation of
+	// [Verifier.CheckOpeningProof]: proof opens commitment at point.
+	VersionStandardProof = 0
+	// VersionBlindedProof is the two-generator hiding scheme of
+	// [Verifier.AssertKZG10HidingProof]: the commitment carries a blinding
+	// term over the second generator and the extras carry the blinding
+	// opening.
+	VersionBlindedProof = 1
+	// VersionShiftedProof is the next-row opening used by PLONK-style
+	// protocols: proof opens commitment at the shifted point ω·point, with
+	// the domain generator ω carried in the extras.
+	VersionShiftedProof = 2
+)
+
+// VersionedProofExtras carries the auxiliary inputs of the non-standard proof
+// versions. Only the fields of the selected version are semantically
+// meaningful, but all must be assigned valid group and field elements so the
+// unselected branches remain solvable; zero-valued placeholders from the
+// native types work.
+type VersionedProofExtras[FR emulated.FieldParams, G1El algebra.G1ElementT] struct {
+	// BlindingProof is the opening of the blinding polynomial over the
+	// second generator ([VersionBlindedProof]).
+	BlindingProof OpeningProof[FR, G1El]
+	// BlindingGenerator is the second generator H₁ ([VersionBlindedProof]).
+	BlindingGenerator G1El
+	// PointShift is the multiplicative shift ω applied to the evaluation
+	// point ([VersionShiftedProof]).
+	PointShift emulated.Element[FR]
+}
+
+// AssertVersionedProof asserts the validity of the opening proof under the
+// opening-equation variant selected by the runtime version value, one of
+// [VersionStandardProof], [VersionBlindedProof] or [VersionShiftedProof].
+// All variants share the pairing shape e(A, G₂)·e(B, [α]G₂) == 1 and differ
+// only in the G₁ inputs, so the dispatcher computes the inputs of every
+// variant and selects among them in-circuit before a single pairing check.
+// One circuit thus handles several proof formats, at the constraint cost of
+// evaluating every variant.
+func (v *Verifier[FR, G1El, G2El, GTEl]) AssertVersionedProof(version frontend.Variable, commitment Commitment[G1El], proof OpeningProof[FR, G1El], point emulated.Element[FR], extras VersionedProofExtras[FR, G1El], vk VerifyingKey[G1El, G2El]) error {
+	isStandard := v.api.IsZero(v.api.Sub(version, VersionStandardProof))
+	isBlinded := v.api.IsZero(v.api.Sub(version, VersionBlindedProof))
+	isShifted := v.api.IsZero(v.api.Sub(version, VersionShiftedProof))
+	// reject unsupported versions
+	v.api.AssertIsEqual(v.api.Add(isStandard, isBlinded, isShifted), 1)
+
+	commitmentNeg := v.curve.Neg(&commitment.G1El)
+	pointNeg := v.scalarApi.Neg(&point)
+
+	// standard: A = [f(a)]G₁ - a·Q - C, B = Q
+	aStandard, err := v.curve.MultiScalarMul([]*G1El{&vk.G1, &proof.Quotient}, []*emulated.Element[FR]{&proof.ClaimedValue, pointNeg})
+	if err != nil {
+		return fmt.Errorf("standard variant: %w", err)
+	}
+	aStandard = v.curve.Add(aStandard, commitmentNeg)
+
+	// blinded: A = [f(a)]G₁ + [f̂(a)]H₁ - a·W - C, B = W = Q + Q̂
+	blindedQuotient := v.curve.Add(&proof.Quotient, &extras.BlindingProof.Quotient)
+	aBlinded, err := v.curve.MultiScalarMul(
+		[]*G1El{&vk.G1, &extras.BlindingGenerator, blindedQuotient},
+		[]*emulated.Element[FR]{&proof.ClaimedValue, &extras.BlindingProof.ClaimedValue, pointNeg},
+	)
+	if err != nil {
+		return fmt.Errorf("blinded variant: %w", err)
+	}
+	aBlinded = v.curve.Add(aBlinded, commitmentNeg)
+
+	// shifted: A = [f(ωa)]G₁ - (ωa)·Q - C, B = Q
+	shiftedPointNeg := v.scalarApi.Neg(v.scalarApi.Mul(&extras.PointShift, &point))
+	aShifted, err := v.curve.MultiScalarMul([]*G1El{&vk.G1, &proof.Quotient}, []*emulated.Element[FR]{&proof.ClaimedValue, shiftedPointNeg})
+	if err != nil {
+		return fmt.Errorf("shifted variant: %w", err)
+	}
+	aShifted = v.curve.Add(aShifted, commitmentNeg)
+
+	a := v.curve.Select(isBlinded, aBlinded, v.curve.Select(isShifted, aShifted, aStandard))
+	b := v.curve.Select(isBlinded, blindedQuotient, &proof.Quotient)
+	if err := v.pairing.PairingCheck(
+		[]*G1El{a, b},
+		[]*G2El{&vk.G2[0], &vk.G2[1]},
+	); err != nil {
+		return fmt.Errorf("pairing check: %w", err)
+	}
+	return nil
+}
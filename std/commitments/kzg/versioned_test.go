@@ -0,0 +1,152 @@
+package kzg
+
+import (
+	"crypto/rand"
+	"fmt"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bn254"
+	fr_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	kzg_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/kzg"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/algebra"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_bn254"
+	"github.com/consensys/gnark/std/math/emulated"
+	"github.com/consensys/gnark/test"
+)
+
+type VersionedProofCircuit[FR emulated.FieldParams, G1El algebra.G1ElementT, G2El algebra.G2ElementT, GTEl algebra.GtElementT] struct {
+	VerifyingKey[G1El, G2El]
+	Versions    [2]frontend.Variable
+	Commitments [2]Commitment[G1El]
+	Proofs      [2]OpeningProof[FR, G1El]
+	Points      [2]emulated.Element[FR]
+	Extras      [2]VersionedProofExtras[FR, G1El]
+}
+
+func (c *VersionedProofCircuit[FR, G1El, G2El, GTEl]) Define(api frontend.API) error {
+	verifier, err := NewVerifier[FR, G1El, G2El, GTEl](api)
+	if err != nil {
+		return fmt.Errorf("new verifier: %w", err)
+	}
+	for i := range c.Versions {
+		if err := verifier.AssertVersionedProof(c.Versions[i], c.Commitments[i], c.Proofs[i], c.Points[i], c.Extras[i], c.VerifyingKey); err != nil {
+			return fmt.Errorf("assert versioned proof %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func TestAssertVersionedProof(t *testing.T) {
+	assert := test.NewAssert(t)
+
+	alpha, err := rand.Int(rand.Reader, ecc.BN254.ScalarField())
+	assert.NoError(err)
+	srs, err := kzg_bn254.NewSRS(kzgSize, alpha)
+	assert.NoError(err)
+	_, _, g1Gen, _ := bn254.Generators()
+
+	// valid placeholders for the unselected branches; distinct random points
+	// so the multi-scalar multiplications see no coinciding inputs
+	zeroExtras := func() VersionedProofExtras[sw_bn254.ScalarField, sw_bn254.G1Affine] {
+		k, err := rand.Int(rand.Reader, ecc.BN254.ScalarField())
+		assert.NoError(err)
+		var placeholderGen, placeholderQuotient bn254.G1Affine
+		placeholderGen.ScalarMultiplication(&g1Gen, k)
+		placeholderQuotient.Double(&placeholderGen)
+		proof, err := ValueOfOpeningProof[sw_bn254.ScalarField, sw_bn254.G1Affine](kzg_bn254.OpeningProof{H: placeholderQuotient})
+		assert.NoError(err)
+		return VersionedProofExtras[sw_bn254.ScalarField, sw_bn254.G1Affine]{
+			BlindingProof:     proof,
+			BlindingGenerator: sw_bn254.NewG1Affine(placeholderGen),
+			PointShift:        emulated.ValueOf[sw_bn254.ScalarField](1),
+		}
+	}
+
+	circuit := VersionedProofCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{}
+	assignment := VersionedProofCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{}
+	assignment.VerifyingKey, err = ValueOfVerifyingKey[sw_bn254.G1Affine, sw_bn254.G2Affine](srs.Vk)
+	assert.NoError(err)
+
+	// slot 0: a standard opening
+	f := make([]fr_bn254.Element, polynomialSize)
+	for i := range f {
+		f[i].SetRandom()
+	}
+	com, err := kzg_bn254.Commit(f, srs.Pk)
+	assert.NoError(err)
+	var point fr_bn254.Element
+	point.SetRandom()
+	proof, err := kzg_bn254.Open(f, point, srs.Pk)
+	assert.NoError(err)
+	assignment.Versions[0] = VersionStandardProof
+	assignment.Commitments[0], err = ValueOfCommitment[sw_bn254.G1Affine](com)
+	assert.NoError(err)
+	assignment.Proofs[0], err = ValueOfOpeningProof[sw_bn254.ScalarField, sw_bn254.G1Affine](proof)
+	assert.NoError(err)
+	assignment.Points[0], err = ValueOfScalar[sw_bn254.ScalarField](point)
+	assert.NoError(err)
+	assignment.Extras[0] = zeroExtras()
+
+	// slot 1: a shifted opening at ω·point of the same commitment
+	var omega, shiftedPoint fr_bn254.Element
+	omega.SetRandom()
+	shiftedPoint.Mul(&omega, &point)
+	shiftedProof, err := kzg_bn254.Open(f, shiftedPoint, srs.Pk)
+	assert.NoError(err)
+	assignment.Versions[1] = VersionShiftedProof
+	assignment.Commitments[1] = assignment.Commitments[0]
+	assignment.Proofs[1], err = ValueOfOpeningProof[sw_bn254.ScalarField, sw_bn254.G1Affine](shiftedProof)
+	assert.NoError(err)
+	assignment.Points[1] = assignment.Points[0]
+	assignment.Extras[1] = zeroExtras()
+	assignment.Extras[1].PointShift = emulated.ValueOf[sw_bn254.ScalarField](omega)
+
+	assert.Run(func(assert *test.Assert) {
+		assert.NoError(test.IsSolved(&circuit, &assignment, ecc.BN254.ScalarField()))
+	}, "two versions")
+
+	assert.Run(func(assert *test.Assert) {
+		// the shifted proof does not verify under the standard version
+		badAssignment := assignment
+		badAssignment.Versions[1] = VersionStandardProof
+		assert.Error(test.IsSolved(&circuit, &badAssignment, ecc.BN254.ScalarField()))
+	}, "wrong version")
+
+	assert.Run(func(assert *test.Assert) {
+		badAssignment := assignment
+		badAssignment.Versions[0] = 3
+		assert.Error(test.IsSolved(&circuit, &badAssignment, ecc.BN254.ScalarField()))
+	}, "unsupported version")
+
+	assert.Run(func(assert *test.Assert) {
+		// the blinded version against a two-generator hiding commitment
+		rho, err := rand.Int(rand.Reader, ecc.BN254.ScalarField())
+		assert.NoError(err)
+		var h bn254.G1Affine
+		h.ScalarMultiplication(&srs.Pk.G1[0], rho)
+		fHat := make([]fr_bn254.Element, polynomialSize)
+		for i := range fHat {
+			fHat[i].SetRandom()
+		}
+		comFHat, err := kzg_bn254.Commit(fHat, srs.Pk)
+		assert.NoError(err)
+		var hidingCom bn254.G1Affine
+		hidingCom.ScalarMultiplication(&comFHat, rho).Add(&hidingCom, &com)
+		blindingProof, err := kzg_bn254.Open(fHat, point, srs.Pk)
+		assert.NoError(err)
+		blindingProof.H.ScalarMultiplication(&blindingProof.H, rho)
+
+		blindedAssignment := assignment
+		blindedAssignment.Versions[1] = VersionBlindedProof
+		blindedAssignment.Commitments[1], err = ValueOfCommitment[sw_bn254.G1Affine](hidingCom)
+		assert.NoError(err)
+		blindedAssignment.Proofs[1] = assignment.Proofs[0]
+		blindedAssignment.Extras[1] = zeroExtras()
+		blindedAssignment.Extras[1].BlindingGenerator = sw_bn254.NewG1Affine(h)
+		blindedAssignment.Extras[1].BlindingProof, err = ValueOfOpeningProof[sw_bn254.ScalarField, sw_bn254.G1Affine](blindingProof)
+		assert.NoError(err)
+		assert.NoError(test.IsSolved(&circuit, &blindedAssignment, ecc.BN254.ScalarField()))
+	}, "blinded version")
+}
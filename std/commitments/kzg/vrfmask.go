@@ -0,0 +1,40 @@
+package kzg
+
+import (
+	"fmt"
+
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/math/bits"
+	"github.com/consensys/gnark/std/math/emulated"
+)
+
+// AssertProofWithVRFMask verifies an opening of a commitment masked with
+// randomness derived from a VRF output. The masked commitment is
+//
+//	C' = C + [m]H
+//
+// for the unmasked commitment C, the mask generator H and the mask scalar m
+// equal to the VRF output embedded into the scalar field. The method
+// re-derives the mask point from the VRF output, subtracts it from the
+// commitment and verifies the opening proof against the unmasked C, so the
+// opening only verifies with the output the mask was actually derived from.
+//
+// The VRF output is taken as a native variable, as produced by an in-circuit
+// VRF evaluation over the native field; verifying the VRF proof itself is the
+// caller's responsibility. It is embedded canonically, so the native field
+// must fit the emulated scalar field. For the mask to hide the commitment, H
+// must have an unknown discrete logarithm relative to the SRS generators.
+func (v *Verifier[FR, G1El, G2El, GTEl]) AssertProofWithVRFMask(commitment Commitment[G1El], proof OpeningProof[FR, G1El], point emulated.Element[FR], vrfOutput frontend.Variable, maskGenerator G1El, vk VerifyingKey[G1El, G2El]) error {
+	var fr FR
+	if fr.Modulus().Cmp(v.api.Compiler().Field()) < 0 {
+		return fmt.Errorf("native field doesn't fit the emulated scalar field")
+	}
+	outputBits := bits.ToBinary(v.api, vrfOutput, bits.WithNbDigits(v.api.Compiler().Field().BitLen()))
+	mask := v.scalarApi.FromBits(outputBits...)
+	maskPoint := v.curve.ScalarMul(&maskGenerator, mask)
+	unmasked := v.curve.Add(&commitment.G1El, v.curve.Neg(maskPoint))
+	if err := v.CheckOpeningProof(Commitment[G1El]{G1El: *unmasked}, proof, point, vk); err != nil {
+		return fmt.Errorf("check opening proof: %w", err)
+	}
+	return nil
+}
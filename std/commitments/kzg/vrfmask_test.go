@@ -0,0 +1,97 @@
+package kzg
+
+import (
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bn254"
+	fr_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	kzg_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/kzg"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/algebra"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_bn254"
+	"github.com/consensys/gnark/std/math/emulated"
+	"github.com/consensys/gnark/test"
+)
+
+type VRFMaskCircuit[FR emulated.FieldParams, G1El algebra.G1ElementT, G2El algebra.G2ElementT, GTEl algebra.GtElementT] struct {
+	VerifyingKey[G1El, G2El]
+	Commitment Commitment[G1El]
+	Proof      OpeningProof[FR, G1El]
+	Point      emulated.Element[FR]
+	VRFOutput  frontend.Variable
+	MaskGen    G1El
+}
+
+func (c *VRFMaskCircuit[FR, G1El, G2El, GTEl]) Define(api frontend.API) error {
+	verifier, err := NewVerifier[FR, G1El, G2El, GTEl](api)
+	if err != nil {
+		return fmt.Errorf("new verifier: %w", err)
+	}
+	return verifier.AssertProofWithVRFMask(c.Commitment, c.Proof, c.Point, c.VRFOutput, c.MaskGen, c.VerifyingKey)
+}
+
+func TestAssertProofWithVRFMask(t *testing.T) {
+	assert := test.NewAssert(t)
+
+	alpha, err := rand.Int(rand.Reader, ecc.BN254.ScalarField())
+	assert.NoError(err)
+	srs, err := kzg_bn254.NewSRS(kzgSize, alpha)
+	assert.NoError(err)
+
+	f := make([]fr_bn254.Element, polynomialSize)
+	for i := range f {
+		f[i].SetRandom()
+	}
+	com, err := kzg_bn254.Commit(f, srs.Pk)
+	assert.NoError(err)
+	var point fr_bn254.Element
+	point.SetRandom()
+	proof, err := kzg_bn254.Open(f, point, srs.Pk)
+	assert.NoError(err)
+
+	// mask generator with unknown discrete logarithm relative to the SRS
+	var maskGen bn254.G1Affine
+	var h fr_bn254.Element
+	h.SetRandom()
+	_, _, g1Gen, _ := bn254.Generators()
+	maskGen.ScalarMultiplication(&g1Gen, h.BigInt(new(big.Int)))
+
+	// mask the commitment with the VRF output
+	var vrfOutput fr_bn254.Element
+	vrfOutput.SetRandom()
+	var maskPoint, masked bn254.G1Affine
+	maskPoint.ScalarMultiplication(&maskGen, vrfOutput.BigInt(new(big.Int)))
+	masked.Add(&com, &maskPoint)
+
+	assignment := VRFMaskCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{
+		Point:     emulated.ValueOf[sw_bn254.ScalarField](point),
+		VRFOutput: vrfOutput.BigInt(new(big.Int)),
+		MaskGen:   sw_bn254.NewG1Affine(maskGen),
+	}
+	assignment.VerifyingKey, err = ValueOfVerifyingKey[sw_bn254.G1Affine, sw_bn254.G2Affine](srs.Vk)
+	assert.NoError(err)
+	assignment.Commitment, err = ValueOfCommitment[sw_bn254.G1Affine](masked)
+	assert.NoError(err)
+	assignment.Proof, err = ValueOfOpeningProof[sw_bn254.ScalarField, sw_bn254.G1Affine](proof)
+	assert.NoError(err)
+
+	circuit := VRFMaskCircuit[sw_bn254.ScalarField, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{}
+
+	assert.Run(func(assert *test.Assert) {
+		assert.NoError(test.IsSolved(&circuit, &assignment, ecc.BN254.ScalarField()))
+	}, "valid")
+
+	assert.Run(func(assert *test.Assert) {
+		// a different VRF output derives a different mask and the unmasked
+		// commitment no longer matches the opening
+		badAssignment := assignment
+		var otherOutput fr_bn254.Element
+		otherOutput.SetRandom()
+		badAssignment.VRFOutput = otherOutput.BigInt(new(big.Int))
+		assert.Error(test.IsSolved(&circuit, &badAssignment, ecc.BN254.ScalarField()))
+	}, "wrong VRF output")
+}
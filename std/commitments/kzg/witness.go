@@ -0,0 +1,136 @@
+package kzg
+
+import (
+	"fmt"
+
+	fr_bls12377 "github.com/consensys/gnark-crypto/ecc/bls12-377/fr"
+	kzg_bls12377 "github.com/consensys/gnark-crypto/ecc/bls12-377/kzg"
+	fr_bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+	kzg_bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381/kzg"
+	fr_bls24315 "github.com/consensys/gnark-crypto/ecc/bls24-315/fr"
+	kzg_bls24315 "github.com/consensys/gnark-crypto/ecc/bls24-315/kzg"
+	fr_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	kzg_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/kzg"
+	fr_bw6761 "github.com/consensys/gnark-crypto/ecc/bw6-761/fr"
+	kzg_bw6761 "github.com/consensys/gnark-crypto/ecc/bw6-761/kzg"
+	"github.com/consensys/gnark/std/algebra"
+	"github.com/consensys/gnark/std/math/emulated"
+)
+
+type valueOfProofConfig struct {
+	nativePrecheck bool
+}
+
+// ValueOfProofOption configures the behaviour of
+// [ValueOfVerifiedOpeningProof].
+type ValueOfProofOption func(*valueOfProofConfig)
+
+// WithNativePrecheck enables or disables the native verification of the
+// opening proof before the witness is built. It is enabled by default;
+// disabling it makes [ValueOfVerifiedOpeningProof] behave as
+// [ValueOfOpeningProof], which is useful when the proof was already verified
+// or when an invalid witness is constructed on purpose.
+func WithNativePrecheck(enabled bool) ValueOfProofOption {
+	return func(cfg *valueOfProofConfig) {
+		cfg.nativePrecheck = enabled
+	}
+}
+
+// ValueOfVerifiedOpeningProof returns the witness of the opening proof as
+// [ValueOfOpeningProof] does, but first verifies the proof natively against
+// the commitment, point and verifying key using gnark-crypto. It returns an
+// error if the native proof does not verify, catching invalid fixtures at
+// witness-construction time instead of as a confusing in-circuit failure. The
+// precheck can be disabled with [WithNativePrecheck].
+func ValueOfVerifiedOpeningProof[FR emulated.FieldParams, G1El algebra.G1ElementT](commitment, proof, point, vk any, opts ...ValueOfProofOption) (OpeningProof[FR, G1El], error) {
+	var ret OpeningProof[FR, G1El]
+	cfg := valueOfProofConfig{nativePrecheck: true}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	if cfg.nativePrecheck {
+		if err := nativeVerify(commitment, proof, point, vk); err != nil {
+			return ret, fmt.Errorf("native precheck: %w", err)
+		}
+	}
+	return ValueOfOpeningProof[FR, G1El](proof)
+}
+
+// nativeVerify runs gnark-crypto's KZG verification for the native types
+// corresponding to the supported parametrizations.
+func nativeVerify(commitment, proof, point, vk any) error {
+	switch tProof := proof.(type) {
+	case kzg_bn254.OpeningProof:
+		tCmt, ok := commitment.(kzg_bn254.Digest)
+		if !ok {
+			return fmt.Errorf("mismatching types %T %T", proof, commitment)
+		}
+		tPoint, ok := point.(fr_bn254.Element)
+		if !ok {
+			return fmt.Errorf("mismatching types %T %T", proof, point)
+		}
+		tVk, ok := vk.(kzg_bn254.VerifyingKey)
+		if !ok {
+			return fmt.Errorf("mismatching types %T %T", proof, vk)
+		}
+		return kzg_bn254.Verify(&tCmt, &tProof, tPoint, tVk)
+	case kzg_bls12377.OpeningProof:
+		tCmt, ok := commitment.(kzg_bls12377.Digest)
+		if !ok {
+			return fmt.Errorf("mismatching types %T %T", proof, commitment)
+		}
+		tPoint, ok := point.(fr_bls12377.Element)
+		if !ok {
+			return fmt.Errorf("mismatching types %T %T", proof, point)
+		}
+		tVk, ok := vk.(kzg_bls12377.VerifyingKey)
+		if !ok {
+			return fmt.Errorf("mismatching types %T %T", proof, vk)
+		}
+		return kzg_bls12377.Verify(&tCmt, &tProof, tPoint, tVk)
+	case kzg_bls12381.OpeningProof:
+		tCmt, ok := commitment.(kzg_bls12381.Digest)
+		if !ok {
+			return fmt.Errorf("mismatching types %T %T", proof, commitment)
+		}
+		tPoint, ok := point.(fr_bls12381.Element)
+		if !ok {
+			return fmt.Errorf("mismatching types %T %T", proof, point)
+		}
+		tVk, ok := vk.(kzg_bls12381.VerifyingKey)
+		if !ok {
+			return fmt.Errorf("mismatching types %T %T", proof, vk)
+		}
+		return kzg_bls12381.Verify(&tCmt, &tProof, tPoint, tVk)
+	case kzg_bw6761.OpeningProof:
+		tCmt, ok := commitment.(kzg_bw6761.Digest)
+		if !ok {
+			return fmt.Errorf("mismatching types %T %T", proof, commitment)
+		}
+		tPoint, ok := point.(fr_bw6761.Element)
+		if !ok {
+			return fmt.Errorf("mismatching types %T %T", proof, point)
+		}
+		tVk, ok := vk.(kzg_bw6761.VerifyingKey)
+		if !ok {
+			return fmt.Errorf("mismatching types %T %T", proof, vk)
+		}
+		return kzg_bw6761.Verify(&tCmt, &tProof, tPoint, tVk)
+	case kzg_bls24315.OpeningProof:
+		tCmt, ok := commitment.(kzg_bls24315.Digest)
+		if !ok {
+			return fmt.Errorf("mismatching types %T %T", proof, commitment)
+		}
+		tPoint, ok := point.(fr_bls24315.Element)
+		if !ok {
+			return fmt.Errorf("mismatching types %T %T", proof, point)
+		}
+		tVk, ok := vk.(kzg_bls24315.VerifyingKey)
+		if !ok {
+			return fmt.Errorf("mismatching types %T %T", proof, vk)
+		}
+		return kzg_bls24315.Verify(&tCmt, &tProof, tPoint, tVk)
+	default:
+		return fmt.Errorf("unknown type parametrization")
+	}
+}
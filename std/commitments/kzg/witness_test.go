@@ -0,0 +1,46 @@
+package kzg
+
+import (
+	"crypto/rand"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	fr_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	kzg_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/kzg"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_bn254"
+	"github.com/consensys/gnark/test"
+)
+
+func TestValueOfVerifiedOpeningProof(t *testing.T) {
+	assert := test.NewAssert(t)
+
+	alpha, err := rand.Int(rand.Reader, ecc.BN254.ScalarField())
+	assert.NoError(err)
+	srs, err := kzg_bn254.NewSRS(kzgSize, alpha)
+	assert.NoError(err)
+
+	f := make([]fr_bn254.Element, polynomialSize)
+	for i := range f {
+		f[i].SetRandom()
+	}
+	com, err := kzg_bn254.Commit(f, srs.Pk)
+	assert.NoError(err)
+	var point fr_bn254.Element
+	point.SetRandom()
+	proof, err := kzg_bn254.Open(f, point, srs.Pk)
+	assert.NoError(err)
+
+	// a valid proof builds the witness
+	_, err = ValueOfVerifiedOpeningProof[sw_bn254.ScalarField, sw_bn254.G1Affine](com, proof, point, srs.Vk)
+	assert.NoError(err)
+
+	// a tampered proof is rejected at witness-construction time
+	badProof := proof
+	badProof.ClaimedValue.SetRandom()
+	_, err = ValueOfVerifiedOpeningProof[sw_bn254.ScalarField, sw_bn254.G1Affine](com, badProof, point, srs.Vk)
+	assert.Error(err)
+
+	// unless the precheck is disabled explicitly
+	_, err = ValueOfVerifiedOpeningProof[sw_bn254.ScalarField, sw_bn254.G1Affine](com, badProof, point, srs.Vk, WithNativePrecheck(false))
+	assert.NoError(err)
+}
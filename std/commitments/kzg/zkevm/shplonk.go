@@ -0,0 +1,139 @@
+package zkevm
+
+import (
+	"fmt"
+
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/algebra"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_bn254"
+	"github.com/consensys/gnark/std/commitments/kzg"
+	"github.com/consensys/gnark/std/math/emulated"
+)
+
+// Proof is a Shplonk aggregated opening proof: W commits to the aggregated
+// quotient h = Σᵢ γⁱ(fᵢ - sᵢ)/(X - zᵢ) and WPrime opens the linearized
+// combination at the evaluation challenge z.
+type Proof struct {
+	W      sw_bn254.G1Affine
+	WPrime sw_bn254.G1Affine
+}
+
+// Verifier verifies Shplonk aggregated openings with the zkEVM transcript.
+type Verifier struct {
+	api     frontend.API
+	curve   algebra.Curve[sw_bn254.ScalarField, sw_bn254.G1Affine]
+	pairing *sw_bn254.Pairing
+	fr      *emulated.Field[sw_bn254.ScalarField]
+}
+
+// NewVerifier returns a new verifier using the native arithmetic of api.
+func NewVerifier(api frontend.API) (*Verifier, error) {
+	curve, err := algebra.GetCurve[sw_bn254.ScalarField, sw_bn254.G1Affine](api)
+	if err != nil {
+		return nil, fmt.Errorf("get curve: %w", err)
+	}
+	pairing, err := sw_bn254.NewPairing(api)
+	if err != nil {
+		return nil, fmt.Errorf("new pairing: %w", err)
+	}
+	fr, err := emulated.NewField[sw_bn254.ScalarField](api)
+	if err != nil {
+		return nil, fmt.Errorf("new scalar field: %w", err)
+	}
+	return &Verifier{api: api, curve: curve, pairing: pairing, fr: fr}, nil
+}
+
+// AssertShplonkProof asserts the validity of the aggregated opening proof for
+// the commitments, each opened at its own point to the claimed value. The
+// folding challenge γ and the evaluation challenge z are derived from the
+// Keccak transcript: γ after absorbing all commitments, points and values; z
+// after additionally absorbing W. With Zᵢ(z) = Π_{j≠i}(z - zⱼ) and
+// Z(z) = Π_j(z - zⱼ), the verifier checks
+//
+//	e(Σᵢ γⁱZᵢ(z)·(Cᵢ - [sᵢ]G₁) - Z(z)·W + z·W', G₂)·e(-W', [α]G₂) == 1
+//
+// which is the BDFG20 verification equation with the linearized combination
+// opened at z by W'.
+func (v *Verifier) AssertShplonkProof(commitments []sw_bn254.G1Affine, points, values []emulated.Element[sw_bn254.ScalarField], proof Proof, vk kzg.VerifyingKey[sw_bn254.G1Affine, sw_bn254.G2Affine]) error {
+	if len(commitments) != len(points) {
+		return fmt.Errorf("number of commitments doesn't match number of points")
+	}
+	if len(commitments) != len(values) {
+		return fmt.Errorf("number of commitments doesn't match number of values")
+	}
+	if len(commitments) == 0 {
+		return fmt.Errorf("number of commitments should be nonzero")
+	}
+	n := len(commitments)
+
+	transcript, err := NewTranscript(v.api)
+	if err != nil {
+		return fmt.Errorf("new transcript: %w", err)
+	}
+	for i := range commitments {
+		transcript.AppendPoint(&commitments[i])
+	}
+	for i := range points {
+		transcript.AppendScalar(&points[i])
+		transcript.AppendScalar(&values[i])
+	}
+	gamma, err := transcript.ChallengeScalar()
+	if err != nil {
+		return fmt.Errorf("derive gamma: %w", err)
+	}
+	transcript.AppendPoint(&proof.W)
+	z, err := transcript.ChallengeScalar()
+	if err != nil {
+		return fmt.Errorf("derive z: %w", err)
+	}
+
+	// γⁱ·Zᵢ(z) and Z(z)
+	coeffs := make([]*emulated.Element[sw_bn254.ScalarField], n)
+	gammaPow := v.fr.One()
+	zS := v.fr.One()
+	for i := 0; i < n; i++ {
+		zi := v.fr.One()
+		for j := 0; j < n; j++ {
+			diff := v.fr.Sub(z, &points[j])
+			if j != i {
+				zi = v.fr.Mul(zi, diff)
+			}
+			if i == 0 {
+				zS = v.fr.Mul(zS, diff)
+			}
+		}
+		coeffs[i] = v.fr.Mul(gammaPow, zi)
+		gammaPow = v.fr.Mul(gammaPow, gamma)
+	}
+	// -Σᵢ γⁱZᵢ(z)·sᵢ
+	folded := v.fr.Zero()
+	for i := 0; i < n; i++ {
+		folded = v.fr.Add(folded, v.fr.Mul(coeffs[i], &values[i]))
+	}
+	folded = v.fr.Neg(folded)
+
+	msmPoints := make([]*sw_bn254.G1Affine, n+3)
+	msmScalars := make([]*emulated.Element[sw_bn254.ScalarField], n+3)
+	for i := range commitments {
+		msmPoints[i] = &commitments[i]
+		msmScalars[i] = coeffs[i]
+	}
+	msmPoints[n] = &vk.G1
+	msmScalars[n] = folded
+	msmPoints[n+1] = &proof.W
+	msmScalars[n+1] = v.fr.Neg(zS)
+	msmPoints[n+2] = &proof.WPrime
+	msmScalars[n+2] = z
+	total, err := v.curve.MultiScalarMul(msmPoints, msmScalars)
+	if err != nil {
+		return fmt.Errorf("fold commitments: %w", err)
+	}
+
+	if err := v.pairing.PairingCheck(
+		[]*sw_bn254.G1Affine{total, v.curve.Neg(&proof.WPrime)},
+		[]*sw_bn254.G2Affine{&vk.G2[0], &vk.G2[1]},
+	); err != nil {
+		return fmt.Errorf("pairing check: %w", err)
+	}
+	return nil
+}
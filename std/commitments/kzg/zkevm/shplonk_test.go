@@ -0,0 +1,203 @@
+package zkevm
+
+import (
+	"crypto/rand"
+	"fmt"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bn254"
+	fr_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	kzg_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/kzg"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_bn254"
+	"github.com/consensys/gnark/std/commitments/kzg"
+	"github.com/consensys/gnark/std/math/emulated"
+	"github.com/consensys/gnark/test"
+	"golang.org/x/crypto/sha3"
+)
+
+const (
+	testNbPolys = 2
+	testDegree  = 10
+	testSRSSize = 16
+)
+
+// nativeTranscript mirrors the in-circuit Keccak transcript.
+type nativeTranscript struct {
+	buf []byte
+}
+
+func (t *nativeTranscript) appendPoint(p *bn254.G1Affine) {
+	t.buf = append(t.buf, p.X.Marshal()...)
+	t.buf = append(t.buf, p.Y.Marshal()...)
+}
+
+func (t *nativeTranscript) appendScalar(s *fr_bn254.Element) {
+	t.buf = append(t.buf, s.Marshal()...)
+}
+
+func (t *nativeTranscript) challengeScalar() fr_bn254.Element {
+	hasher := sha3.NewLegacyKeccak256()
+	hasher.Write(t.buf)
+	digest := hasher.Sum(nil)
+	t.buf = digest
+	var c fr_bn254.Element
+	c.SetBytes(digest)
+	return c
+}
+
+// divLinear returns the quotient of f - f(a) by X - a.
+func divLinear(f []fr_bn254.Element, a fr_bn254.Element) []fr_bn254.Element {
+	q := make([]fr_bn254.Element, len(f)-1)
+	var acc fr_bn254.Element
+	for i := len(f) - 1; i > 0; i-- {
+		acc.Mul(&acc, &a).Add(&acc, &f[i])
+		q[i-1].Set(&acc)
+	}
+	return q
+}
+
+func evalPoly(f []fr_bn254.Element, a fr_bn254.Element) fr_bn254.Element {
+	var res fr_bn254.Element
+	for i := len(f) - 1; i >= 0; i-- {
+		res.Mul(&res, &a).Add(&res, &f[i])
+	}
+	return res
+}
+
+type ShplonkCircuit struct {
+	Vk          kzg.VerifyingKey[sw_bn254.G1Affine, sw_bn254.G2Affine]
+	Commitments [testNbPolys]sw_bn254.G1Affine
+	Points      [testNbPolys]emulated.Element[sw_bn254.ScalarField]
+	Values      [testNbPolys]emulated.Element[sw_bn254.ScalarField]
+	Proof       Proof
+}
+
+func (c *ShplonkCircuit) Define(api frontend.API) error {
+	verifier, err := NewVerifier(api)
+	if err != nil {
+		return fmt.Errorf("new verifier: %w", err)
+	}
+	if err := verifier.AssertShplonkProof(c.Commitments[:], c.Points[:], c.Values[:], c.Proof, c.Vk); err != nil {
+		return fmt.Errorf("assert shplonk proof: %w", err)
+	}
+	return nil
+}
+
+func TestAssertShplonkProof(t *testing.T) {
+	assert := test.NewAssert(t)
+
+	alpha, err := rand.Int(rand.Reader, ecc.BN254.ScalarField())
+	assert.NoError(err)
+	srs, err := kzg_bn254.NewSRS(testSRSSize, alpha)
+	assert.NoError(err)
+
+	// a small zkEVM-style instance: two polynomials opened at their own
+	// points, aggregated with a single Shplonk proof
+	polys := make([][]fr_bn254.Element, testNbPolys)
+	points := make([]fr_bn254.Element, testNbPolys)
+	values := make([]fr_bn254.Element, testNbPolys)
+	commitments := make([]bn254.G1Affine, testNbPolys)
+	transcript := &nativeTranscript{}
+	for i := range polys {
+		polys[i] = make([]fr_bn254.Element, testDegree+1)
+		for j := range polys[i] {
+			polys[i][j].SetRandom()
+		}
+		commitments[i], err = kzg_bn254.Commit(polys[i], srs.Pk)
+		assert.NoError(err)
+		points[i].SetRandom()
+		values[i] = evalPoly(polys[i], points[i])
+	}
+	for i := range commitments {
+		transcript.appendPoint(&commitments[i])
+	}
+	for i := range points {
+		transcript.appendScalar(&points[i])
+		transcript.appendScalar(&values[i])
+	}
+	gamma := transcript.challengeScalar()
+
+	// h = Σᵢ γⁱ(fᵢ - sᵢ)/(X - zᵢ)
+	h := make([]fr_bn254.Element, testDegree)
+	gammaPow := fr_bn254.One()
+	for i := range polys {
+		qi := divLinear(polys[i], points[i])
+		for j := range qi {
+			var tmp fr_bn254.Element
+			tmp.Mul(&qi[j], &gammaPow)
+			h[j].Add(&h[j], &tmp)
+		}
+		gammaPow.Mul(&gammaPow, &gamma)
+	}
+	w, err := kzg_bn254.Commit(h, srs.Pk)
+	assert.NoError(err)
+	transcript.appendPoint(&w)
+	z := transcript.challengeScalar()
+
+	// L = Σᵢ γⁱZᵢ(z)(fᵢ - sᵢ) - Z(z)·h, opened at z by W'
+	l := make([]fr_bn254.Element, testDegree+1)
+	gammaPow.SetOne()
+	var zS fr_bn254.Element
+	zS.SetOne()
+	for i := range polys {
+		var zi fr_bn254.Element
+		zi.SetOne()
+		for j := range points {
+			var diff fr_bn254.Element
+			diff.Sub(&z, &points[j])
+			if j != i {
+				zi.Mul(&zi, &diff)
+			}
+			if i == 0 {
+				zS.Mul(&zS, &diff)
+			}
+		}
+		var coeff fr_bn254.Element
+		coeff.Mul(&gammaPow, &zi)
+		for j := range polys[i] {
+			var tmp fr_bn254.Element
+			tmp.Mul(&polys[i][j], &coeff)
+			l[j].Add(&l[j], &tmp)
+		}
+		var cv fr_bn254.Element
+		cv.Mul(&coeff, &values[i])
+		l[0].Sub(&l[0], &cv)
+		gammaPow.Mul(&gammaPow, &gamma)
+	}
+	for j := range h {
+		var tmp fr_bn254.Element
+		tmp.Mul(&h[j], &zS)
+		l[j].Sub(&l[j], &tmp)
+	}
+	lz := evalPoly(l, z)
+	assert.True(lz.IsZero(), "linearized combination doesn't vanish at z")
+	wPrime, err := kzg_bn254.Commit(divLinear(l, z), srs.Pk)
+	assert.NoError(err)
+
+	wVk, err := kzg.ValueOfVerifyingKey[sw_bn254.G1Affine, sw_bn254.G2Affine](srs.Vk)
+	assert.NoError(err)
+
+	circuit := ShplonkCircuit{}
+	assignment := ShplonkCircuit{
+		Vk: wVk,
+		Proof: Proof{
+			W:      sw_bn254.NewG1Affine(w),
+			WPrime: sw_bn254.NewG1Affine(wPrime),
+		},
+	}
+	for i := range commitments {
+		assignment.Commitments[i] = sw_bn254.NewG1Affine(commitments[i])
+		assignment.Points[i] = emulated.ValueOf[sw_bn254.ScalarField](points[i])
+		assignment.Values[i] = emulated.ValueOf[sw_bn254.ScalarField](values[i])
+	}
+	assert.NoError(test.IsSolved(&circuit, &assignment, ecc.BN254.ScalarField()))
+
+	// a tampered claimed value breaks the transcript and the check
+	badAssignment := assignment
+	var badValue fr_bn254.Element
+	badValue.SetOne().Add(&badValue, &values[0])
+	badAssignment.Values[0] = emulated.ValueOf[sw_bn254.ScalarField](badValue)
+	assert.Error(test.IsSolved(&circuit, &badAssignment, ecc.BN254.ScalarField()))
+}
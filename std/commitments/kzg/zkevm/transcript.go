@@ -0,0 +1,103 @@
+// Package zkevm verifies KZG openings produced by zkEVM-style provers that
+// aggregate multiple openings with the Shplonk scheme [BDFG20] and run their
+// Fiat-Shamir transcript over Keccak256, as done by Scroll's zkEVM verifier
+// contracts.
+//
+// The transcript absorbs affine points coordinate-wise and scalars as
+// canonical 32-byte big-endian strings. A challenge is squeezed by hashing
+// the running buffer with Keccak256 and reducing the digest big-endian modulo
+// the scalar field; the digest is fed forward as the first absorption of the
+// next round. The package instantiates BN254 only, matching the zkEVM
+// deployment.
+//
+// [BDFG20]: https://eprint.iacr.org/2020/081
+package zkevm
+
+import (
+	"fmt"
+	"math/big"
+	"slices"
+
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/algebra"
+	"github.com/consensys/gnark/std/algebra/algopts"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_bn254"
+	"github.com/consensys/gnark/std/hash/sha3"
+	"github.com/consensys/gnark/std/math/bits"
+	"github.com/consensys/gnark/std/math/emulated"
+	"github.com/consensys/gnark/std/math/uints"
+)
+
+// Transcript is the Keccak256 Fiat-Shamir transcript of the zkEVM verifier.
+type Transcript struct {
+	api   frontend.API
+	curve algebra.Curve[sw_bn254.ScalarField, sw_bn254.G1Affine]
+	fr    *emulated.Field[sw_bn254.ScalarField]
+	uapi  *uints.BinaryField[uints.U64]
+	buf   []uints.U8
+}
+
+// NewTranscript returns a fresh transcript.
+func NewTranscript(api frontend.API) (*Transcript, error) {
+	curve, err := algebra.GetCurve[sw_bn254.ScalarField, sw_bn254.G1Affine](api)
+	if err != nil {
+		return nil, fmt.Errorf("get curve: %w", err)
+	}
+	fr, err := emulated.NewField[sw_bn254.ScalarField](api)
+	if err != nil {
+		return nil, fmt.Errorf("new scalar field: %w", err)
+	}
+	uapi, err := uints.New[uints.U64](api)
+	if err != nil {
+		return nil, fmt.Errorf("new uints: %w", err)
+	}
+	return &Transcript{api: api, curve: curve, fr: fr, uapi: uapi}, nil
+}
+
+// appendBits absorbs a canonical MSB-first bit string, which must be
+// byte-aligned.
+func (t *Transcript) appendBits(bts []frontend.Variable) {
+	for i := 0; i < len(bts); i += 8 {
+		grp := make([]frontend.Variable, 8)
+		copy(grp, bts[i:i+8])
+		slices.Reverse(grp)
+		t.buf = append(t.buf, t.uapi.ByteValueOf(bits.FromBinary(t.api, grp)))
+	}
+}
+
+// AppendPoint absorbs the affine coordinates of p as two 32-byte big-endian
+// strings.
+func (t *Transcript) AppendPoint(p *sw_bn254.G1Affine) {
+	t.appendBits(t.curve.MarshalG1(*p, algopts.WithCanonicalBitRepresentation()))
+}
+
+// AppendScalar absorbs s as a canonical 32-byte big-endian string.
+func (t *Transcript) AppendScalar(s *emulated.Element[sw_bn254.ScalarField]) {
+	t.appendBits(t.curve.MarshalScalar(*s, algopts.WithCanonicalBitRepresentation()))
+}
+
+// ChallengeScalar squeezes a challenge: the Keccak256 digest of the running
+// buffer reduced big-endian modulo the scalar field. The digest is fed
+// forward into the next round.
+func (t *Transcript) ChallengeScalar() (*emulated.Element[sw_bn254.ScalarField], error) {
+	hasher, err := sha3.NewLegacyKeccak256(t.api)
+	if err != nil {
+		return nil, fmt.Errorf("new keccak: %w", err)
+	}
+	hasher.Write(t.buf)
+	digest := hasher.Sum()
+	t.buf = slices.Clone(digest)
+
+	// LSB-first bits of the big-endian digest
+	bts := make([]frontend.Variable, 0, 8*len(digest))
+	for i := len(digest) - 1; i >= 0; i-- {
+		bts = append(bts, bits.ToBinary(t.api, digest[i].Val, bits.WithNbDigits(8))...)
+	}
+	// reduce modulo r: the emulated field handles the overflow of the
+	// high bits
+	nbLow := len(bts) - 3
+	low := t.fr.FromBits(bts[:nbLow]...)
+	high := t.fr.FromBits(bts[nbLow:]...)
+	shift := emulated.ValueOf[sw_bn254.ScalarField](new(big.Int).Lsh(big.NewInt(1), uint(nbLow)))
+	return t.fr.Add(low, t.fr.Mul(high, &shift)), nil
+}
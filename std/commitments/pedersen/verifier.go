@@ -1,4 +1,9 @@
 // Package pedersen implements the Pedersen vector commitment scheme verifier.
+//
+// The verifier checks the knowledge proofs produced by gnark-crypto's
+// pedersen package and is parameterized over the group element types like
+// the KZG gadget, so it is used for recursively verifying Groth16 proofs of
+// circuits using the Commit API.
 package pedersen
 
 import (
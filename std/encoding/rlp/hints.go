@@ -0,0 +1,132 @@
+package rlp
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/consensys/gnark/constraint/solver"
+)
+
+func init() {
+	solver.RegisterHint(GetHints()...)
+}
+
+// GetHints returns all hint functions used in the package.
+func GetHints() []solver.Hint {
+	return []solver.Hint{decodeBytesHint, decodeListHint}
+}
+
+// decodePrefix parses the RLP prefix at pos and returns the prefix length,
+// the content length and whether the item is a list.
+func decodePrefix(data []byte, pos int) (plen, clen int, isList bool, err error) {
+	if pos >= len(data) {
+		return 0, 0, false, fmt.Errorf("offset %d out of bounds", pos)
+	}
+	b := data[pos]
+	switch {
+	case b < 0x80:
+		return 0, 1, false, nil
+	case b <= 0xbf:
+		isList = false
+	default:
+		isList = true
+		b -= 0x40
+	}
+	if b <= 0xb7 {
+		return 1, int(b - 0x80), isList, nil
+	}
+	n := int(b - 0xb7)
+	if pos+1+n > len(data) {
+		return 0, 0, false, fmt.Errorf("length prefix out of bounds")
+	}
+	for k := 0; k < n; k++ {
+		clen = clen<<8 | int(data[pos+1+k])
+	}
+	return 1 + n, clen, isList, nil
+}
+
+func toBytes(inputs []*big.Int) ([]byte, error) {
+	data := make([]byte, len(inputs))
+	for i, in := range inputs {
+		if !in.IsUint64() || in.Uint64() > 255 {
+			return nil, fmt.Errorf("input %d is not a byte", i)
+		}
+		data[i] = byte(in.Uint64())
+	}
+	return data, nil
+}
+
+// decodeBytesHint outputs the prefix length and content length of the string
+// at the start of the data.
+func decodeBytesHint(_ *big.Int, inputs, outputs []*big.Int) error {
+	data, err := toBytes(inputs)
+	if err != nil {
+		return err
+	}
+	plen, clen, isList, err := decodePrefix(data, 0)
+	if err != nil {
+		return err
+	}
+	if isList {
+		return fmt.Errorf("expected a string, got a list")
+	}
+	outputs[0].SetInt64(int64(plen))
+	outputs[1].SetInt64(int64(clen))
+	return nil
+}
+
+// decodeListHint outputs the item count, the list header length, the payload
+// length and (offset, content length, prefix length, is list) for each item
+// of the list at the start of the data. The first input is the maximum number
+// of items; unused slots are zero.
+func decodeListHint(_ *big.Int, inputs, outputs []*big.Int) error {
+	if len(inputs) < 1 {
+		return fmt.Errorf("missing maxItems input")
+	}
+	maxItems := int(inputs[0].Int64())
+	if len(outputs) != 3+4*maxItems {
+		return fmt.Errorf("expected %d outputs, got %d", 3+4*maxItems, len(outputs))
+	}
+	data, err := toBytes(inputs[1:])
+	if err != nil {
+		return err
+	}
+	hdrLen, payloadLen, isList, err := decodePrefix(data, 0)
+	if err != nil {
+		return err
+	}
+	if !isList {
+		return fmt.Errorf("expected a list, got a string")
+	}
+	end := hdrLen + payloadLen
+	if end > len(data) {
+		return fmt.Errorf("list payload out of bounds")
+	}
+	for i := range outputs {
+		outputs[i].SetInt64(0)
+	}
+	outputs[1].SetInt64(int64(hdrLen))
+	outputs[2].SetInt64(int64(payloadLen))
+	count := 0
+	for pos := hdrLen; pos < end; count++ {
+		if count == maxItems {
+			return fmt.Errorf("list has more than %d items", maxItems)
+		}
+		plen, clen, itemIsList, err := decodePrefix(data, pos)
+		if err != nil {
+			return err
+		}
+		outputs[3+4*count].SetInt64(int64(pos))
+		outputs[4+4*count].SetInt64(int64(clen))
+		outputs[5+4*count].SetInt64(int64(plen))
+		if itemIsList {
+			outputs[6+4*count].SetInt64(1)
+		}
+		pos += plen + clen
+		if pos > end {
+			return fmt.Errorf("malformed list: item overflows the payload")
+		}
+	}
+	outputs[0].SetInt64(int64(count))
+	return nil
+}
@@ -0,0 +1,146 @@
+// Package rlp implements in-circuit decoding of RLP (recursive length
+// prefix) encoded data, as used throughout the Ethereum protocol. The
+// gadgets parse the length prefixes of strings and lists and return the item
+// boundaries as in-circuit values, for inputs padded to a compile-time
+// maximum size.
+//
+// The boundaries are recovered by hints and verified in-circuit to be the
+// canonical encoding: the prefix form matches the content length, short
+// forms are used whenever possible and the items fill the list payload
+// exactly.
+package rlp
+
+import (
+	"fmt"
+
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/math/uints"
+	"github.com/consensys/gnark/std/selector"
+)
+
+// Item describes the boundaries of a decoded RLP item inside the input
+// buffer.
+type Item struct {
+	// Offset is the index of the first content byte.
+	Offset frontend.Variable
+	// Length is the content length in bytes.
+	Length frontend.Variable
+	// IsList is 1 if the item is a nested list and 0 for a string. For a
+	// list the content is its payload, which can be decoded further with
+	// [DecodeList].
+	IsList frontend.Variable
+}
+
+// DecodeBytes decodes the RLP string starting at the beginning of data and
+// returns its content boundaries. The bytes after the encoded string are
+// ignored, so the input can be padded to a compile-time maximum size.
+func DecodeBytes(api frontend.API, data []uints.U8) (Item, error) {
+	if len(data) == 0 {
+		return Item{}, fmt.Errorf("empty data")
+	}
+	vals := byteVals(data)
+	outs, err := api.Compiler().NewHint(decodeBytesHint, 2, vals...)
+	if err != nil {
+		return Item{}, fmt.Errorf("decode bytes hint: %w", err)
+	}
+	plen, clen := outs[0], outs[1]
+	assertPrefix(api, vals, 0, clen, plen, 0, 1)
+	// the encoded string fits in the buffer
+	api.AssertIsLessOrEqual(api.Add(plen, clen), len(data))
+	return Item{Offset: plen, Length: clen, IsList: 0}, nil
+}
+
+// DecodeList decodes the RLP list starting at the beginning of data and
+// returns the number of items and their boundaries. Up to maxItems items are
+// decoded; the decoding fails if the list holds more. The bytes after the
+// encoded list are ignored, so the input can be padded to a compile-time
+// maximum size.
+func DecodeList(api frontend.API, data []uints.U8, maxItems int) (frontend.Variable, []Item, error) {
+	if len(data) == 0 {
+		return nil, nil, fmt.Errorf("empty data")
+	}
+	if maxItems <= 0 {
+		return nil, nil, fmt.Errorf("maxItems must be positive")
+	}
+	vals := byteVals(data)
+	outs, err := api.Compiler().NewHint(decodeListHint, 3+4*maxItems, append([]frontend.Variable{maxItems}, vals...)...)
+	if err != nil {
+		return nil, nil, fmt.Errorf("decode list hint: %w", err)
+	}
+	count, hdrLen, payloadLen := outs[0], outs[1], outs[2]
+	// the list header is a canonical list prefix
+	assertPrefix(api, vals, 0, payloadLen, hdrLen, 1, 1)
+	end := api.Add(hdrLen, payloadLen)
+	api.AssertIsLessOrEqual(end, len(data))
+
+	items := make([]Item, maxItems)
+	cur := frontend.Variable(hdrLen)
+	active := frontend.Variable(1)
+	for i := 0; i < maxItems; i++ {
+		off, clen, plen, isList := outs[3+4*i], outs[4+4*i], outs[5+4*i], outs[6+4*i]
+		// active while i < count
+		active = api.Mul(active, api.Sub(1, api.IsZero(api.Sub(count, i))))
+		api.AssertIsBoolean(isList)
+		// the item starts where the previous one ended
+		api.AssertIsEqual(api.Mul(active, api.Sub(off, cur)), 0)
+		assertPrefix(api, vals, off, clen, plen, isList, active)
+		cur = api.Select(active, api.Add(cur, plen, clen), cur)
+		items[i] = Item{Offset: api.Add(off, plen), Length: clen, IsList: isList}
+	}
+	// the items fill the list payload exactly
+	api.AssertIsEqual(cur, end)
+	return count, items, nil
+}
+
+// assertPrefix asserts, when active is 1, that the bytes at off are the
+// canonical RLP prefix of an item with content length clen and prefix length
+// plen, of string or list kind according to isList.
+func assertPrefix(api frontend.API, vals []frontend.Variable, off, clen, plen, isList, active frontend.Variable) {
+	b0 := selector.Mux(api, api.Select(active, off, 0), vals...)
+	p0 := api.IsZero(plen)
+	p1 := api.IsZero(api.Sub(plen, 1))
+	p2 := api.IsZero(api.Sub(plen, 2))
+	p3 := api.IsZero(api.Sub(plen, 3))
+	api.AssertIsEqual(api.Add(p0, p1, p2, p3), 1)
+
+	// single byte below 0x80: the byte is the content itself, strings only
+	s0 := api.Mul(active, p0)
+	api.AssertIsEqual(api.Mul(s0, isList), 0)
+	api.AssertIsEqual(api.Mul(s0, api.Sub(clen, 1)), 0)
+	api.AssertIsLessOrEqual(api.Select(s0, b0, 0), 0x7f)
+
+	// short form: the prefix byte encodes the content length
+	s1 := api.Mul(active, p1)
+	base := api.Select(isList, 0xc0, 0x80)
+	api.AssertIsEqual(api.Mul(s1, api.Sub(b0, api.Add(clen, base))), 0)
+	api.AssertIsLessOrEqual(api.Select(s1, clen, 0), 55)
+	// a single content byte below 0x80 must use the single byte form
+	sOne := api.Mul(s1, api.Sub(1, isList), api.IsZero(api.Sub(clen, 1)))
+	c0 := selector.Mux(api, api.Select(sOne, api.Add(off, 1), 0), vals...)
+	api.AssertIsLessOrEqual(api.Sub(api.Select(sOne, c0, 0x80), 0x80), 0x7f)
+
+	// long form, one length byte: content length in [56, 255]
+	s2 := api.Mul(active, p2)
+	long1 := api.Select(isList, 0xf8, 0xb8)
+	api.AssertIsEqual(api.Mul(s2, api.Sub(b0, long1)), 0)
+	b1 := selector.Mux(api, api.Select(s2, api.Add(off, 1), 0), vals...)
+	api.AssertIsEqual(api.Mul(s2, api.Sub(b1, clen)), 0)
+	api.AssertIsLessOrEqual(api.Sub(api.Select(s2, clen, 56), 56), 199)
+
+	// long form, two length bytes: content length in [256, 65535]
+	s3 := api.Mul(active, p3)
+	long2 := api.Select(isList, 0xf9, 0xb9)
+	api.AssertIsEqual(api.Mul(s3, api.Sub(b0, long2)), 0)
+	hi := selector.Mux(api, api.Select(s3, api.Add(off, 1), 0), vals...)
+	lo := selector.Mux(api, api.Select(s3, api.Add(off, 2), 0), vals...)
+	api.AssertIsEqual(api.Mul(s3, api.Sub(api.Add(api.Mul(hi, 256), lo), clen)), 0)
+	api.AssertIsLessOrEqual(api.Sub(api.Select(s3, clen, 256), 256), 65279)
+}
+
+func byteVals(data []uints.U8) []frontend.Variable {
+	vals := make([]frontend.Variable, len(data))
+	for i := range data {
+		vals[i] = data[i].Val
+	}
+	return vals
+}
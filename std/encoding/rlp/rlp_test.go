@@ -0,0 +1,179 @@
+package rlp
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/math/uints"
+	"github.com/consensys/gnark/test"
+)
+
+// encStr and encList mirror Go's RLP encoder for the test fixtures.
+func encStr(b []byte) []byte {
+	if len(b) == 1 && b[0] < 0x80 {
+		return b
+	}
+	if len(b) <= 55 {
+		return append([]byte{0x80 + byte(len(b))}, b...)
+	}
+	if len(b) <= 255 {
+		return append([]byte{0xb8, byte(len(b))}, b...)
+	}
+	return append([]byte{0xb9, byte(len(b) >> 8), byte(len(b))}, b...)
+}
+
+func encList(items ...[]byte) []byte {
+	var payload []byte
+	for _, item := range items {
+		payload = append(payload, item...)
+	}
+	switch {
+	case len(payload) <= 55:
+		return append([]byte{0xc0 + byte(len(payload))}, payload...)
+	case len(payload) <= 255:
+		return append([]byte{0xf8, byte(len(payload))}, payload...)
+	default:
+		return append([]byte{0xf9, byte(len(payload) >> 8), byte(len(payload))}, payload...)
+	}
+}
+
+type decodeBytesCircuit struct {
+	Data      []uints.U8
+	ExpOffset frontend.Variable
+	ExpLength frontend.Variable
+}
+
+func (c *decodeBytesCircuit) Define(api frontend.API) error {
+	item, err := DecodeBytes(api, c.Data)
+	if err != nil {
+		return err
+	}
+	api.AssertIsEqual(item.Offset, c.ExpOffset)
+	api.AssertIsEqual(item.Length, c.ExpLength)
+	return nil
+}
+
+func TestDecodeBytes(t *testing.T) {
+	assert := test.NewAssert(t)
+	for _, tc := range []struct {
+		name    string
+		content []byte
+	}{
+		{"empty", nil},
+		{"single", []byte{0x2a}},
+		{"short", []byte("hello world")},
+		{"long", bytes.Repeat([]byte{0xab}, 60)},
+		{"longer", bytes.Repeat([]byte{0xcd}, 300)},
+	} {
+		assert.Run(func(assert *test.Assert) {
+			enc := encStr(tc.content)
+			// pad to a larger compile-time buffer
+			data := append(enc, make([]byte, 7)...)
+			circuit := decodeBytesCircuit{Data: make([]uints.U8, len(data))}
+			assignment := decodeBytesCircuit{
+				Data:      uints.NewU8Array(data),
+				ExpOffset: len(enc) - len(tc.content),
+				ExpLength: len(tc.content),
+			}
+			assert.NoError(test.IsSolved(&circuit, &assignment, ecc.BN254.ScalarField()))
+		}, tc.name)
+	}
+
+	// non-canonical encodings are rejected: long form for a short string
+	data := append([]byte{0xb8, 0x03}, []byte("cat")...)
+	circuit := decodeBytesCircuit{Data: make([]uints.U8, len(data))}
+	assignment := decodeBytesCircuit{Data: uints.NewU8Array(data), ExpOffset: 2, ExpLength: 3}
+	assert.Error(test.IsSolved(&circuit, &assignment, ecc.BN254.ScalarField()))
+}
+
+type decodeListCircuit struct {
+	Data      []uints.U8
+	ExpCount  frontend.Variable
+	ExpOffset []frontend.Variable
+	ExpLength []frontend.Variable
+	ExpIsList []frontend.Variable
+	maxItems  int
+}
+
+func (c *decodeListCircuit) Define(api frontend.API) error {
+	count, items, err := DecodeList(api, c.Data, c.maxItems)
+	if err != nil {
+		return err
+	}
+	api.AssertIsEqual(count, c.ExpCount)
+	for i := range items {
+		api.AssertIsEqual(items[i].Offset, c.ExpOffset[i])
+		api.AssertIsEqual(items[i].Length, c.ExpLength[i])
+		api.AssertIsEqual(items[i].IsList, c.ExpIsList[i])
+	}
+	return nil
+}
+
+func TestDecodeList(t *testing.T) {
+	assert := test.NewAssert(t)
+
+	type item struct {
+		enc        []byte
+		contentLen int
+		isList     bool
+	}
+	for _, tc := range []struct {
+		name  string
+		items []item
+	}{
+		{"short", []item{
+			{encStr([]byte("cat")), 3, false},
+			{encStr([]byte("dog")), 3, false},
+		}},
+		{"mixed", []item{
+			{encStr(nil), 0, false},
+			{encStr([]byte{0x2a}), 1, false},
+			{encStr([]byte("hello world")), 11, false},
+			{encStr(bytes.Repeat([]byte{0xab}, 60)), 60, false},
+			{encList(encStr([]byte{0x01}), encStr([]byte("ab"))), 4, true},
+		}},
+	} {
+		assert.Run(func(assert *test.Assert) {
+			const maxItems = 6
+			var payload []byte
+			offs := make([]frontend.Variable, maxItems)
+			lens := make([]frontend.Variable, maxItems)
+			isList := make([]frontend.Variable, maxItems)
+			for i := range offs {
+				offs[i], lens[i], isList[i] = 0, 0, 0
+			}
+			relOffs := make([]int, len(tc.items))
+			for i, it := range tc.items {
+				relOffs[i] = len(payload) + len(it.enc) - it.contentLen
+				payload = append(payload, it.enc...)
+			}
+			enc := encList(payload)
+			hdrLen := len(enc) - len(payload)
+			for i, it := range tc.items {
+				offs[i] = hdrLen + relOffs[i]
+				lens[i] = it.contentLen
+				if it.isList {
+					isList[i] = 1
+				}
+			}
+			data := append(enc, make([]byte, 9)...)
+			circuit := decodeListCircuit{
+				Data:      make([]uints.U8, len(data)),
+				ExpOffset: make([]frontend.Variable, maxItems),
+				ExpLength: make([]frontend.Variable, maxItems),
+				ExpIsList: make([]frontend.Variable, maxItems),
+				maxItems:  maxItems,
+			}
+			assignment := decodeListCircuit{
+				Data:      uints.NewU8Array(data),
+				ExpCount:  len(tc.items),
+				ExpOffset: offs,
+				ExpLength: lens,
+				ExpIsList: isList,
+			}
+			assert.NoError(test.IsSolved(&circuit, &assignment, ecc.BN254.ScalarField()))
+		}, tc.name)
+	}
+}
@@ -0,0 +1,135 @@
+package evmprecompiles
+
+import (
+	"fmt"
+	"math/big"
+
+	fp_bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381/fp"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_bls12381"
+	"github.com/consensys/gnark/std/commitments/kzg"
+	"github.com/consensys/gnark/std/hash/sha2"
+	"github.com/consensys/gnark/std/math/emulated"
+	"github.com/consensys/gnark/std/math/uints"
+)
+
+// blobCommitmentVersionKZG is the version byte of KZG-committed blobs, as
+// defined by EIP-4844.
+const blobCommitmentVersionKZG = 0x01
+
+// KzgPointEvaluation implements the [POINT_EVALUATION] precompile contract at
+// address 0x0a. It asserts that the blob commitment behind versionedHash
+// opens to claimedValue at evaluationPoint, given the opening proof and the
+// KZG verifying key of the trusted setup:
+//
+//   - versionedHash == 0x01 || sha256(compress(commitment))[1:], binding the
+//     commitment to the versioned hash carried by the blob transaction;
+//   - the KZG pairing check of the opening proof over emulated BLS12-381.
+//
+// The evaluation point and claimed value are canonical scalars by the
+// emulated field encoding, matching the precompile's range checks. The
+// identity commitment is not representable in affine coordinates and fails
+// to solve. The precompile's constant return values (the number of field
+// elements per blob and the scalar field modulus) carry no information and
+// are not reproduced.
+//
+// [POINT_EVALUATION]: https://eips.ethereum.org/EIPS/eip-4844#point-evaluation-precompile
+func KzgPointEvaluation(api frontend.API,
+	versionedHash [32]uints.U8,
+	evaluationPoint *emulated.Element[sw_bls12381.ScalarField],
+	claimedValue *emulated.Element[sw_bls12381.ScalarField],
+	commitment *sw_bls12381.G1Affine,
+	proof *sw_bls12381.G1Affine,
+	vk kzg.VerifyingKey[sw_bls12381.G1Affine, sw_bls12381.G2Affine],
+) error {
+	// commitment-to-versioned-hash binding
+	compressed, err := compressG1BLS12381(api, commitment)
+	if err != nil {
+		return fmt.Errorf("compress commitment: %w", err)
+	}
+	hasher, err := sha2.New(api)
+	if err != nil {
+		return fmt.Errorf("new sha256: %w", err)
+	}
+	hasher.Write(compressed[:])
+	digest := hasher.Sum()
+	if len(digest) != len(versionedHash) {
+		return fmt.Errorf("unexpected digest length %d", len(digest))
+	}
+	uapi, err := uints.New[uints.U32](api)
+	if err != nil {
+		return fmt.Errorf("new uints api: %w", err)
+	}
+	uapi.ByteAssertEq(versionedHash[0], uints.NewU8(blobCommitmentVersionKZG))
+	for i := 1; i < len(versionedHash); i++ {
+		uapi.ByteAssertEq(versionedHash[i], digest[i])
+	}
+
+	// opening proof pairing check
+	verifier, err := kzg.NewVerifier[sw_bls12381.ScalarField, sw_bls12381.G1Affine, sw_bls12381.G2Affine, sw_bls12381.GTEl](api)
+	if err != nil {
+		return fmt.Errorf("new kzg verifier: %w", err)
+	}
+	if err := verifier.CheckOpeningProof(
+		kzg.Commitment[sw_bls12381.G1Affine]{G1El: *commitment},
+		kzg.OpeningProof[sw_bls12381.ScalarField, sw_bls12381.G1Affine]{
+			Quotient:     *proof,
+			ClaimedValue: *claimedValue,
+		},
+		*evaluationPoint,
+		vk,
+	); err != nil {
+		return fmt.Errorf("check opening proof: %w", err)
+	}
+	return nil
+}
+
+// compressG1BLS12381 returns the compressed gnark-crypto serialization of the
+// point: the 48 big-endian bytes of the x coordinate with the compression
+// flag on the top bit and the lexicographically-largest flag, asserted
+// in-circuit against the y coordinate, on the third-highest bit.
+func compressG1BLS12381(api frontend.API, p *sw_bls12381.G1Affine) ([48]uints.U8, error) {
+	var res [48]uints.U8
+	fp, err := emulated.NewField[sw_bls12381.BaseField](api)
+	if err != nil {
+		return res, fmt.Errorf("new base field: %w", err)
+	}
+
+	// the hint provides the flag; constraining the smaller of y and -y below
+	// (p-1)/2 pins it down uniquely for y != 0
+	flags, err := fp.NewHintWithNativeOutput(lexicographicallyLargestHint, 1, &p.Y)
+	if err != nil {
+		return res, fmt.Errorf("lexicographically largest hint: %w", err)
+	}
+	flagLargest := flags[0]
+	api.AssertIsBoolean(flagLargest)
+	ySmall := fp.ReduceStrict(fp.Select(flagLargest, fp.Neg(&p.Y), &p.Y))
+	var halfP big.Int
+	halfP.Sub(fp_bls12381.Modulus(), big.NewInt(1)).Rsh(&halfP, 1)
+	fp.AssertIsLessOrEqual(ySmall, fp.NewElement(&halfP))
+
+	xBits := fp.ToBitsCanonical(&p.X)
+	bit := func(i int) frontend.Variable {
+		if i < len(xBits) {
+			return xBits[i]
+		}
+		return 0
+	}
+	uapi, err := uints.New[uints.U32](api)
+	if err != nil {
+		return res, fmt.Errorf("new uints api: %w", err)
+	}
+	for i := 0; i < 48; i++ {
+		// res[0] is the most significant byte
+		v := frontend.Variable(0)
+		for t := 0; t < 8; t++ {
+			v = api.Add(v, api.Mul(bit((47-i)*8+t), 1<<t))
+		}
+		if i == 0 {
+			// compression flag and sort flag; the infinity flag stays clear
+			v = api.Add(v, 0b10000000, api.Mul(flagLargest, 0b00100000))
+		}
+		res[i] = uapi.ByteValueOf(v)
+	}
+	return res, nil
+}
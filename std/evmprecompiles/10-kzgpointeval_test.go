@@ -0,0 +1,88 @@
+package evmprecompiles
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	fr_bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+	kzg_bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381/kzg"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_bls12381"
+	"github.com/consensys/gnark/std/commitments/kzg"
+	"github.com/consensys/gnark/std/math/emulated"
+	"github.com/consensys/gnark/std/math/uints"
+	"github.com/consensys/gnark/test"
+)
+
+type kzgPointEvaluationCircuit struct {
+	VersionedHash   [32]uints.U8
+	EvaluationPoint emulated.Element[sw_bls12381.ScalarField]
+	ClaimedValue    emulated.Element[sw_bls12381.ScalarField]
+	Commitment      sw_bls12381.G1Affine
+	Proof           sw_bls12381.G1Affine
+	Vk              kzg.VerifyingKey[sw_bls12381.G1Affine, sw_bls12381.G2Affine]
+}
+
+func (c *kzgPointEvaluationCircuit) Define(api frontend.API) error {
+	return KzgPointEvaluation(api, c.VersionedHash, &c.EvaluationPoint, &c.ClaimedValue, &c.Commitment, &c.Proof, c.Vk)
+}
+
+func TestKzgPointEvaluation(t *testing.T) {
+	assert := test.NewAssert(t)
+
+	alpha, err := rand.Int(rand.Reader, ecc.BLS12_381.ScalarField())
+	assert.NoError(err)
+	srs, err := kzg_bls12381.NewSRS(16, alpha)
+	assert.NoError(err)
+
+	f := make([]fr_bls12381.Element, 10)
+	for i := range f {
+		f[i].SetRandom()
+	}
+	com, err := kzg_bls12381.Commit(f, srs.Pk)
+	assert.NoError(err)
+
+	var point fr_bls12381.Element
+	point.SetRandom()
+	proof, err := kzg_bls12381.Open(f, point, srs.Pk)
+	assert.NoError(err)
+	assert.NoError(kzg_bls12381.Verify(&com, &proof, point, srs.Vk))
+
+	// versioned hash of the compressed commitment
+	compressed := com.Bytes()
+	vh := sha256.Sum256(compressed[:])
+	vh[0] = blobCommitmentVersionKZG
+
+	wCmt, err := kzg.ValueOfCommitment[sw_bls12381.G1Affine](com)
+	assert.NoError(err)
+	wProof, err := kzg.ValueOfOpeningProof[sw_bls12381.ScalarField, sw_bls12381.G1Affine](proof)
+	assert.NoError(err)
+	wVk, err := kzg.ValueOfVerifyingKey[sw_bls12381.G1Affine, sw_bls12381.G2Affine](srs.Vk)
+	assert.NoError(err)
+	wPt, err := kzg.ValueOfScalar[sw_bls12381.ScalarField](point)
+	assert.NoError(err)
+
+	assignment := kzgPointEvaluationCircuit{
+		EvaluationPoint: wPt,
+		ClaimedValue:    wProof.ClaimedValue,
+		Commitment:      wCmt.G1El,
+		Proof:           wProof.Quotient,
+		Vk:              wVk,
+	}
+	for i := range vh {
+		assignment.VersionedHash[i] = uints.NewU8(vh[i])
+	}
+
+	// a versioned hash not bound to the commitment must not verify
+	badAssignment := assignment
+	badAssignment.VersionedHash[5] = uints.NewU8(vh[5] ^ 1)
+
+	assert.CheckCircuit(&kzgPointEvaluationCircuit{},
+		test.WithValidAssignment(&assignment),
+		test.WithInvalidAssignment(&badAssignment),
+		test.WithCurves(ecc.BN254),
+		test.NoProverChecks(),
+	)
+}
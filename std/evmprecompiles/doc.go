@@ -12,6 +12,7 @@
 //  7. BN_MUL ✅ -- function [ECMul]
 //  8. SNARKV ✅ -- function [ECPair]
 //  9. BLAKE2F ❌ -- postponed
+//  10. POINT_EVALUATION ✅ -- function [KzgPointEvaluation]
 //
 // This package uses local representation for the arguments. It is up to the
 // user to instantiate corresponding types from their application-specific data.
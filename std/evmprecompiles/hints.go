@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"math/big"
 
+	fp_bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381/fp"
 	"github.com/consensys/gnark-crypto/ecc/secp256k1/ecdsa"
 	"github.com/consensys/gnark/constraint/solver"
 	"github.com/consensys/gnark/frontend"
@@ -18,7 +19,31 @@ func init() {
 
 // GetHints returns all the hints used in this package.
 func GetHints() []solver.Hint {
-	return []solver.Hint{recoverPublicKeyHint}
+	return []solver.Hint{recoverPublicKeyHint, lexicographicallyLargestHint}
+}
+
+// lexicographicallyLargestHint returns 1 when its input, an element of the
+// BLS12-381 base field, is lexicographically larger than its negation and 0
+// otherwise.
+func lexicographicallyLargestHint(_ *big.Int, inputs, outputs []*big.Int) error {
+	return emulated.UnwrapHintWithNativeOutput(inputs, outputs, func(field *big.Int, inputs, outputs []*big.Int) error {
+		if len(inputs) != 1 {
+			return fmt.Errorf("expecting one input")
+		}
+		if len(outputs) != 1 {
+			return fmt.Errorf("expecting one output")
+		}
+		if field.Cmp(fp_bls12381.Modulus()) != 0 {
+			return fmt.Errorf("expecting BLS12-381 base field")
+		}
+		var y fp_bls12381.Element
+		y.SetBigInt(inputs[0])
+		outputs[0].SetInt64(0)
+		if y.LexicographicallyLargest() {
+			outputs[0].SetInt64(1)
+		}
+		return nil
+	})
 }
 
 func recoverPublicKeyHintArgs(msg emulated.Element[emulated.Secp256k1Fr],
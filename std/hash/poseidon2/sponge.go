@@ -0,0 +1,95 @@
+package poseidon
+
+import (
+	"errors"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/internal/utils"
+	"github.com/consensys/gnark/std/hash"
+)
+
+var (
+	ErrInvalidWidth = errors.New("the permutation width should be at least 2")
+	ErrUnknownCurve = errors.New("unknown curve id")
+)
+
+// Sponge is a sponge construction over the Poseidon2 permutation implementing
+// [hash.FieldHasher]. The state has t elements of which the first is the
+// capacity; written data is absorbed rate = t-1 elements at a time, the last
+// block zero-padded, and the digest is the first rate element of the final
+// state. As the padding does not encode the input length, the construction
+// assumes fixed-length inputs like the MiMC gadget.
+type Sponge struct {
+	api  frontend.API
+	h    Hash
+	t    int
+	data []frontend.Variable
+}
+
+// NewSponge returns a sponge hasher over the Poseidon2 permutation with the
+// given parameters, inferring the curve from the native field of api. See
+// [NewHash] for the meaning of the parameters.
+func NewSponge(api frontend.API, t, d, rf, rp int, seed string) (*Sponge, error) {
+	if t < 2 {
+		return nil, ErrInvalidWidth
+	}
+	curve := utils.FieldToCurve(api.Compiler().Field())
+	if curve == ecc.UNKNOWN {
+		return nil, ErrUnknownCurve
+	}
+	return &Sponge{api: api, h: NewHash(t, d, rf, rp, seed, curve), t: t}, nil
+}
+
+// Write populates the internal state of the hash function with data.
+func (s *Sponge) Write(data ...frontend.Variable) {
+	s.data = append(s.data, data...)
+}
+
+// Reset empties the internal state.
+func (s *Sponge) Reset() {
+	s.data = nil
+}
+
+// Sum absorbs the written data and returns the digest. The data already
+// hashed is flushed as in the MiMC gadget.
+func (s *Sponge) Sum() frontend.Variable {
+	rate := s.t - 1
+	state := make([]frontend.Variable, s.t)
+	for i := range state {
+		state[i] = 0
+	}
+	for start := 0; start == 0 || start < len(s.data); start += rate {
+		for i := 0; i < rate && start+i < len(s.data); i++ {
+			state[i+1] = s.api.Add(state[i+1], s.data[start+i])
+		}
+		// the state has the right width by construction
+		_ = s.h.Permutation(s.api, state)
+	}
+	s.data = nil
+	return state[1]
+}
+
+// Compress is a 2-to-1 compression function for Merkle trees: it permutes the
+// state (left, right) and returns the second element of the result with right
+// fed forward, as in gnark-crypto's Compress. The permutation must be
+// instantiated with width t=2.
+func (h *Hash) Compress(api frontend.API, left, right frontend.Variable) (frontend.Variable, error) {
+	if h.params.t != 2 {
+		return nil, errors.New("need a 2-1 function")
+	}
+	state := []frontend.Variable{left, right}
+	if err := h.Permutation(api, state); err != nil {
+		return nil, err
+	}
+	return api.Add(state[1], right), nil
+}
+
+// Register registers a sponge builder with the given parameters under name in
+// the in-circuit hash registry, so that gadgets can construct the hasher with
+// [hash.GetFieldHasher].
+func Register(name string, t, d, rf, rp int, seed string) {
+	hash.Register(name, func(api frontend.API) (hash.FieldHasher, error) {
+		return NewSponge(api, t, d, rf, rp, seed)
+	})
+}
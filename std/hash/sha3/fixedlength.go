@@ -0,0 +1,78 @@
+package sha3
+
+import (
+	"math/big"
+
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/math/cmp"
+	"github.com/consensys/gnark/std/math/uints"
+	"github.com/consensys/gnark/std/permutation/keccakf"
+)
+
+// FixedLengthSum returns the digest of the first length bytes of the written
+// data, implementing [hash.BinaryFixedLengthHasher]. The total written data
+// defines the compile-time maximum input size; length is constrained to be at
+// most that size. This allows hashing variable-length inputs, e.g. RLP-coded
+// nodes of Ethereum storage proofs, at the cost of selecting the padding
+// position and the final state in-circuit.
+func (d *digest) FixedLengthSum(length frontend.Variable) []uints.U8 {
+	// pad the data with a full extra block of zeros so the dynamic padding
+	// always fits, then align to a multiple of the rate
+	data := make([]uints.U8, len(d.in))
+	copy(data, d.in)
+	for i := 0; i < d.rate; i++ {
+		data = append(data, uints.NewU8(0))
+	}
+	for len(data)%d.rate != 0 {
+		data = append(data, uints.NewU8(0))
+	}
+	maxBlocks := len(data) / d.rate
+
+	comparator := cmp.NewBoundedComparator(d.api, big.NewInt(int64(len(data)+d.rate)), false)
+	comparator.AssertIsLessEq(length, len(d.in))
+
+	// totalLen = rate·(⌊length/rate⌋+1) is the padded size: the smallest
+	// multiple of the rate strictly greater than length, as the padding is at
+	// least one byte
+	nbBlocks := frontend.Variable(1)
+	for k := 1; k < maxBlocks; k++ {
+		nbBlocks = d.api.Add(nbBlocks, d.api.Sub(1, comparator.IsLess(length, k*d.rate)))
+	}
+	totalLen := d.api.Mul(nbBlocks, d.rate)
+	lastBytePos := d.api.Sub(totalLen, 1)
+
+	// pad10*1: dsbyte at position length, zeros after, and 0x80 on the last
+	// byte of the block. dsbyte < 0x80, so adding the final bit never carries,
+	// also when the padding is a single byte carrying both.
+	for i := range data {
+		isPadding := comparator.IsLess(length, i)
+		data[i].Val = d.api.Select(isPadding, 0, data[i].Val)
+		isPaddingStart := d.api.IsZero(d.api.Sub(i, length))
+		data[i].Val = d.api.Select(isPaddingStart, d.dsbyte, data[i].Val)
+		isLastByte := d.api.IsZero(d.api.Sub(i, lastBytePos))
+		data[i].Val = d.api.Add(data[i].Val, d.api.Mul(isLastByte, 0x80))
+	}
+
+	// absorb all blocks, keeping the state at the dynamic input size
+	runningState := d.state
+	resultState := d.state
+	for b := 0; b < maxBlocks; b++ {
+		block := data[b*d.rate : (b+1)*d.rate]
+		for j := 0; j < d.rate/8; j++ {
+			runningState[j] = d.uapi.Xor(runningState[j], d.uapi.PackLSB(block[j*8:j*8+8]...))
+		}
+		runningState = keccakf.Permute(d.uapi, runningState)
+		isInRange := comparator.IsLess(b*d.rate, totalLen)
+		for j := 0; j < d.outputLen/8; j++ {
+			for k := range resultState[j] {
+				resultState[j][k].Val = d.api.Select(isInRange, runningState[j][k].Val, resultState[j][k].Val)
+			}
+		}
+	}
+
+	var result []uints.U8
+	for i := 0; i < d.outputLen/8; i++ {
+		result = append(result, d.uapi.UnpackLSB(resultState[i])...)
+	}
+	return result
+}
@@ -0,0 +1,84 @@
+package sha3
+
+import (
+	"crypto/rand"
+	"fmt"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark/frontend"
+	zkhash "github.com/consensys/gnark/std/hash"
+	"github.com/consensys/gnark/std/math/uints"
+	"github.com/consensys/gnark/test"
+)
+
+type sha3FixedLengthCircuit struct {
+	In       []uints.U8
+	Length   frontend.Variable
+	Expected []uints.U8
+
+	hasher string
+}
+
+func (c *sha3FixedLengthCircuit) Define(api frontend.API) error {
+	newHasher, ok := testCases[c.hasher]
+	if !ok {
+		return fmt.Errorf("hash function unknown: %s", c.hasher)
+	}
+	h, err := newHasher.zk(api)
+	if err != nil {
+		return err
+	}
+	fh, ok := h.(zkhash.BinaryFixedLengthHasher)
+	if !ok {
+		return fmt.Errorf("hash function is not fixed-length: %s", c.hasher)
+	}
+	uapi, err := uints.New[uints.U64](api)
+	if err != nil {
+		return err
+	}
+
+	fh.Write(c.In)
+	res := fh.FixedLengthSum(c.Length)
+
+	for i := range c.Expected {
+		uapi.ByteAssertEq(c.Expected[i], res[i])
+	}
+	return nil
+}
+
+func TestSHA3FixedLengthSum(t *testing.T) {
+	assert := test.NewAssert(t)
+	const maxLen = 310
+	in := make([]byte, maxLen)
+	_, err := rand.Reader.Read(in)
+	assert.NoError(err)
+
+	for name := range testCases {
+		assert.Run(func(assert *test.Assert) {
+			strategy := testCases[name]
+			// lengths crossing block boundaries of every rate in use
+			for _, length := range []int{0, 1, 71, 72, 136, 200, maxLen} {
+				assert.Run(func(assert *test.Assert) {
+					h := strategy.native()
+					h.Write(in[:length])
+					expected := h.Sum(nil)
+
+					circuit := &sha3FixedLengthCircuit{
+						In:       make([]uints.U8, maxLen),
+						Expected: make([]uints.U8, len(expected)),
+						hasher:   name,
+					}
+
+					witness := &sha3FixedLengthCircuit{
+						In:       uints.NewU8Array(in),
+						Length:   length,
+						Expected: uints.NewU8Array(expected),
+					}
+
+					assert.NoError(test.IsSolved(circuit, witness, ecc.BN254.ScalarField()))
+				}, fmt.Sprintf("length=%d", length))
+			}
+		}, name)
+	}
+}
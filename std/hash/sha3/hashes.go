@@ -15,6 +15,7 @@ func New256(api frontend.API) (hash.BinaryHasher, error) {
 		return nil, err
 	}
 	return &digest{
+		api:       api,
 		uapi:      uapi,
 		state:     newState(),
 		dsbyte:    0x06,
@@ -32,6 +33,7 @@ func New384(api frontend.API) (hash.BinaryHasher, error) {
 		return nil, err
 	}
 	return &digest{
+		api:       api,
 		uapi:      uapi,
 		state:     newState(),
 		dsbyte:    0x06,
@@ -49,6 +51,7 @@ func New512(api frontend.API) (hash.BinaryHasher, error) {
 		return nil, err
 	}
 	return &digest{
+		api:       api,
 		uapi:      uapi,
 		state:     newState(),
 		dsbyte:    0x06,
@@ -67,6 +70,7 @@ func NewLegacyKeccak256(api frontend.API) (hash.BinaryHasher, error) {
 		return nil, err
 	}
 	return &digest{
+		api:       api,
 		uapi:      uapi,
 		state:     newState(),
 		dsbyte:    0x01,
@@ -85,6 +89,7 @@ func NewLegacyKeccak512(api frontend.API) (hash.BinaryHasher, error) {
 		return nil, err
 	}
 	return &digest{
+		api:       api,
 		uapi:      uapi,
 		state:     newState(),
 		dsbyte:    0x01,
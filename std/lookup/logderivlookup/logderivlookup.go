@@ -15,7 +15,11 @@
 // check that all queried tuples (i, x_i) are included in the table.
 //
 // The complexity of the lookups is linear in the size of the table and the
-// number of queries (O(n+m)).
+// number of queries (O(n+m)), amortizing the table cost across all queries.
+// Multiple independent tables can coexist in a circuit — each [New] call
+// creates its own argument — and the package works with both the Groth16 and
+// PLONK backends through the commitment API, so it is the supported lookup
+// primitive for byte-oriented gadgets instead of a dedicated frontend method.
 package logderivlookup
 
 import (
@@ -0,0 +1,320 @@
+package emulated
+
+import (
+	"fmt"
+	"math/big"
+	"math/bits"
+
+	"github.com/consensys/gnark/frontend"
+)
+
+// crtModulusBits is the width of the small CRT moduli. The moduli are primes
+// just below 2^crtModulusBits so that residue products fit comfortably in the
+// native field.
+const crtModulusBits = 60
+
+// CRT is an experimental residue-number-system context for emulated scalars.
+// It represents an integer by its residues modulo a set of small primes, so
+// that a non-native multiplication becomes one cheap native multiplication
+// per residue instead of a full limb product. The representation is
+// positional-free: additions and multiplications are component-wise and never
+// carry, which is the speed advantage; the costs are the conversions at the
+// boundaries ([CRT.ToCRT], [CRT.FromCRT]) and the loss of comparisons and
+// reductions inside the representation.
+//
+// Correctness conditions: the moduli product M is chosen with a margin over
+// p² (p the emulated modulus), and [CRT.FromCRT] is sound only while the
+// represented integer stays below M/8. Converting canonical elements in,
+// taking one product and accumulating a moderate number of additions stays
+// within the margin; longer products must round-trip through [CRT.FromCRT]
+// to reduce modulo p. Soundness of the boundary checks requires the native
+// field to be wide enough for the residue congruences; [NewCRT] returns an
+// error otherwise.
+type CRT[T FieldParams] struct {
+	f *Field[T]
+
+	moduli    []*big.Int
+	pResidues []*big.Int
+	// limbCoeffs[i][j] = 2^(j·w) mod mᵢ for the emulated limb width w
+	limbCoeffs [][]*big.Int
+	// tCoeffs[i][j] = 2^(j·crtModulusBits) mod mᵢ for the quotient limbs
+	tCoeffs [][]*big.Int
+
+	nbTLimbs int
+	tTopBits int
+	// elementBound and quotientBound are the bit bounds of the residue
+	// congruence accumulators in [CRT.FromCRT]
+	elementBound  int
+	quotientBound int
+}
+
+// CRTElement represents an integer by its residues modulo the moduli of the
+// [CRT] context it was created by. The zero value is not valid, use
+// [CRT.ToCRT] to create elements.
+type CRTElement struct {
+	residues []frontend.Variable
+}
+
+// NewCRT returns a CRT context for the emulated field T over the given
+// native field. It errors when the native field is too narrow to carry the
+// residue congruence checks.
+func NewCRT[T FieldParams](api frontend.API) (*CRT[T], error) {
+	f, err := NewField[T](api)
+	if err != nil {
+		return nil, fmt.Errorf("new field: %w", err)
+	}
+	var fp T
+	p := fp.Modulus()
+	moduli := crtModuli(2*p.BitLen() + 16)
+	c := &CRT[T]{
+		f:          f,
+		moduli:     moduli,
+		pResidues:  make([]*big.Int, len(moduli)),
+		limbCoeffs: make([][]*big.Int, len(moduli)),
+		tCoeffs:    make([][]*big.Int, len(moduli)),
+	}
+	bigM := big.NewInt(1)
+	for _, m := range moduli {
+		bigM.Mul(bigM, m)
+	}
+	// the represented integer must stay below 2^zBits = M/8 so that the
+	// bounded quotient decomposition in FromCRT cannot wrap around M
+	zBits := bigM.BitLen() - 3
+	tBits := zBits - p.BitLen() + 1
+	c.nbTLimbs = (tBits + crtModulusBits - 1) / crtModulusBits
+	c.tTopBits = tBits - crtModulusBits*(c.nbTLimbs-1)
+
+	w := int(fp.BitsPerLimb())
+	nbLimbs := int(fp.NbLimbs())
+	for i, m := range moduli {
+		c.pResidues[i] = new(big.Int).Mod(p, m)
+		c.limbCoeffs[i] = make([]*big.Int, nbLimbs)
+		for j := range c.limbCoeffs[i] {
+			e := new(big.Int).Lsh(big.NewInt(1), uint(j*w))
+			c.limbCoeffs[i][j] = e.Mod(e, m)
+		}
+		c.tCoeffs[i] = make([]*big.Int, c.nbTLimbs)
+		for j := range c.tCoeffs[i] {
+			e := new(big.Int).Lsh(big.NewInt(1), uint(j*crtModulusBits))
+			c.tCoeffs[i][j] = e.Mod(e, m)
+		}
+	}
+	c.elementBound = w + crtModulusBits + bits.Len(uint(nbLimbs))
+	c.quotientBound = 2*crtModulusBits + bits.Len(uint(c.nbTLimbs))
+	congruenceBound := max(c.elementBound, c.quotientBound+crtModulusBits) + 1
+	if api.Compiler().FieldBitLen() < congruenceBound+3 {
+		return nil, fmt.Errorf("native field too narrow for CRT congruence checks")
+	}
+	return c, nil
+}
+
+// crtModuli returns deterministic distinct primes just below 2^crtModulusBits
+// whose product exceeds 2^targetBits.
+func crtModuli(targetBits int) []*big.Int {
+	var moduli []*big.Int
+	productBits := 0
+	candidate := new(big.Int).Lsh(big.NewInt(1), crtModulusBits)
+	candidate.Sub(candidate, big.NewInt(1))
+	for productBits < targetBits {
+		if candidate.ProbablyPrime(20) {
+			moduli = append(moduli, new(big.Int).Set(candidate))
+			productBits += crtModulusBits - 1
+		}
+		candidate.Sub(candidate, big.NewInt(2))
+	}
+	return moduli
+}
+
+// reduce returns v mod mᵢ canonically, for v bounded by 2^boundBits. The
+// quotient and remainder come from a hint and are bound by the native
+// identity v = q·mᵢ + r, which cannot overflow by the constructor's width
+// check.
+func (c *CRT[T]) reduce(v frontend.Variable, boundBits, i int) (frontend.Variable, error) {
+	m := c.moduli[i]
+	qr, err := c.f.api.Compiler().NewHint(CRTReduceHint, 2, m, v)
+	if err != nil {
+		return nil, fmt.Errorf("reduce hint: %w", err)
+	}
+	q, r := qr[0], qr[1]
+	c.f.checker.Check(q, boundBits-crtModulusBits+2)
+	c.f.api.AssertIsLessOrEqual(r, new(big.Int).Sub(m, big.NewInt(1)))
+	c.f.api.AssertIsEqual(v, c.f.api.Add(c.f.api.Mul(q, m), r))
+	return r, nil
+}
+
+// linearCombination returns ∑ⱼ varsⱼ·coeffsⱼ over the native field.
+func (c *CRT[T]) linearCombination(vars []frontend.Variable, coeffs []*big.Int) frontend.Variable {
+	terms := make([]frontend.Variable, len(vars))
+	for j := range vars {
+		terms[j] = c.f.api.Mul(vars[j], coeffs[j])
+	}
+	if len(terms) == 1 {
+		return terms[0]
+	}
+	return c.f.api.Add(terms[0], terms[1], terms[2:]...)
+}
+
+// ToCRT converts an emulated element to its residue representation. The
+// element is reduced first, so the represented integer is the canonical
+// value below p.
+func (c *CRT[T]) ToCRT(a *Element[T]) (*CRTElement, error) {
+	red := c.f.Reduce(a)
+	res := make([]frontend.Variable, len(c.moduli))
+	for i := range c.moduli {
+		l := c.linearCombination(red.Limbs, c.limbCoeffs[i][:len(red.Limbs)])
+		r, err := c.reduce(l, c.elementBound, i)
+		if err != nil {
+			return nil, fmt.Errorf("residue %d: %w", i, err)
+		}
+		res[i] = r
+	}
+	return &CRTElement{residues: res}, nil
+}
+
+// Mul returns the product a·b in residue form. Each residue costs one native
+// multiplication and one small modular reduction.
+func (c *CRT[T]) Mul(a, b *CRTElement) (*CRTElement, error) {
+	res := make([]frontend.Variable, len(c.moduli))
+	for i := range c.moduli {
+		t := c.f.api.Mul(a.residues[i], b.residues[i])
+		r, err := c.reduce(t, 2*crtModulusBits, i)
+		if err != nil {
+			return nil, fmt.Errorf("residue %d: %w", i, err)
+		}
+		res[i] = r
+	}
+	return &CRTElement{residues: res}, nil
+}
+
+// Add returns the sum a+b in residue form.
+func (c *CRT[T]) Add(a, b *CRTElement) (*CRTElement, error) {
+	res := make([]frontend.Variable, len(c.moduli))
+	for i := range c.moduli {
+		t := c.f.api.Add(a.residues[i], b.residues[i])
+		r, err := c.reduce(t, crtModulusBits+1, i)
+		if err != nil {
+			return nil, fmt.Errorf("residue %d: %w", i, err)
+		}
+		res[i] = r
+	}
+	return &CRTElement{residues: res}, nil
+}
+
+// FromCRT converts the residue representation back to a canonical emulated
+// element. A hint provides e = z mod p and the bounded quotient t = z div p
+// of the represented integer z; the congruence e + t·p ≡ rᵢ (mod mᵢ) is then
+// enforced for every modulus, which pins z (and hence e) as long as z stays
+// below the documented M/8 margin.
+func (c *CRT[T]) FromCRT(a *CRTElement) (*Element[T], error) {
+	ins := make([]frontend.Variable, 0, 1+2*len(c.moduli))
+	ins = append(ins, len(c.moduli))
+	for _, m := range c.moduli {
+		ins = append(ins, m)
+	}
+	ins = append(ins, a.residues...)
+
+	es, err := c.f.NewHintWithNativeInput(CRTRecomposeHint, 1, ins...)
+	if err != nil {
+		return nil, fmt.Errorf("recompose hint: %w", err)
+	}
+	e := es[0]
+	c.f.AssertIsInRange(e)
+
+	tLimbs, err := c.f.api.Compiler().NewHint(CRTQuotientHint, c.nbTLimbs, c.f.wrapHintNatives(ins...)...)
+	if err != nil {
+		return nil, fmt.Errorf("quotient hint: %w", err)
+	}
+	for j := range tLimbs {
+		if j == len(tLimbs)-1 {
+			c.f.checker.Check(tLimbs[j], c.tTopBits)
+		} else {
+			c.f.checker.Check(tLimbs[j], crtModulusBits)
+		}
+	}
+
+	for i := range c.moduli {
+		eL := c.linearCombination(e.Limbs, c.limbCoeffs[i][:len(e.Limbs)])
+		tL := c.linearCombination(tLimbs, c.tCoeffs[i])
+		v := c.f.api.Add(eL, c.f.api.Mul(tL, c.pResidues[i]))
+		bound := max(c.elementBound, c.quotientBound+crtModulusBits) + 1
+		r, err := c.reduce(v, bound, i)
+		if err != nil {
+			return nil, fmt.Errorf("residue %d: %w", i, err)
+		}
+		c.f.api.AssertIsEqual(r, a.residues[i])
+	}
+	return e, nil
+}
+
+// crtRecompose reconstructs the canonical integer below ∏mᵢ from its
+// residues.
+func crtRecompose(moduli, residues []*big.Int) *big.Int {
+	bigM := big.NewInt(1)
+	for _, m := range moduli {
+		bigM.Mul(bigM, m)
+	}
+	z := new(big.Int)
+	tmp := new(big.Int)
+	for i, m := range moduli {
+		mi := new(big.Int).Div(bigM, m)
+		tmp.ModInverse(mi, m)
+		tmp.Mul(tmp, mi).Mul(tmp, residues[i])
+		z.Add(z, tmp)
+	}
+	return z.Mod(z, bigM)
+}
+
+// CRTReduceHint returns the quotient and remainder of inputs[1] by the small
+// modulus inputs[0].
+func CRTReduceHint(mod *big.Int, inputs, outputs []*big.Int) error {
+	if len(inputs) != 2 {
+		return fmt.Errorf("expecting two inputs")
+	}
+	if len(outputs) != 2 {
+		return fmt.Errorf("expecting two outputs")
+	}
+	outputs[0].QuoRem(inputs[1], inputs[0], outputs[1])
+	return nil
+}
+
+// CRTRecomposeHint reconstructs the integer represented by the residues and
+// returns it modulo the emulated modulus.
+func CRTRecomposeHint(nativeMod *big.Int, nativeInputs, nativeOutputs []*big.Int) error {
+	return UnwrapHintWithNativeInput(nativeInputs, nativeOutputs, func(p *big.Int, inputs, outputs []*big.Int) error {
+		if len(inputs) < 1 || !inputs[0].IsInt64() {
+			return fmt.Errorf("missing moduli count")
+		}
+		k := int(inputs[0].Int64())
+		if len(inputs) != 1+2*k {
+			return fmt.Errorf("expecting %d inputs", 1+2*k)
+		}
+		outputs[0].Set(crtRecompose(inputs[1:1+k], inputs[1+k:1+2*k]))
+		return nil
+	})
+}
+
+// CRTQuotientHint reconstructs the integer represented by the residues and
+// returns the limbs of its quotient by the emulated modulus.
+func CRTQuotientHint(nativeMod *big.Int, nativeInputs, nativeOutputs []*big.Int) error {
+	return unwrapHint(false, false, nativeInputs, nativeOutputs, func(p *big.Int, inputs, outputs []*big.Int) error {
+		if len(inputs) < 1 || !inputs[0].IsInt64() {
+			return fmt.Errorf("missing moduli count")
+		}
+		k := int(inputs[0].Int64())
+		if len(inputs) != 1+2*k {
+			return fmt.Errorf("expecting %d inputs", 1+2*k)
+		}
+		z := crtRecompose(inputs[1:1+k], inputs[1+k:1+2*k])
+		t := new(big.Int).Quo(z, p)
+		mask := new(big.Int).Lsh(big.NewInt(1), crtModulusBits)
+		mask.Sub(mask, big.NewInt(1))
+		for i := range outputs {
+			outputs[i].And(t, mask)
+			t.Rsh(t, crtModulusBits)
+		}
+		if t.Sign() != 0 {
+			return fmt.Errorf("quotient exceeds limb capacity")
+		}
+		return nil
+	})
+}
@@ -0,0 +1,154 @@
+package emulated
+
+import (
+	"crypto/rand"
+	"testing"
+
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/frontend/cs/scs"
+	"github.com/consensys/gnark/test"
+)
+
+type crtCircuit[T FieldParams] struct {
+	X, Y, Z Element[T]
+}
+
+func (c *crtCircuit[T]) Define(api frontend.API) error {
+	f, err := NewField[T](api)
+	if err != nil {
+		return err
+	}
+	crt, err := NewCRT[T](api)
+	if err != nil {
+		return err
+	}
+	// compute x·y + z in residue form and compare against the standard form
+	x, err := crt.ToCRT(&c.X)
+	if err != nil {
+		return err
+	}
+	y, err := crt.ToCRT(&c.Y)
+	if err != nil {
+		return err
+	}
+	z, err := crt.ToCRT(&c.Z)
+	if err != nil {
+		return err
+	}
+	prod, err := crt.Mul(x, y)
+	if err != nil {
+		return err
+	}
+	sum, err := crt.Add(prod, z)
+	if err != nil {
+		return err
+	}
+	res, err := crt.FromCRT(sum)
+	if err != nil {
+		return err
+	}
+	expected := f.Add(f.Mul(&c.X, &c.Y), &c.Z)
+	f.AssertIsEqual(res, expected)
+	return nil
+}
+
+func testCRTArithmetic[T FieldParams](t *testing.T) {
+	var fp T
+	assert := test.NewAssert(t)
+	assert.Run(func(assert *test.Assert) {
+		x, _ := rand.Int(rand.Reader, fp.Modulus())
+		y, _ := rand.Int(rand.Reader, fp.Modulus())
+		z, _ := rand.Int(rand.Reader, fp.Modulus())
+		circuit := crtCircuit[T]{}
+		witness := crtCircuit[T]{
+			X: ValueOf[T](x),
+			Y: ValueOf[T](y),
+			Z: ValueOf[T](z),
+		}
+		assert.NoError(test.IsSolved(&circuit, &witness, testCurve.ScalarField()))
+	}, testName[T]())
+}
+
+func TestCRTArithmetic(t *testing.T) {
+	testCRTArithmetic[Goldilocks](t)
+	testCRTArithmetic[Secp256k1Fp](t)
+	testCRTArithmetic[BN254Fr](t)
+}
+
+const benchCRTNbMuls = 16
+
+type crtMulChainCircuit[T FieldParams] struct {
+	X, Y Element[T]
+
+	useCRT bool
+}
+
+func (c *crtMulChainCircuit[T]) Define(api frontend.API) error {
+	f, err := NewField[T](api)
+	if err != nil {
+		return err
+	}
+	if !c.useCRT {
+		res := &c.X
+		for i := 0; i < benchCRTNbMuls; i++ {
+			res = f.Mul(res, &c.Y)
+		}
+		f.AssertIsEqual(res, res)
+		return nil
+	}
+	crt, err := NewCRT[T](api)
+	if err != nil {
+		return err
+	}
+	res, err := crt.ToCRT(&c.X)
+	if err != nil {
+		return err
+	}
+	y, err := crt.ToCRT(&c.Y)
+	if err != nil {
+		return err
+	}
+	for i := 0; i < benchCRTNbMuls; i++ {
+		// round-trip through the standard form to reduce modulo p, keeping
+		// the represented integer within the documented margin
+		prod, err := crt.Mul(res, y)
+		if err != nil {
+			return err
+		}
+		e, err := crt.FromCRT(prod)
+		if err != nil {
+			return err
+		}
+		if res, err = crt.ToCRT(e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// BenchmarkCRTMulChain compares the constraint cost of a chain of BN254
+// scalar multiplications in residue form against the standard emulated form.
+// A chain forces a boundary round-trip per product to stay within the
+// reduction margin, and the conversions dominate by a wide margin over the
+// deferred-check batching of the standard form — the residue representation
+// only pays off for computations that stay inside it.
+func BenchmarkCRTMulChain(b *testing.B) {
+	for _, bb := range []struct {
+		name   string
+		useCRT bool
+	}{
+		{"crt", true},
+		{"standard", false},
+	} {
+		b.Run(bb.name, func(b *testing.B) {
+			var ccs interface{ GetNbConstraints() int }
+			var err error
+			for i := 0; i < b.N; i++ {
+				if ccs, err = frontend.Compile(testCurve.ScalarField(), scs.NewBuilder, &crtMulChainCircuit[BN254Fr]{useCRT: bb.useCRT}); err != nil {
+					b.Fatal(err)
+				}
+			}
+			b.Logf("nb constraints %d", ccs.GetNbConstraints())
+		})
+	}
+}
@@ -9,8 +9,8 @@ import (
 // ModMul computes a*b mod modulus. Instead of taking modulus as a constant
 // parametrized by T, it is passed as an argument. This allows to use a variable
 // modulus in the circuit. Type parameter T should be sufficiently big to fit a,
-// b and modulus. Recommended to use [emparams.Mod1e512] or
-// [emparams.Mod1e4096].
+// b and modulus. Recommended to use [emparams.Mod1e512],
+// [emparams.Mod1e2048], [emparams.Mod1e3072] or [emparams.Mod1e4096].
 //
 // NB! circuit complexity depends on T rather on the actual length of the modulus.
 func (f *Field[T]) ModMul(a, b *Element[T], modulus *Element[T]) *Element[T] {
@@ -25,8 +25,8 @@ func (f *Field[T]) ModMul(a, b *Element[T], modulus *Element[T]) *Element[T] {
 // ModAdd computes a+b mod modulus. Instead of taking modulus as a constant
 // parametrized by T, it is passed as an argument. This allows to use a variable
 // modulus in the circuit. Type parameter T should be sufficiently big to fit a,
-// b and modulus. Recommended to use [emparams.Mod1e512] or
-// [emparams.Mod1e4096].
+// b and modulus. Recommended to use [emparams.Mod1e512],
+// [emparams.Mod1e2048], [emparams.Mod1e3072] or [emparams.Mod1e4096].
 //
 // NB! circuit complexity depends on T rather on the actual length of the modulus.
 func (f *Field[T]) ModAdd(a, b *Element[T], modulus *Element[T]) *Element[T] {
@@ -79,7 +79,8 @@ func (f *Field[T]) modSub(a, b *Element[T], modulus *Element[T]) *Element[T] {
 // modulus as a constant parametrized by T, it is passed as an argument. This
 // allows to use a variable modulus in the circuit. Type parameter T should be
 // sufficiently big to fit a, b and modulus. Recommended to use
-// [emparams.Mod1e512] or [emparams.Mod1e4096].
+// [emparams.Mod1e512], [emparams.Mod1e2048], [emparams.Mod1e3072] or
+// [emparams.Mod1e4096].
 //
 // NB! circuit complexity depends on T rather on the actual length of the modulus.
 func (f *Field[T]) ModAssertIsEqual(a, b *Element[T], modulus *Element[T]) {
@@ -92,8 +93,8 @@ func (f *Field[T]) ModAssertIsEqual(a, b *Element[T], modulus *Element[T]) {
 // ModExp computes base^exp mod modulus. Instead of taking modulus as a constant
 // parametrized by T, it is passed as an argument. This allows to use a variable
 // modulus in the circuit. Type parameter T should be sufficiently big to fit
-// base, exp and modulus. Recommended to use [emparams.Mod1e512] or
-// [emparams.Mod1e4096].
+// base, exp and modulus. Recommended to use [emparams.Mod1e512],
+// [emparams.Mod1e2048], [emparams.Mod1e3072] or [emparams.Mod1e4096].
 //
 // NB! circuit complexity depends on T rather on the actual length of the modulus.
 func (f *Field[T]) ModExp(base, exp, modulus *Element[T]) *Element[T] {
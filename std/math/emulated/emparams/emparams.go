@@ -313,6 +313,40 @@ type STARKCurveFr struct{ fourLimbPrimeField }
 
 func (fp STARKCurveFr) Modulus() *big.Int { return ecc.STARK_CURVE.ScalarField() }
 
+// Ed25519Fp provides type parametrization for field emulation:
+//   - limbs: 4
+//   - limb width: 64 bits
+//
+// The prime modulus for type parametrisation is:
+//
+//	0x7fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffed (base 16)
+//	57896044618658097711785492504343953926634992332820282019728792003956564819949 (base 10)
+//
+// This is the base field 2²⁵⁵-19 of the Ed25519 twisted Edwards curve.
+type Ed25519Fp struct{ fourLimbPrimeField }
+
+func (fp Ed25519Fp) Modulus() *big.Int {
+	val, _ := new(big.Int).SetString("7fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffed", 16)
+	return val
+}
+
+// Ed25519Fr provides type parametrization for field emulation:
+//   - limbs: 4
+//   - limb width: 64 bits
+//
+// The prime modulus for type parametrisation is:
+//
+//	0x1000000000000000000000000000000014def9dea2f79cd65812631a5cf5d3ed (base 16)
+//	7237005577332262213973186563042994240857116359379907606001950938285454250989 (base 10)
+//
+// This is the prime-order subgroup size of the Ed25519 twisted Edwards curve.
+type Ed25519Fr struct{ fourLimbPrimeField }
+
+func (fp Ed25519Fr) Modulus() *big.Int {
+	val, _ := new(big.Int).SetString("1000000000000000000000000000000014def9dea2f79cd65812631a5cf5d3ed", 16)
+	return val
+}
+
 // Mod1e4096 provides type parametrization for emulated aritmetic:
 //   - limbs: 64
 //   - limb width: 64 bits
@@ -332,6 +366,44 @@ func (Mod1e4096) Modulus() *big.Int {
 	return val
 }
 
+// Mod1e3072 provides type parametrization for emulated arithmetic:
+//   - limbs: 48
+//   - limb width: 64 bits
+//
+// The modulus for type parametrisation is 2^3072-1.
+//
+// This is non-prime modulus. It is mainly targeted for using variable-modulus
+// operations (ModAdd, ModMul, ModExp, ModAssertIsEqual) for variable modulus
+// arithmetic.
+type Mod1e3072 struct{}
+
+func (Mod1e3072) NbLimbs() uint     { return 48 }
+func (Mod1e3072) BitsPerLimb() uint { return 64 }
+func (Mod1e3072) IsPrime() bool     { return false }
+func (Mod1e3072) Modulus() *big.Int {
+	val, _ := new(big.Int).SetString("ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff", 16)
+	return val
+}
+
+// Mod1e2048 provides type parametrization for emulated arithmetic:
+//   - limbs: 32
+//   - limb width: 64 bits
+//
+// The modulus for type parametrisation is 2^2048-1.
+//
+// This is non-prime modulus. It is mainly targeted for using variable-modulus
+// operations (ModAdd, ModMul, ModExp, ModAssertIsEqual) for variable modulus
+// arithmetic.
+type Mod1e2048 struct{}
+
+func (Mod1e2048) NbLimbs() uint     { return 32 }
+func (Mod1e2048) BitsPerLimb() uint { return 64 }
+func (Mod1e2048) IsPrime() bool     { return false }
+func (Mod1e2048) Modulus() *big.Int {
+	val, _ := new(big.Int).SetString("ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff", 16)
+	return val
+}
+
 // Mod1e512 provides type parametrization for emulated arithmetic:
 //   - limbs: 8
 //   - limb width: 64 bits
@@ -401,3 +473,19 @@ type KoalaBear struct{ oneLimbPrimeField }
 
 func (KoalaBear) BitsPerLimb() uint { return 31 }
 func (KoalaBear) Modulus() *big.Int { return big.NewInt(2130706433) }
+
+// Mersenne31 provides type parametrization for field emulation:
+//   - limbs: 1
+//   - limb width: 31 bits
+//
+// The prime modulus for type parametrisation is:
+//
+//	2^31-1
+//	0x7fffffff (base 16)
+//	2147483647 (base 10)
+//
+// The field has 2-adicity of 1.
+type Mersenne31 struct{ oneLimbPrimeField }
+
+func (Mersenne31) BitsPerLimb() uint { return 31 }
+func (Mersenne31) Modulus() *big.Int { return big.NewInt(2147483647) }
@@ -25,6 +25,9 @@ func GetHints() []solver.Hint {
 		mulHint,
 		subPaddingHint,
 		polyMvHint,
+		CRTReduceHint,
+		CRTRecomposeHint,
+		CRTQuotientHint,
 	}
 }
 
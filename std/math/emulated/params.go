@@ -18,7 +18,7 @@ import (
 //   - [P256Fp] and [P256Fr]
 //   - [P384Fp] and [P384Fr]
 //   - [STARKCurveFp] and [STARKCurveFr]
-//   - [BabyBear] and [KoalaBear]
+//   - [BabyBear], [KoalaBear] and [Mersenne31]
 type FieldParams interface {
 	NbLimbs() uint     // number of limbs to represent field element
 	BitsPerLimb() uint // number of bits per limb. Top limb may contain less than limbSize bits.
@@ -45,4 +45,5 @@ type (
 	STARKCurveFr = emparams.STARKCurveFr
 	BabyBear     = emparams.BabyBear
 	KoalaBear    = emparams.KoalaBear
+	Mersenne31   = emparams.Mersenne31
 )
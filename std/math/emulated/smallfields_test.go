@@ -0,0 +1,72 @@
+package emulated
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/test"
+)
+
+type smallFieldArithCircuit[T FieldParams] struct {
+	X, Y                 Element[T]
+	Sum, Diff, Prod, Quo Element[T]
+}
+
+func (c *smallFieldArithCircuit[T]) Define(api frontend.API) error {
+	f, err := NewField[T](api)
+	if err != nil {
+		return err
+	}
+	f.AssertIsEqual(f.Add(&c.X, &c.Y), &c.Sum)
+	f.AssertIsEqual(f.Sub(&c.X, &c.Y), &c.Diff)
+	f.AssertIsEqual(f.Mul(&c.X, &c.Y), &c.Prod)
+	f.AssertIsEqual(f.Div(&c.X, &c.Y), &c.Quo)
+	return nil
+}
+
+// testSmallFieldArith checks the emulated arithmetic over a single-limb small
+// field against big.Int reference values, including the boundary cases 0, 1
+// and q-1.
+func testSmallFieldArith[T FieldParams](t *testing.T) {
+	var fp T
+	assert := test.NewAssert(t)
+	q := fp.Modulus()
+	qMinusOne := new(big.Int).Sub(q, big.NewInt(1))
+	vectors := [][2]*big.Int{
+		{big.NewInt(0), big.NewInt(1)},
+		{big.NewInt(1), qMinusOne},
+		{qMinusOne, qMinusOne},
+		{big.NewInt(0x1234567), big.NewInt(0x89abcd)},
+	}
+	for _, v := range vectors {
+		x, y := v[0], v[1]
+		assert.Run(func(assert *test.Assert) {
+			sum := new(big.Int).Add(x, y)
+			sum.Mod(sum, q)
+			diff := new(big.Int).Sub(x, y)
+			diff.Mod(diff, q)
+			prod := new(big.Int).Mul(x, y)
+			prod.Mod(prod, q)
+			quo := new(big.Int).ModInverse(y, q)
+			quo.Mul(quo, x).Mod(quo, q)
+			circuit := smallFieldArithCircuit[T]{}
+			witness := smallFieldArithCircuit[T]{
+				X:    ValueOf[T](x),
+				Y:    ValueOf[T](y),
+				Sum:  ValueOf[T](sum),
+				Diff: ValueOf[T](diff),
+				Prod: ValueOf[T](prod),
+				Quo:  ValueOf[T](quo),
+			}
+			assert.NoError(test.IsSolved(&circuit, &witness, testCurve.ScalarField()))
+		}, fmt.Sprintf("%s/x=%s,y=%s", testName[T](), x, y))
+	}
+}
+
+func TestSmallFieldArith(t *testing.T) {
+	testSmallFieldArith[BabyBear](t)
+	testSmallFieldArith[KoalaBear](t)
+	testSmallFieldArith[Mersenne31](t)
+}
@@ -49,6 +49,13 @@ func TestEvalPoly(t *testing.T) {
 	testEvalPoly[emparams.BN254Fr](t, []int64{1, 2, 3, 4}, 5, 586)
 }
 
+func TestEvalPolySmallField(t *testing.T) {
+	// coefficients and evaluation point exceed the small moduli so the
+	// evaluation exercises the single-limb reduction path
+	testEvalPoly[emparams.BabyBear](t, []int64{2013265920, 1000000007, 999999937}, 1234567, 416743563)
+	testEvalPoly[emparams.Mersenne31](t, []int64{2013265920, 1000000007, 999999937}, 1234567, 1691076514)
+}
+
 type evalMultiLinCircuit[FR emulated.FieldParams] struct {
 	M          []emulated.Element[FR] `gnark:",public"`
 	At         []emulated.Element[FR] `gnark:",secret"`
@@ -0,0 +1,70 @@
+package uints
+
+// ByteOrder defines how the byte-oriented witness constructors map a value to
+// its byte wires. External serializers disagree on the ordering, so the
+// WithOrder constructor variants allow choosing it explicitly instead of
+// relying on the package defaults.
+type ByteOrder uint8
+
+const (
+	// LittleEndian stores the least significant byte first. It is the
+	// default of [NewU32] and [NewU64].
+	LittleEndian ByteOrder = iota
+	// BigEndian stores the most significant byte first. For byte streams it
+	// keeps the slice as given and is the default of [NewU8Array].
+	BigEndian
+)
+
+// NewU8ArrayWithOrder returns the byte stream in the given order: as given
+// for [BigEndian] (matching [NewU8Array]) and reversed for [LittleEndian].
+func NewU8ArrayWithOrder(v []uint8, order ByteOrder) []U8 {
+	ret := NewU8Array(v)
+	if order == LittleEndian {
+		for i, j := 0, len(ret)-1; i < j; i, j = i+1, j-1 {
+			ret[i], ret[j] = ret[j], ret[i]
+		}
+	}
+	return ret
+}
+
+// NewU32WithOrder returns the value as bytes in the given order. With
+// [LittleEndian] it is equivalent to [NewU32].
+func NewU32WithOrder(v uint32, order ByteOrder) U32 {
+	ret := NewU32(v)
+	if order == BigEndian {
+		ret[0], ret[1], ret[2], ret[3] = ret[3], ret[2], ret[1], ret[0]
+	}
+	return ret
+}
+
+// NewU64WithOrder returns the value as bytes in the given order. With
+// [LittleEndian] it is equivalent to [NewU64].
+func NewU64WithOrder(v uint64, order ByteOrder) U64 {
+	ret := NewU64(v)
+	if order == BigEndian {
+		for i, j := 0, len(ret)-1; i < j; i, j = i+1, j-1 {
+			ret[i], ret[j] = ret[j], ret[i]
+		}
+	}
+	return ret
+}
+
+// NewU32ArrayWithOrder returns the values as bytes in the given order. See
+// [NewU32WithOrder].
+func NewU32ArrayWithOrder(v []uint32, order ByteOrder) []U32 {
+	ret := make([]U32, len(v))
+	for i := range v {
+		ret[i] = NewU32WithOrder(v[i], order)
+	}
+	return ret
+}
+
+// NewU64ArrayWithOrder returns the values as bytes in the given order. See
+// [NewU64WithOrder].
+func NewU64ArrayWithOrder(v []uint64, order ByteOrder) []U64 {
+	ret := make([]U64, len(v))
+	for i := range v {
+		ret[i] = NewU64WithOrder(v[i], order)
+	}
+	return ret
+}
@@ -0,0 +1,45 @@
+package uints
+
+import (
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/test"
+)
+
+type byteOrderCirc struct {
+	In    U64
+	Bytes [8]U8
+}
+
+func (c *byteOrderCirc) Define(api frontend.API) error {
+	uapi, err := New[U64](api)
+	if err != nil {
+		return err
+	}
+	for i := range c.Bytes {
+		uapi.ByteAssertEq(c.In[i], c.Bytes[i])
+	}
+	return nil
+}
+
+func TestByteOrder(t *testing.T) {
+	assert := test.NewAssert(t)
+	var err error
+	// little endian keeps the default wire order, big endian reverses it
+	le := [8]uint8{0xef, 0xcd, 0xab, 0x89, 0x67, 0x45, 0x23, 0x01}
+	be := [8]uint8{0x01, 0x23, 0x45, 0x67, 0x89, 0xab, 0xcd, 0xef}
+	var leBytes, beBytes [8]U8
+	copy(leBytes[:], NewU8Array(le[:]))
+	copy(beBytes[:], NewU8Array(be[:]))
+	err = test.IsSolved(&byteOrderCirc{}, &byteOrderCirc{In: NewU64WithOrder(0x0123456789abcdef, LittleEndian), Bytes: leBytes}, ecc.BN254.ScalarField())
+	assert.NoError(err)
+	err = test.IsSolved(&byteOrderCirc{}, &byteOrderCirc{In: NewU64WithOrder(0x0123456789abcdef, BigEndian), Bytes: beBytes}, ecc.BN254.ScalarField())
+	assert.NoError(err)
+	// the byte stream constructor reverses for little endian
+	var leStream [8]U8
+	copy(leStream[:], NewU8ArrayWithOrder(be[:], LittleEndian))
+	err = test.IsSolved(&byteOrderCirc{}, &byteOrderCirc{In: NewU64(0x0123456789abcdef), Bytes: leStream}, ecc.BN254.ScalarField())
+	assert.NoError(err)
+}
@@ -1,2 +1,10 @@
 // Package recursion provides in-circuit verifiers for different proofs systems.
+//
+// Besides the verifier subpackages, the package provides the building blocks
+// for matching the native prover's Fiat-Shamir transcript byte-for-byte
+// inside a recursive verifier: [NewShort] wraps a native hash so its digests
+// fit the target field, [NewHash] is the in-circuit counterpart and
+// [NewTranscript] builds a fiat-shamir transcript over it for binding
+// commitments, points and field elements with deterministic encoding across
+// curves.
 package recursion
@@ -1,2 +1,8 @@
 // Package groth16 provides in-circuit Groth16 verifier.
+//
+// The verifier works both over the 2-chain curves with native field
+// arithmetic and over emulated pairings (e.g. a BN254 proof verified inside
+// a BN254 circuit via [sw_bn254], enabling proof aggregation on Ethereum).
+// The [ValueOfProof], [ValueOfVerifyingKey] and [ValueOfWitness]
+// constructors assign the native objects to the typed circuit witnesses.
 package groth16
@@ -1,5 +1,11 @@
 // Package plonk implements in-circuit PLONK verifier.
 //
+// The package provides the [VerifyingKey], [Proof] and [Witness] circuit
+// types and a [Verifier] whose AssertProof method verifies a native PLONK
+// proof inside another circuit. It is built on the generic [algebra.Curve]
+// and [algebra.Pairing] interfaces and the KZG gadget, so the same verifier
+// works for all supported inner/outer curve combinations.
+//
 // NB! The circuit allows verifying proofs of PLONK circuits of size up to 2**30
 // constraints.
 package plonk
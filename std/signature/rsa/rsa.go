@@ -0,0 +1,243 @@
+// Package rsa implements in-circuit RSA signature verification.
+//
+// The gadget verifies PKCS#1 v1.5 and PSS signatures over SHA-256 digests
+// using variable-modulus emulated arithmetic, so a single compiled circuit
+// works for any public key of the chosen size. The type parameter T only
+// fixes the capacity of the emulation and should be the smallest of
+// [emparams.Mod1e2048], [emparams.Mod1e3072] and [emparams.Mod1e4096] fitting
+// the modulus. The public exponent is a compile-time constant of the
+// verifier, so the exponentiation costs one modular multiplication per bit
+// and per set bit of the exponent (18 for the common F4 exponent 65537).
+package rsa
+
+import (
+	cryptorsa "crypto/rsa"
+	"fmt"
+	"math/big"
+
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/hash/sha2"
+	"github.com/consensys/gnark/std/math/bits"
+	"github.com/consensys/gnark/std/math/emulated"
+	"github.com/consensys/gnark/std/math/uints"
+)
+
+const (
+	// hLen is the byte size of the SHA-256 digests the gadget is wired to.
+	hLen = 32
+	// sLen is the PSS salt byte size, fixed to the digest size as in
+	// [crypto/rsa.PSSSaltLengthEqualsHash].
+	sLen = hLen
+)
+
+// pkcs1v15Prefix is the ASN.1 DigestInfo prefix for SHA-256.
+var pkcs1v15Prefix = []byte{0x30, 0x31, 0x30, 0x0d, 0x06, 0x09, 0x60, 0x86, 0x48, 0x01, 0x65, 0x03, 0x04, 0x02, 0x01, 0x05, 0x00, 0x04, 0x20}
+
+// PublicKey is the RSA public key. The exponent is a compile-time constant of
+// the [Verifier].
+type PublicKey[T emulated.FieldParams] struct {
+	N emulated.Element[T]
+}
+
+// ValueOfPublicKey initializes the public key witness from a native public
+// key.
+func ValueOfPublicKey[T emulated.FieldParams](pk *cryptorsa.PublicKey) PublicKey[T] {
+	return PublicKey[T]{N: emulated.ValueOf[T](pk.N)}
+}
+
+// Signature is an RSA signature.
+type Signature[T emulated.FieldParams] struct {
+	S emulated.Element[T]
+}
+
+// ValueOfSignature initializes the signature witness from native signature
+// bytes.
+func ValueOfSignature[T emulated.FieldParams](sig []byte) Signature[T] {
+	return Signature[T]{S: emulated.ValueOf[T](new(big.Int).SetBytes(sig))}
+}
+
+// Verifier verifies RSA signatures for moduli of modBits bits with the public
+// exponent e.
+type Verifier[T emulated.FieldParams] struct {
+	api frontend.API
+	f   *emulated.Field[T]
+	e   uint
+	k   int // modulus size in bytes
+}
+
+// NewVerifier returns a verifier for signatures with moduli of modBits bits
+// and the public exponent e. modBits must be a multiple of 8 fitting the
+// emulation capacity of T.
+func NewVerifier[T emulated.FieldParams](api frontend.API, modBits int, e uint) (*Verifier[T], error) {
+	var fp T
+	if modBits%8 != 0 {
+		return nil, fmt.Errorf("modulus size %d is not a multiple of 8", modBits)
+	}
+	if uint(modBits) > fp.NbLimbs()*fp.BitsPerLimb() {
+		return nil, fmt.Errorf("modulus size %d exceeds emulation capacity %d", modBits, fp.NbLimbs()*fp.BitsPerLimb())
+	}
+	if e < 3 || e%2 == 0 {
+		return nil, fmt.Errorf("public exponent must be odd and at least 3")
+	}
+	f, err := emulated.NewField[T](api)
+	if err != nil {
+		return nil, fmt.Errorf("new field: %w", err)
+	}
+	return &Verifier[T]{api: api, f: f, e: e, k: modBits / 8}, nil
+}
+
+// expMod computes base^e mod n with a square-and-multiply ladder over the
+// constant bits of the public exponent.
+func (v *Verifier[T]) expMod(base, n *emulated.Element[T]) *emulated.Element[T] {
+	var res *emulated.Element[T]
+	b := base
+	for e := v.e; e > 0; e >>= 1 {
+		if e&1 == 1 {
+			if res == nil {
+				res = b
+			} else {
+				res = v.f.ModMul(res, b, n)
+			}
+		}
+		if e > 1 {
+			b = v.f.ModMul(b, b, n)
+		}
+	}
+	return res
+}
+
+// emBytes returns the big-endian bytes of the encoded message s^e mod n,
+// together with its raw little-endian bits. The bytes beyond the modulus size
+// are constrained to be zero.
+func (v *Verifier[T]) emBytes(sig Signature[T], pub PublicKey[T]) ([]frontend.Variable, []frontend.Variable) {
+	m := v.expMod(&sig.S, &pub.N)
+	bts := v.f.ToBitsCanonical(m)
+	for i := 8 * v.k; i < len(bts); i++ {
+		v.api.AssertIsEqual(bts[i], 0)
+	}
+	em := make([]frontend.Variable, v.k)
+	for i := 0; i < v.k; i++ {
+		em[v.k-1-i] = bits.FromBinary(v.api, bts[8*i:8*i+8])
+	}
+	return em, bts
+}
+
+// AssertVerifyPKCS1v15 asserts that the signature is a valid PKCS#1 v1.5
+// signature of the SHA-256 digest under the public key. The digest is hashed
+// outside the gadget; digest must have 32 bytes.
+func (v *Verifier[T]) AssertVerifyPKCS1v15(pub PublicKey[T], sig Signature[T], digest []uints.U8) error {
+	if len(digest) != hLen {
+		return fmt.Errorf("digest size %d doesn't match hash size %d", len(digest), hLen)
+	}
+	tLen := len(pkcs1v15Prefix) + hLen
+	if v.k < tLen+11 {
+		return fmt.Errorf("message too long for modulus size")
+	}
+	em, _ := v.emBytes(sig, pub)
+
+	// EM = 0x00 || 0x01 || 0xff…0xff || 0x00 || DigestInfo || digest
+	v.api.AssertIsEqual(em[0], 0)
+	v.api.AssertIsEqual(em[1], 1)
+	for i := 2; i < v.k-tLen-1; i++ {
+		v.api.AssertIsEqual(em[i], 0xff)
+	}
+	v.api.AssertIsEqual(em[v.k-tLen-1], 0)
+	for i, b := range pkcs1v15Prefix {
+		v.api.AssertIsEqual(em[v.k-tLen+i], b)
+	}
+	for i := 0; i < hLen; i++ {
+		v.api.AssertIsEqual(em[v.k-hLen+i], digest[i].Val)
+	}
+	return nil
+}
+
+// xorBits returns the bits of the xor of two bytes given the bits of the
+// first and the value of the second.
+func (v *Verifier[T]) xorBits(aBits []frontend.Variable, b frontend.Variable) []frontend.Variable {
+	bBits := bits.ToBinary(v.api, b, bits.WithNbDigits(8))
+	res := make([]frontend.Variable, 8)
+	for i := range res {
+		res[i] = v.api.Sub(v.api.Add(aBits[i], bBits[i]), v.api.Mul(2, v.api.Mul(aBits[i], bBits[i])))
+	}
+	return res
+}
+
+// AssertVerifyPSS asserts that the signature is a valid RSASSA-PSS signature
+// of the SHA-256 digest mHash under the public key, with MGF1 over SHA-256
+// and the salt size equal to the digest size as in
+// [crypto/rsa.PSSSaltLengthEqualsHash]. mHash must have 32 bytes.
+func (v *Verifier[T]) AssertVerifyPSS(pub PublicKey[T], sig Signature[T], mHash []uints.U8) error {
+	if len(mHash) != hLen {
+		return fmt.Errorf("digest size %d doesn't match hash size %d", len(mHash), hLen)
+	}
+	emLen := v.k
+	if emLen < hLen+sLen+2 {
+		return fmt.Errorf("message too long for modulus size")
+	}
+	em, emBits := v.emBytes(sig, pub)
+
+	// EM = maskedDB || H || 0xbc with emBits = modBits-1, so the leftmost bit
+	// of maskedDB is zero
+	v.api.AssertIsEqual(em[emLen-1], 0xbc)
+	v.api.AssertIsEqual(emBits[8*v.k-1], 0)
+	h := em[emLen-hLen-1 : emLen-1]
+	dbLen := emLen - hLen - 1
+
+	hU8 := make([]uints.U8, hLen)
+	for i := range hU8 {
+		hU8[i] = uints.U8{Val: h[i]}
+	}
+
+	// DB = maskedDB ⊕ MGF1(H, dbLen), with the leftmost bit cleared
+	db := make([]frontend.Variable, dbLen)
+	for block := 0; block*hLen < dbLen; block++ {
+		hasher, err := sha2.New(v.api)
+		if err != nil {
+			return fmt.Errorf("new hasher: %w", err)
+		}
+		hasher.Write(hU8)
+		hasher.Write([]uints.U8{
+			uints.NewU8(byte(block >> 24)), uints.NewU8(byte(block >> 16)),
+			uints.NewU8(byte(block >> 8)), uints.NewU8(byte(block)),
+		})
+		mask := hasher.Sum()
+		for i := 0; i < hLen && block*hLen+i < dbLen; i++ {
+			j := block*hLen + i
+			// big-endian byte j of EM spans the raw bits 8(k-1-j)..8(k-j)
+			dbBits := v.xorBits(emBits[8*(v.k-1-j):8*(v.k-j)], mask[i].Val)
+			if j == 0 {
+				// clear the leftmost 8·emLen−emBits bits after unmasking, as
+				// in RFC 8017 §9.1.2 step 9; with emBits = 8·emLen−1 only the
+				// top bit is cleared
+				dbBits[7] = 0
+			}
+			db[j] = bits.FromBinary(v.api, dbBits)
+		}
+	}
+
+	// DB = 0x00…0x00 || 0x01 || salt
+	psLen := emLen - hLen - sLen - 2
+	for i := 0; i < psLen; i++ {
+		v.api.AssertIsEqual(db[i], 0)
+	}
+	v.api.AssertIsEqual(db[psLen], 1)
+	salt := db[dbLen-sLen:]
+
+	// H = SHA-256(0x00…0x00 || mHash || salt)
+	hasher, err := sha2.New(v.api)
+	if err != nil {
+		return fmt.Errorf("new hasher: %w", err)
+	}
+	hasher.Write(uints.NewU8Array(make([]uint8, 8)))
+	hasher.Write(mHash)
+	saltU8 := make([]uints.U8, sLen)
+	for i := range saltU8 {
+		saltU8[i] = uints.U8{Val: salt[i]}
+	}
+	hasher.Write(saltU8)
+	hPrime := hasher.Sum()
+	for i := 0; i < hLen; i++ {
+		v.api.AssertIsEqual(hPrime[i].Val, h[i])
+	}
+	return nil
+}
@@ -0,0 +1,121 @@
+package rsa
+
+import (
+	"crypto"
+	"crypto/rand"
+	cryptorsa "crypto/rsa"
+	"crypto/sha256"
+	"strconv"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/math/emulated"
+	"github.com/consensys/gnark/std/math/emulated/emparams"
+	"github.com/consensys/gnark/std/math/uints"
+	"github.com/consensys/gnark/test"
+)
+
+const (
+	testModBits = 2048
+	testE       = 65537
+)
+
+type Pkcs1v15Circuit[T emulated.FieldParams] struct {
+	PublicKey[T]
+	Signature[T]
+	Digest [32]uints.U8
+}
+
+func (c *Pkcs1v15Circuit[T]) Define(api frontend.API) error {
+	verifier, err := NewVerifier[T](api, testModBits, testE)
+	if err != nil {
+		return err
+	}
+	return verifier.AssertVerifyPKCS1v15(c.PublicKey, c.Signature, c.Digest[:])
+}
+
+type PssCircuit[T emulated.FieldParams] struct {
+	PublicKey[T]
+	Signature[T]
+	Digest [32]uints.U8
+}
+
+func (c *PssCircuit[T]) Define(api frontend.API) error {
+	verifier, err := NewVerifier[T](api, testModBits, testE)
+	if err != nil {
+		return err
+	}
+	return verifier.AssertVerifyPSS(c.PublicKey, c.Signature, c.Digest[:])
+}
+
+func TestAssertVerifyPKCS1v15(t *testing.T) {
+	assert := test.NewAssert(t)
+
+	sk, err := cryptorsa.GenerateKey(rand.Reader, testModBits)
+	assert.NoError(err)
+	msg := []byte("gnark RSA test message")
+	digest := sha256.Sum256(msg)
+	sig, err := cryptorsa.SignPKCS1v15(rand.Reader, sk, crypto.SHA256, digest[:])
+	assert.NoError(err)
+	assert.NoError(cryptorsa.VerifyPKCS1v15(&sk.PublicKey, crypto.SHA256, digest[:], sig))
+
+	assignment := Pkcs1v15Circuit[emparams.Mod1e2048]{
+		PublicKey: ValueOfPublicKey[emparams.Mod1e2048](&sk.PublicKey),
+		Signature: ValueOfSignature[emparams.Mod1e2048](sig),
+	}
+	copy(assignment.Digest[:], uints.NewU8Array(digest[:]))
+
+	circuit := Pkcs1v15Circuit[emparams.Mod1e2048]{}
+
+	assert.Run(func(assert *test.Assert) {
+		assert.NoError(test.IsSolved(&circuit, &assignment, ecc.BN254.ScalarField()))
+	}, "valid")
+
+	assert.Run(func(assert *test.Assert) {
+		// a signature over a different digest is rejected
+		badAssignment := assignment
+		otherDigest := sha256.Sum256([]byte("other message"))
+		copy(badAssignment.Digest[:], uints.NewU8Array(otherDigest[:]))
+		assert.Error(test.IsSolved(&circuit, &badAssignment, ecc.BN254.ScalarField()))
+	}, "wrong digest")
+}
+
+func TestAssertVerifyPSS(t *testing.T) {
+	assert := test.NewAssert(t)
+
+	sk, err := cryptorsa.GenerateKey(rand.Reader, testModBits)
+	assert.NoError(err)
+	msg := []byte("gnark RSA-PSS test message")
+	digest := sha256.Sum256(msg)
+	opts := &cryptorsa.PSSOptions{SaltLength: cryptorsa.PSSSaltLengthEqualsHash, Hash: crypto.SHA256}
+
+	circuit := PssCircuit[emparams.Mod1e2048]{}
+
+	var assignment PssCircuit[emparams.Mod1e2048]
+	// the salt is fresh per signature, so repeated signatures exercise both
+	// values of the leftmost MGF1 mask bit
+	for i := 0; i < 4; i++ {
+		sig, err := cryptorsa.SignPSS(rand.Reader, sk, crypto.SHA256, digest[:], opts)
+		assert.NoError(err)
+		assert.NoError(cryptorsa.VerifyPSS(&sk.PublicKey, crypto.SHA256, digest[:], sig, opts))
+
+		assignment = PssCircuit[emparams.Mod1e2048]{
+			PublicKey: ValueOfPublicKey[emparams.Mod1e2048](&sk.PublicKey),
+			Signature: ValueOfSignature[emparams.Mod1e2048](sig),
+		}
+		copy(assignment.Digest[:], uints.NewU8Array(digest[:]))
+
+		assert.Run(func(assert *test.Assert) {
+			assert.NoError(test.IsSolved(&circuit, &assignment, ecc.BN254.ScalarField()))
+		}, "valid", strconv.Itoa(i))
+	}
+
+	assert.Run(func(assert *test.Assert) {
+		// a signature over a different digest is rejected
+		badAssignment := assignment
+		otherDigest := sha256.Sum256([]byte("other message"))
+		copy(badAssignment.Digest[:], uints.NewU8Array(otherDigest[:]))
+		assert.Error(test.IsSolved(&circuit, &badAssignment, ecc.BN254.ScalarField()))
+	}, "wrong digest")
+}
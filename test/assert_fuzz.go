@@ -5,13 +5,16 @@ import (
 	"math/big"
 	mrand "math/rand"
 	"reflect"
+	"strings"
+	"testing"
 	"time"
 
 	"github.com/consensys/gnark"
 	"github.com/consensys/gnark-crypto/ecc"
-	"github.com/consensys/gnark/backend"
+	"github.com/consensys/gnark/constraint"
 	"github.com/consensys/gnark/frontend"
 	"github.com/consensys/gnark/frontend/schema"
+	"github.com/stretchr/testify/require"
 )
 
 var seedCorpus []*big.Int
@@ -111,6 +114,36 @@ func randomFiller(w frontend.Circuit, curve ecc.ID) {
 	})
 }
 
+// Sampler provides a fuzzed assignment value for a single witness leaf, or
+// nil to fall back to the default corpus for that leaf. Gadget authors use
+// samplers to steer the fuzzer towards the edge cases of their types, e.g.
+// zero coordinates for leaves belonging to a point or the maximum scalar for
+// exponent leaves, keyed on the leaf name.
+type Sampler func(leaf schema.LeafInfo, field *big.Int) interface{}
+
+// samplerFiller fills the witness with the user-provided samplers, falling
+// back to the seed corpus for the leaves no sampler claims.
+func samplerFiller(samplers []Sampler) filler {
+	return func(w frontend.Circuit, curve ecc.ID) {
+		mrand := mrand.New(mrand.NewSource(time.Now().Unix())) //#nosec G404 weak rng is fine here
+		m := curve.ScalarField()
+
+		setHandler := func(f schema.LeafInfo, tInput reflect.Value) error {
+			for _, s := range samplers {
+				if v := s(f, m); v != nil {
+					tInput.Set(reflect.ValueOf(v))
+					return nil
+				}
+			}
+			i := int(mrand.Uint32() % uint32(len(seedCorpus))) //#nosec G404 weak rng is fine here
+			r := new(big.Int).Set(seedCorpus[i])
+			tInput.Set(reflect.ValueOf(r.Mod(r, m)))
+			return nil
+		}
+		_, _ = schema.Walk(w, tVariable, setHandler)
+	}
+}
+
 func fill(w frontend.Circuit, nextValue func() interface{}) {
 	setHandler := func(f schema.LeafInfo, tInput reflect.Value) error {
 		v := nextValue()
@@ -128,37 +161,53 @@ func init() {
 	tVariable = reflect.ValueOf(struct{ A frontend.Variable }{}).FieldByName("A").Type()
 }
 
+// Fuzz fuzzes the given circuit by generating randomized witnesses from the
+// circuit schema and cross checking the execution result between the
+// constraint system solver of the given backends and the big.Int test
+// execution engine. The (curve, backend) combinations run as parallel
+// subtests, and failing witnesses are shrunk towards zero before being
+// reported. It is a convenience wrapper over [Assert.Fuzz].
+func Fuzz(t *testing.T, circuit frontend.Circuit, opts ...TestingOption) {
+	assert := NewAssert(t)
+	opt := assert.options(opts...)
+	assert.Fuzz(circuit, opt.fuzzCount, opts...)
+}
+
 // Fuzz fuzzes the given circuit by instantiating "randomized" witnesses and cross checking
-// execution result between constraint system solver and big.Int test execution engine
+// execution result between constraint system solver and big.Int test execution engine.
+// The (curve, backend) combinations run as parallel subtests; user samplers
+// given with [WithSampler] steer the generated values per leaf, and failing
+// witnesses are shrunk before being reported.
 //
 // note: this is experimental and will be more tightly integrated with go1.18 built-in fuzzing
 func (assert *Assert) Fuzz(circuit frontend.Circuit, fuzzCount int, opts ...TestingOption) {
 	opt := assert.options(opts...)
 
-	// first we clone the circuit
-	// then we parse the frontend.Variable and set them to a random value  or from our interesting pool
-	// (% of allocations to be tuned)
-	w := shallowClone(circuit)
-
 	fillers := []filler{randomFiller, binaryFiller, seedFiller}
+	if len(opt.samplers) > 0 {
+		fillers = append(fillers, samplerFiller(opt.samplers))
+	}
 
 	for _, curve := range opt.curves {
 		for _, b := range opt.backends {
 			curve := curve
 			b := b
-			assert.Run(func(assert *Assert) {
-				// this puts the compiled circuit in the cache
-				// we do this here in case our fuzzWitness method mutates some references in the circuit
-				// (like []frontend.Variable) before cleaning up
-				_, err := assert.compile(circuit, curve, b, opt.compileOpts)
+			assert.runParallel(func(assert *Assert) {
+				// each parallel subtest works on its own clones: the compiler
+				// writes the allocated variables into the circuit structure,
+				// and the fillers mutate the witness
+				circuit := deepClone(circuit)
+				w := deepClone(circuit)
+
+				ccs, err := assert.compile(circuit, curve, b, opt.compileOpts)
 				assert.NoError(err)
 				valid := 0
 				// "fuzz" with zeros
-				valid += assert.fuzzer(zeroFiller, circuit, w, b, curve, &opt)
+				valid += assert.fuzzer(zeroFiller, circuit, w, ccs, curve, &opt)
 
 				for i := 0; i < fuzzCount; i++ {
 					for _, f := range fillers {
-						valid += assert.fuzzer(f, circuit, w, b, curve, &opt)
+						valid += assert.fuzzer(f, circuit, w, ccs, curve, &opt)
 					}
 				}
 
@@ -168,79 +217,143 @@ func (assert *Assert) Fuzz(circuit frontend.Circuit, fuzzCount int, opts ...Test
 	}
 }
 
-func (assert *Assert) fuzzer(fuzzer filler, circuit, w frontend.Circuit, b backend.ID, curve ecc.ID, opt *testingConfig) int {
-	// fuzz a witness
-	fuzzer(w, curve)
+// runParallel runs the test function fn as a parallel subtest.
+func (a *Assert) runParallel(fn func(assert *Assert), descs ...string) {
+	desc := strings.Join(descs, "/")
+	a.t.Run(desc, func(t *testing.T) {
+		t.Parallel()
+		assert := &Assert{t, require.New(t)}
+		fn(assert)
+	})
+}
 
-	errVars := IsSolved(circuit, w, curve.ScalarField())
-	errConsts := IsSolved(circuit, w, curve.ScalarField(), SetAllVariablesAsConstants())
+// deepClone clones the circuit and the slices reachable through its exported
+// fields, so that the clone can be compiled and solved concurrently with the
+// original. Unexported fields are shallow copied: they host compile-time
+// configuration, not witness leaves.
+func deepClone(circuit frontend.Circuit) frontend.Circuit {
+	cValue := reflect.ValueOf(circuit).Elem()
+	newCircuit := reflect.New(cValue.Type())
+	newCircuit.Elem().Set(cValue)
+	deepCloneValue(newCircuit.Elem())
+
+	circuitCopy, ok := newCircuit.Interface().(frontend.Circuit)
+	if !ok {
+		panic("couldn't clone the circuit")
+	}
 
-	if (errVars == nil) != (errConsts == nil) {
-		w, err := frontend.NewWitness(w, curve.ScalarField())
-		if err != nil {
-			panic(err)
+	return circuitCopy
+}
+
+// deepCloneValue replaces, in place, the slices reachable through exported
+// fields of v with copies.
+func deepCloneValue(v reflect.Value) {
+	switch v.Kind() {
+	case reflect.Slice:
+		if v.IsNil() || !v.CanSet() {
+			return
 		}
-		s, err := frontend.NewSchema(circuit)
-		if err != nil {
-			panic(err)
+		cp := reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+		reflect.Copy(cp, v)
+		v.Set(cp)
+		for i := 0; i < v.Len(); i++ {
+			deepCloneValue(v.Index(i))
+		}
+	case reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			deepCloneValue(v.Index(i))
 		}
-		bb, err := w.ToJSON(s)
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			if v.Field(i).CanSet() {
+				deepCloneValue(v.Field(i))
+			}
+		}
+	}
+}
+
+func (assert *Assert) fuzzer(fuzzer filler, circuit, w frontend.Circuit, ccs constraint.ConstraintSystem, curve ecc.ID, opt *testingConfig) int {
+	// fuzz a witness
+	fuzzer(w, curve)
+
+	solveEngine := func() error { return IsSolved(circuit, w, curve.ScalarField()) }
+	solveEngineConsts := func() error {
+		return IsSolved(circuit, w, curve.ScalarField(), SetAllVariablesAsConstants())
+	}
+	solveSystem := func() error {
+		fw, err := frontend.NewWitness(w, curve.ScalarField())
 		if err != nil {
-			panic(err)
+			return err
 		}
+		return ccs.IsSolved(fw, opt.solverOpts...)
+	}
+
+	errVars := solveEngine()
+	errConsts := solveEngineConsts()
 
+	if (errVars == nil) != (errConsts == nil) {
+		shrink(w, func() bool {
+			return (solveEngine() == nil) != (solveEngineConsts() == nil)
+		})
+		errVars, errConsts = solveEngine(), solveEngineConsts()
 		assert.Log("errVars", errVars)
 		assert.Log("errConsts", errConsts)
-		assert.Log("fuzzer witness", string(bb))
+		assert.Log("fuzzer witness", assert.witnessJSON(circuit, w, curve))
 		assert.FailNow("solving circuit with values as constants vs non-constants mismatched result")
 	}
 
-	if errVars == nil && errConsts == nil {
+	errSolver := solveSystem()
+	if (errVars == nil) != (errSolver == nil) {
+		shrink(w, func() bool {
+			return (solveEngine() == nil) != (solveSystem() == nil)
+		})
+		errVars, errSolver = solveEngine(), solveSystem()
+		assert.Log("errVars", errVars)
+		assert.Log("errSolver", errSolver)
+		assert.Log("fuzzer witness", assert.witnessJSON(circuit, w, curve))
+		assert.FailNow("constraint system solver and test engine mismatched result")
+	}
+
+	if errVars == nil {
 		// valid witness
-		assert.solvingSucceeded(circuit, w, b, curve, opt)
 		return 1
 	}
-
-	// invalid witness
-	assert.solvingFailed(circuit, w, b, curve, opt)
 	return 0
 }
 
-func (assert *Assert) solvingSucceeded(circuit frontend.Circuit, validAssignment frontend.Circuit, b backend.ID, curve ecc.ID, opt *testingConfig) {
-	// parse assignment
-	w := assert.parseAssignment(circuit, validAssignment, curve, opt.checkSerialization)
-
-	checkError := func(err error) { assert.noError(err, &w) }
-
-	// 1- compile the circuit
-	ccs, err := assert.compile(circuit, curve, b, opt.compileOpts)
-	checkError(err)
-
-	// must not error with big int test engine
-	err = IsSolved(circuit, validAssignment, curve.ScalarField())
-	checkError(err)
-
-	err = ccs.IsSolved(w.full, opt.solverOpts...)
-	checkError(err)
-
+// shrink minimizes a failing fuzzed witness in place: it greedily replaces
+// each leaf with zero, then one, keeping the replacement as long as the
+// failure predicate still holds. The user then debugs the shrunk witness
+// rather than a fully random one.
+func shrink(w frontend.Circuit, failing func() bool) {
+	candidates := []interface{}{0, 1}
+	setHandler := func(f schema.LeafInfo, tInput reflect.Value) error {
+		saved := tInput.Interface()
+		for _, candidate := range candidates {
+			tInput.Set(reflect.ValueOf(candidate))
+			if failing() {
+				return nil
+			}
+		}
+		tInput.Set(reflect.ValueOf(saved))
+		return nil
+	}
+	_, _ = schema.Walk(w, tVariable, setHandler)
 }
 
-func (assert *Assert) solvingFailed(circuit frontend.Circuit, invalidAssignment frontend.Circuit, b backend.ID, curve ecc.ID, opt *testingConfig) {
-	// parse assignment
-	w := assert.parseAssignment(circuit, invalidAssignment, curve, opt.checkSerialization)
-
-	checkError := func(err error) { assert.noError(err, &w) }
-	mustError := func(err error) { assert.error(err, &w) }
-
-	// 1- compile the circuit
-	ccs, err := assert.compile(circuit, curve, b, opt.compileOpts)
-	checkError(err)
-
-	// must error with big int test engine
-	err = IsSolved(circuit, invalidAssignment, curve.ScalarField())
-	mustError(err)
-
-	err = ccs.IsSolved(w.full, opt.solverOpts...)
-	mustError(err)
-
+// witnessJSON renders the witness for failure reports.
+func (assert *Assert) witnessJSON(circuit, w frontend.Circuit, curve ecc.ID) string {
+	fw, err := frontend.NewWitness(w, curve.ScalarField())
+	if err != nil {
+		panic(err)
+	}
+	s, err := frontend.NewSchema(circuit)
+	if err != nil {
+		panic(err)
+	}
+	bb, err := fw.ToJSON(s)
+	if err != nil {
+		panic(err)
+	}
+	return string(bb)
 }
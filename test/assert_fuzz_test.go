@@ -0,0 +1,76 @@
+package test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark/backend"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/frontend/schema"
+)
+
+type fuzzableCircuit struct {
+	X   frontend.Variable
+	Bit frontend.Variable `gnark:",public"`
+}
+
+func (c *fuzzableCircuit) Define(api frontend.API) error {
+	api.AssertIsBoolean(c.Bit)
+	api.AssertIsEqual(api.Mul(c.X, 0), 0)
+	return nil
+}
+
+func TestFuzzCircuit(t *testing.T) {
+	// a sampler keeps the boolean leaf in range so the fuzzer also covers
+	// valid witnesses
+	bitSampler := func(leaf schema.LeafInfo, field *big.Int) interface{} {
+		if leaf.FullName() == "Bit" {
+			return 1
+		}
+		return nil
+	}
+	Fuzz(t, &fuzzableCircuit{},
+		WithCurves(ecc.BN254),
+		WithBackends(backend.GROTH16),
+		WithFuzzCount(2),
+		WithSampler(bitSampler),
+	)
+}
+
+func TestFuzzShrink(t *testing.T) {
+	w := &fuzzableCircuit{X: 123, Bit: 456}
+
+	// the failure depends only on Bit: X shrinks to zero, Bit keeps its value
+	shrink(w, func() bool { return w.Bit == 456 })
+	if w.X != 0 {
+		t.Errorf("expected X shrunk to 0, got %v", w.X)
+	}
+	if w.Bit != 456 {
+		t.Errorf("expected Bit kept at 456, got %v", w.Bit)
+	}
+
+	// an unconditional failure shrinks everything
+	w = &fuzzableCircuit{X: 123, Bit: 456}
+	shrink(w, func() bool { return true })
+	if w.X != 0 || w.Bit != 0 {
+		t.Errorf("expected all leaves shrunk to 0, got X=%v Bit=%v", w.X, w.Bit)
+	}
+}
+
+type slicedCircuit struct {
+	Vals []frontend.Variable
+}
+
+func (c *slicedCircuit) Define(api frontend.API) error {
+	return nil
+}
+
+func TestFuzzDeepClone(t *testing.T) {
+	original := &slicedCircuit{Vals: []frontend.Variable{1, 2}}
+	clone := deepClone(original).(*slicedCircuit)
+	clone.Vals[0] = 3
+	if original.Vals[0] != 1 {
+		t.Errorf("clone shares the Vals slice with the original")
+	}
+}
@@ -25,6 +25,9 @@ type testingConfig struct {
 
 	validAssignments   []frontend.Circuit
 	invalidAssignments []frontend.Circuit
+
+	fuzzCount int
+	samplers  []Sampler
 }
 
 // default options
@@ -47,6 +50,8 @@ func (assert *Assert) options(opts ...TestingOption) testingConfig {
 		opt.profile = constraintSolverChecks
 	}
 
+	opt.fuzzCount = 10
+
 	// apply user provided options.
 	for _, option := range opts {
 		err := option(&opt)
@@ -92,6 +97,24 @@ func WithCurves(c ecc.ID, curves ...ecc.ID) TestingOption {
 	}
 }
 
+// WithFuzzCount is a testing option which sets the number of fuzzing
+// iterations per filler in [Fuzz]. Defaults to 10.
+func WithFuzzCount(n int) TestingOption {
+	return func(opt *testingConfig) error {
+		opt.fuzzCount = n
+		return nil
+	}
+}
+
+// WithSampler is a testing option which adds user-provided witness samplers
+// to the fuzzing fillers of [Fuzz]. See [Sampler].
+func WithSampler(samplers ...Sampler) TestingOption {
+	return func(opt *testingConfig) error {
+		opt.samplers = append(opt.samplers, samplers...)
+		return nil
+	}
+}
+
 // NoSerializationChecks is a testing option which disables serialization checks,
 // even when the build tag "release_checks" is set.
 func NoSerializationChecks() TestingOption {
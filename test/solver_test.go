@@ -93,6 +93,14 @@ func (pw *permutterWitness) FromJSON(s *schema.Schema, data []byte) error {
 	return nil
 }
 
+func (pw *permutterWitness) ToCBOR(s *schema.Schema) ([]byte, error) {
+	return nil, nil
+}
+
+func (pw *permutterWitness) FromCBOR(s *schema.Schema, data []byte) error {
+	return nil
+}
+
 func (pw *permutterWitness) Fill(nbPublic, nbSecret int, values <-chan any) error {
 	return nil
 }